@@ -1,8 +0,0 @@
-select
-  name,
-  id,
-  storage_account_id
-from
-  azure.azure_monitor_log_profile
-where
-  name = '{{ resourceName }}'
\ No newline at end of file
@@ -1,7 +0,0 @@
-select
-  name,
-  id
-from
-  azure.azure_monitor_log_profile
-where
-  id = '{{ output.resource_id_lower.value }}';
\ No newline at end of file
@@ -1,7 +0,0 @@
-select
-  name,
-  id
-from
-  azure.azure_monitor_log_profile
-where
-  name = 'dummy-{{ resourceName }}';
\ No newline at end of file
@@ -1,8 +0,0 @@
-select
-  name,
-  subscription_id,
-  title
-from
-  azure.azure_monitor_log_profile
-where
-  name = '{{ resourceName }}';
\ No newline at end of file
@@ -5,16 +5,17 @@ import (
 )
 
 type azureConfig struct {
-	TenantID            *string  `hcl:"tenant_id"`
-	SubscriptionID      *string  `hcl:"subscription_id"`
-	ClientID            *string  `hcl:"client_id"`
-	ClientSecret        *string  `hcl:"client_secret"`
-	CertificatePath     *string  `hcl:"certificate_path"`
-	CertificatePassword *string  `hcl:"certificate_password"`
-	Username            *string  `hcl:"username"`
-	Password            *string  `hcl:"password"`
-	Environment         *string  `hcl:"environment"`
-	IgnoreErrorCodes    []string `hcl:"ignore_error_codes,optional"`
+	TenantID                *string  `hcl:"tenant_id"`
+	SubscriptionID          *string  `hcl:"subscription_id"`
+	ClientID                *string  `hcl:"client_id"`
+	ClientSecret            *string  `hcl:"client_secret"`
+	CertificatePath         *string  `hcl:"certificate_path"`
+	CertificatePassword     *string  `hcl:"certificate_password"`
+	Username                *string  `hcl:"username"`
+	Password                *string  `hcl:"password"`
+	Environment             *string  `hcl:"environment"`
+	ResourceManagerEndpoint *string  `hcl:"resource_manager_endpoint"`
+	IgnoreErrorCodes        []string `hcl:"ignore_error_codes,optional"`
 }
 
 func ConfigInstance() interface{} {
@@ -0,0 +1,73 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Azure/azure-sdk-for-go/services/operationalinsights/v1/operationalinsights"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// Some data, like the change tracking records of Automation, is only available as
+// records of a Log Analytics workspace, so it is read by running a KQL query against it.
+
+// queryLogAnalyticsWorkspace runs query against the workspace with the given workspace (customer) ID,
+// limited to timespan, and decodes the rows of the primary result table into result, which must
+// be a pointer to a slice of structs whose json tags match the column names.
+func queryLogAnalyticsWorkspace(ctx context.Context, d *plugin.QueryData, workspaceID string, query string, timespan string, result interface{}) error {
+	session, err := GetNewSession(ctx, d, "LOGANALYTICS")
+	if err != nil {
+		return err
+	}
+
+	client := operationalinsights.NewQueryClientWithBaseURI(session.LogAnalyticsEndpoint + "/v1")
+	client.Authorizer = session.Authorizer
+
+	body := operationalinsights.QueryBody{
+		Query: types.String(query),
+	}
+	if timespan != "" {
+		body.Timespan = types.String(timespan)
+	}
+
+	op, err := client.Execute(ctx, workspaceID, body)
+	if err != nil {
+		return err
+	}
+
+	// The rows are returned as arrays of values, in the order of the columns of the table
+	records := []map[string]interface{}{}
+	if op.Tables != nil && len(*op.Tables) > 0 {
+		table := (*op.Tables)[0]
+		if table.Columns != nil && table.Rows != nil {
+			for _, row := range *table.Rows {
+				record := map[string]interface{}{}
+				for i, column := range *table.Columns {
+					if i < len(row) && column.Name != nil {
+						record[*column.Name] = row[i]
+					}
+				}
+				records = append(records, record)
+			}
+		}
+	}
+
+	data, err := json.Marshal(records)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, result)
+}
+
+// escapeKustoString escapes value for use inside a single-quoted KQL string literal
+func escapeKustoString(value string) string {
+	escaped := make([]rune, 0, len(value))
+	for _, r := range value {
+		if r == '\'' || r == '\\' {
+			escaped = append(escaped, '\\')
+		}
+		escaped = append(escaped, r)
+	}
+	return string(escaped)
+}
@@ -0,0 +1,176 @@
+package azure
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	cloudPolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// The Azure SDK does not ship a Microsoft Graph client, so Graph objects are
+// requested over REST using the credential of the updated session.
+
+// MicrosoftGraphClient sends requests to the Microsoft Graph API of the configured cloud
+type MicrosoftGraphClient struct {
+	endpoint string
+	pipeline runtime.Pipeline
+}
+
+// Microsoft Graph objects, limited to the properties used by the tables
+
+type GraphPasswordCredential struct {
+	KeyID         *string `json:"keyId,omitempty"`
+	DisplayName   *string `json:"displayName,omitempty"`
+	Hint          *string `json:"hint,omitempty"`
+	StartDateTime *string `json:"startDateTime,omitempty"`
+	EndDateTime   *string `json:"endDateTime,omitempty"`
+}
+
+type GraphKeyCredential struct {
+	KeyID               *string `json:"keyId,omitempty"`
+	DisplayName         *string `json:"displayName,omitempty"`
+	Type                *string `json:"type,omitempty"`
+	Usage               *string `json:"usage,omitempty"`
+	CustomKeyIdentifier *string `json:"customKeyIdentifier,omitempty"`
+	StartDateTime       *string `json:"startDateTime,omitempty"`
+	EndDateTime         *string `json:"endDateTime,omitempty"`
+}
+
+type GraphServicePrincipal struct {
+	ID                     *string                   `json:"id,omitempty"`
+	AppID                  *string                   `json:"appId,omitempty"`
+	DisplayName            *string                   `json:"displayName,omitempty"`
+	ServicePrincipalType   *string                   `json:"servicePrincipalType,omitempty"`
+	AppOwnerOrganizationID *string                   `json:"appOwnerOrganizationId,omitempty"`
+	AccountEnabled         *bool                     `json:"accountEnabled,omitempty"`
+	PasswordCredentials    []GraphPasswordCredential `json:"passwordCredentials,omitempty"`
+	KeyCredentials         []GraphKeyCredential      `json:"keyCredentials,omitempty"`
+}
+
+type GraphDirectoryObject struct {
+	ODataType         *string `json:"@odata.type,omitempty"`
+	ID                *string `json:"id,omitempty"`
+	DisplayName       *string `json:"displayName,omitempty"`
+	UserPrincipalName *string `json:"userPrincipalName,omitempty"`
+}
+
+type GraphApplication struct {
+	ID                  *string                   `json:"id,omitempty"`
+	AppID               *string                   `json:"appId,omitempty"`
+	DisplayName         *string                   `json:"displayName,omitempty"`
+	PasswordCredentials []GraphPasswordCredential `json:"passwordCredentials,omitempty"`
+	KeyCredentials      []GraphKeyCredential      `json:"keyCredentials,omitempty"`
+}
+
+func getMicrosoftGraphClient(ctx context.Context, d *plugin.QueryData) (*MicrosoftGraphClient, error) {
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	clientOptions := session.ClientOptions.ClientOptions
+	endpoint := microsoftGraphEndpoint(clientOptions.Cloud)
+	tokenPolicy := runtime.NewBearerTokenPolicy(session.Cred, []string{endpoint + "/.default"}, nil)
+	pipeline := runtime.NewPipeline("steampipe-plugin-azure", "v1", runtime.PipelineOptions{PerRetry: []cloudPolicy.Policy{tokenPolicy}}, &clientOptions)
+
+	return &MicrosoftGraphClient{endpoint: endpoint, pipeline: pipeline}, nil
+}
+
+// microsoftGraphEndpoint returns the Microsoft Graph endpoint of the cloud the session authenticates against
+func microsoftGraphEndpoint(configuration cloud.Configuration) string {
+	switch configuration.ActiveDirectoryAuthorityHost {
+	case cloud.AzureChina.ActiveDirectoryAuthorityHost:
+		return "https://microsoftgraph.chinacloudapi.cn"
+	case cloud.AzureGovernment.ActiveDirectoryAuthorityHost:
+		return "https://graph.microsoft.us"
+	}
+	return "https://graph.microsoft.com"
+}
+
+// Get sends a GET request for path, relative to the v1.0 endpoint, and decodes the response into result
+func (c *MicrosoftGraphClient) Get(ctx context.Context, path string, query url.Values, result interface{}) error {
+	requestURL := c.endpoint + "/v1.0/" + strings.TrimPrefix(path, "/")
+	if len(query) > 0 {
+		requestURL += "?" + query.Encode()
+	}
+	return c.get(ctx, requestURL, result)
+}
+
+// listMicrosoftGraphObjects returns all the pages of a collection, following the @odata.nextLink of each page
+func listMicrosoftGraphObjects[T any](ctx context.Context, c *MicrosoftGraphClient, path string, query url.Values) ([]T, error) {
+	type page struct {
+		Value    []T    `json:"value"`
+		NextLink string `json:"@odata.nextLink"`
+	}
+
+	var items []T
+	var result page
+	if err := c.Get(ctx, path, query, &result); err != nil {
+		return nil, err
+	}
+	items = append(items, result.Value...)
+
+	for result.NextLink != "" {
+		nextLink := result.NextLink
+		result = page{}
+		if err := c.get(ctx, nextLink, &result); err != nil {
+			return nil, err
+		}
+		items = append(items, result.Value...)
+	}
+
+	return items, nil
+}
+
+func (c *MicrosoftGraphClient) get(ctx context.Context, requestURL string, result interface{}) error {
+	req, err := runtime.NewRequest(ctx, http.MethodGet, requestURL)
+	if err != nil {
+		return err
+	}
+	req.Raw().Header.Set("Accept", "application/json")
+
+	resp, err := c.pipeline.Do(req)
+	if err != nil {
+		return err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return runtime.NewResponseError(resp)
+	}
+	return runtime.UnmarshalAsJSON(resp, result)
+}
+
+// graphStringLiteral quotes value as a string literal of an OData $filter expression, in which
+// single quotes are escaped by doubling them
+func graphStringLiteral(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+// isGraphAccessDeniedError reports whether a Microsoft Graph request failed because the identity
+// could not get a token for Microsoft Graph or is not allowed to read the object
+func isGraphAccessDeniedError(err error) bool {
+	var authErr *azidentity.AuthenticationFailedError
+	if errors.As(err, &authErr) {
+		return true
+	}
+	var respErr *azcore.ResponseError
+	if errors.As(err, &respErr) {
+		return respErr.StatusCode == http.StatusUnauthorized || respErr.StatusCode == http.StatusForbidden
+	}
+	return false
+}
+
+// isGraphNotFoundError reports whether a Microsoft Graph request failed because the object does not exist
+func isGraphNotFoundError(err error) bool {
+	if respErr, ok := err.(*azcore.ResponseError); ok {
+		return respErr.StatusCode == http.StatusNotFound
+	}
+	return false
+}
@@ -2,6 +2,7 @@ package azure
 
 import (
 	"context"
+	"path"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
@@ -33,12 +34,20 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			NewInstance: ConfigInstance,
 		},
 		TableMap: map[string]*plugin.Table{
+			"azure_aad_b2c_tenant":                                         tableAzureAADB2CTenant(ctx),
 			"azure_ad_group":                                               tableAzureAdGroup(ctx),
 			"azure_ad_service_principal":                                   tableAzureAdServicePrincipal(ctx),
 			"azure_ad_user":                                                tableAzureAdUser(ctx),
+			"azure_advisor_recommendation":                                 tableAzureAdvisorRecommendation(ctx),
+			"azure_advisor_score":                                          tableAzureAdvisorScore(ctx),
 			"azure_alert_management":                                       tableAzureAlertMangement(ctx),
 			"azure_api_management":                                         tableAzureAPIManagement(ctx),
 			"azure_api_management_backend":                                 tableAzureAPIManagementBackend(ctx),
+			"azure_api_management_certificate":                             tableAzureAPIManagementCertificate(ctx),
+			"azure_api_management_diagnostic":                              tableAzureAPIManagementDiagnostic(ctx),
+			"azure_api_management_gateway":                                 tableAzureAPIManagementGateway(ctx),
+			"azure_api_management_logger":                                  tableAzureAPIManagementLogger(ctx),
+			"azure_api_management_named_value":                             tableAzureAPIManagementNamedValue(ctx),
 			"azure_app_configuration":                                      tableAzureAppConfiguration(ctx),
 			"azure_app_service_environment":                                tableAzureAppServiceEnvironment(ctx),
 			"azure_app_service_function_app":                               tableAzureAppServiceFunctionApp(ctx),
@@ -48,12 +57,23 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"azure_application_gateway":                                    tableAzureApplicationGateway(ctx),
 			"azure_application_insight":                                    tableAzureApplicationInsight(ctx),
 			"azure_application_security_group":                             tableAzureApplicationSecurityGroup(ctx),
+			"azure_attestation_provider":                                   tableAzureAttestationProvider(ctx),
 			"azure_automation_account":                                     tableAzureApAutomationAccount(ctx),
+			"azure_automation_change_tracking":                             tableAzureAutomationChangeTracking(ctx),
 			"azure_automation_variable":                                    tableAzureApAutomationVariable(ctx),
 			"azure_backup_policy":                                          tableAzureBackupPolicy(ctx),
+			"azure_backup_protected_item":                                  tableAzureBackupProtectedItem(ctx),
 			"azure_bastion_host":                                           tableAzureBastionHost(ctx),
 			"azure_batch_account":                                          tableAzureBatchAccount(ctx),
+			"azure_billing_account":                                        tableAzureBillingAccount(ctx),
+			"azure_billing_invoice":                                        tableAzureBillingInvoice(ctx),
+			"azure_billing_invoice_section":                                tableAzureBillingInvoiceSection(ctx),
+			"azure_billing_profile":                                        tableAzureBillingProfile(ctx),
+			"azure_capacity_reservation":                                   tableAzureCapacityReservation(ctx),
+			"azure_capacity_reservation_group":                             tableAzureCapacityReservationGroup(ctx),
 			"azure_cdn_frontdoor_profile":                                  tableAzureCDNFrontDoorProfile(ctx),
+			"azure_change_analysis":                                        tableAzureChangeAnalysis(ctx),
+			"azure_classic_administrator":                                  tableAzureClassicAdministrator(ctx),
 			"azure_cognitive_account":                                      tableAzureCognitiveAccount(ctx),
 			"azure_compute_availability_set":                               tableAzureComputeAvailabilitySet(ctx),
 			"azure_compute_disk":                                           tableAzureComputeDisk(ctx),
@@ -65,8 +85,13 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"azure_compute_disk_metric_write_ops":                          tableAzureComputeDiskMetricWriteOps(ctx),
 			"azure_compute_disk_metric_write_ops_daily":                    tableAzureComputeDiskMetricWriteOpsDaily(ctx),
 			"azure_compute_disk_metric_write_ops_hourly":                   tableAzureComputeDiskMetricWriteOpsHourly(ctx),
+			"azure_compute_gallery":                                        tableAzureComputeGallery(ctx),
+			"azure_compute_gallery_image":                                  tableAzureComputeGalleryImage(ctx),
+			"azure_compute_gallery_image_version":                          tableAzureComputeGalleryImageVersion(ctx),
 			"azure_compute_image":                                          tableAzureComputeImage(ctx),
 			"azure_compute_resource_sku":                                   tableAzureResourceSku(ctx),
+			"azure_compute_restore_point":                                  tableAzureComputeRestorePoint(ctx),
+			"azure_compute_restore_point_collection":                       tableAzureComputeRestorePointCollection(ctx),
 			"azure_compute_snapshot":                                       tableAzureComputeSnapshot(ctx),
 			"azure_compute_ssh_key":                                        tableAzureComputeSshKey(ctx),
 			"azure_compute_virtual_machine":                                tableAzureComputeVirtualMachine(ctx),
@@ -79,7 +104,10 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"azure_compute_virtual_machine_scale_set":                      tableAzureComputeVirtualMachineScaleSet(ctx),
 			"azure_compute_virtual_machine_scale_set_network_interface":    tableAzureComputeVirtualMachineScaleSetNetworkInterface(ctx),
 			"azure_compute_virtual_machine_scale_set_vm":                   tableAzureComputeVirtualMachineScaleSetVm(ctx),
+			"azure_confidential_ledger":                                    tableAzureConfidentialLedger(ctx),
+			"azure_connection_health":                                      tableAzureConnectionHealth(ctx),
 			"azure_consumption_usage":                                      tableAzureConsumptionUsage(ctx),
+			"azure_consumption_usage_detail":                               tableAzureConsumptionUsageDetail(ctx),
 			"azure_container_group":                                        tableAzureContainerGroup(ctx),
 			"azure_container_registry":                                     tableAzureContainerRegistry(ctx),
 			"azure_cosmosdb_account":                                       tableAzureCosmosDBAccount(ctx),
@@ -87,21 +115,45 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"azure_cosmosdb_mongo_database":                                tableAzureCosmosDBMongoDatabase(ctx),
 			"azure_cosmosdb_restorable_database_account":                   tableAzureCosmosDBRestorableDatabaseAccount(ctx),
 			"azure_cosmosdb_sql_database":                                  tableAzureCosmosDBSQLDatabase(ctx),
+			"azure_cost_forecast":                                          tableAzureCostForecast(ctx),
+			"azure_cost_usage":                                             tableAzureCostUsage(ctx),
+			"azure_costmanagement_anomaly_alert":                           tableAzureCostManagementAnomalyAlert(ctx),
+			"azure_costmanagement_export":                                  tableAzureCostManagementExport(ctx),
+			"azure_costmanagement_scheduled_action":                        tableAzureCostManagementScheduledAction(ctx),
+			"azure_custom_ip_prefix":                                       tableAzureCustomIPPrefix(ctx),
 			"azure_data_factory":                                           tableAzureDataFactory(ctx),
+			"azure_data_factory_credential":                                tableAzureDataFactoryCredential(ctx),
 			"azure_data_factory_dataset":                                   tableAzureDataFactoryDataset(ctx),
+			"azure_data_factory_managed_private_endpoint":                  tableAzureDataFactoryManagedPrivateEndpoint(ctx),
 			"azure_data_factory_pipeline":                                  tableAzureDataFactoryPipeline(ctx),
 			"azure_data_lake_analytics_account":                            tableAzureDataLakeAnalyticsAccount(ctx),
 			"azure_data_lake_store":                                        tableAzureDataLakeStore(ctx),
+			"azure_data_protection_backup_instance":                        tableAzureDataProtectionBackupInstance(ctx),
 			"azure_data_protection_backup_job":                             tableAzureDataProtectionBackupJob(ctx),
+			"azure_data_protection_backup_policy":                          tableAzureDataProtectionBackupPolicy(ctx),
 			"azure_data_protection_backup_vault":                           tableAzureDataProtectionBackupVault(ctx),
 			"azure_databox_edge_device":                                    tableAzureDataBoxEdgeDevice(ctx),
 			"azure_databricks_workspace":                                   tableAzureDatabricksWorkspace(ctx),
+			"azure_ddos_protection_plan":                                   tableAzureDdosProtectionPlan(ctx),
+			"azure_dedicated_host":                                         tableAzureDedicatedHost(ctx),
+			"azure_dedicated_host_group":                                   tableAzureDedicatedHostGroup(ctx),
+			"azure_deny_assignment":                                        tableAzureDenyAssignment(ctx),
+			"azure_deployment_stack":                                       tableAzureDeploymentStack(ctx),
+			"azure_dev_center":                                             tableAzureDevCenter(ctx),
+			"azure_dev_center_dev_box_definition":                          tableAzureDevCenterDevBoxDefinition(ctx),
+			"azure_dev_center_pool":                                        tableAzureDevCenterPool(ctx),
+			"azure_dev_center_project":                                     tableAzureDevCenterProject(ctx),
+			"azure_devtest_global_schedule":                                tableAzureDevTestGlobalSchedule(ctx),
+			"azure_devtest_lab":                                            tableAzureDevTestLab(ctx),
+			"azure_devtest_lab_virtual_machine":                            tableAzureDevTestLabVirtualMachine(ctx),
 			"azure_diagnostic_setting":                                     tableAzureDiagnosticSetting(ctx),
 			"azure_dns_zone":                                               tableAzureDNSZone(ctx),
 			"azure_eventgrid_domain":                                       tableAzureEventGridDomain(ctx),
 			"azure_eventgrid_topic":                                        tableAzureEventGridTopic(ctx),
 			"azure_eventhub_namespace":                                     tableAzureEventHubNamespace(ctx),
 			"azure_express_route_circuit":                                  tableAzureExpressRouteCircuit(ctx),
+			"azure_express_route_port":                                     tableAzureExpressRoutePort(ctx),
+			"azure_express_route_port_link":                                tableAzureExpressRoutePortLink(ctx),
 			"azure_firewall":                                               tableAzureFirewall(ctx),
 			"azure_firewall_policy":                                        tableAzureFirewallPolicy(ctx),
 			"azure_frontdoor":                                              tableAzureFrontDoor(ctx),
@@ -109,18 +161,30 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"azure_healthcare_service":                                     tableAzureHealthcareService(ctx),
 			"azure_hpc_cache":                                              tableAzureHPCCache(ctx),
 			"azure_hybrid_compute_machine":                                 tableAzureHybridComputeMachine(ctx),
+			"azure_hybrid_compute_machine_extension":                       tableAzureHybridComputeMachineExtension(ctx),
 			"azure_hybrid_kubernetes_connected_cluster":                    tableAzureHybridKubernetesConnectedCluster(ctx),
+			"azure_hybrid_kubernetes_connected_cluster_extension":          tableAzureHybridKubernetesConnectedClusterExtension(ctx),
 			"azure_iothub":                                                 tableAzureIotHub(ctx),
 			"azure_iothub_dps":                                             tableAzureIotHubDps(ctx),
+			"azure_ip_group":                                               tableAzureIPGroup(ctx),
 			"azure_key_vault":                                              tableAzureKeyVault(ctx),
+			"azure_key_vault_certificate":                                  tableAzureKeyVaultCertificate(ctx),
+			"azure_key_vault_deleted_certificate":                          tableAzureKeyVaultDeletedCertificate(ctx),
+			"azure_key_vault_deleted_key":                                  tableAzureKeyVaultDeletedKey(ctx),
+			"azure_key_vault_deleted_secret":                               tableAzureKeyVaultDeletedSecret(ctx),
 			"azure_key_vault_deleted_vault":                                tableAzureKeyVaultDeletedVault(ctx),
 			"azure_key_vault_key":                                          tableAzureKeyVaultKey(ctx),
 			"azure_key_vault_key_version":                                  tableAzureKeyVaultKeyVersion(ctx),
 			"azure_key_vault_managed_hardware_security_module":             tableAzureKeyVaultManagedHardwareSecurityModule(ctx),
+			"azure_key_vault_managed_hsm_key":                              tableAzureKeyVaultManagedHsmKey(ctx),
+			"azure_key_vault_managed_hsm_role_assignment":                  tableAzureKeyVaultManagedHsmRoleAssignment(ctx),
 			"azure_key_vault_secret":                                       tableAzureKeyVaultSecret(ctx),
 			"azure_kubernetes_cluster":                                     tableAzureKubernetesCluster(ctx),
 			"azure_kubernetes_service_version":                             tableAzureAKSVersion(ctx),
 			"azure_kusto_cluster":                                          tableAzureKustoCluster(ctx),
+			"azure_lab_services_lab":                                       tableAzureLabServicesLab(ctx),
+			"azure_lab_services_lab_plan":                                  tableAzureLabServicesLabPlan(ctx),
+			"azure_lab_services_virtual_machine":                           tableAzureLabServicesVirtualMachine(ctx),
 			"azure_lb":                                                     tableAzureLoadBalancer(ctx),
 			"azure_lb_backend_address_pool":                                tableAzureLoadBalancerBackendAddressPool(ctx),
 			"azure_lb_nat_rule":                                            tableAzureLoadBalancerNatRule(ctx),
@@ -136,11 +200,12 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"azure_logic_app_workflow":                                     tableAzureLogicAppWorkflow(ctx),
 			"azure_machine_learning_workspace":                             tableAzureMachineLearningWorkspace(ctx),
 			"azure_maintenance_configuration":                              tableAzureMaintenanceConfiguration(ctx),
+			"azure_maintenance_configuration_assignment":                   tableAzureMaintenanceConfigurationAssignment(ctx),
+			"azure_managed_grafana":                                        tableAzureManagedGrafana(ctx),
 			"azure_management_group":                                       tableAzureManagementGroup(ctx),
 			"azure_management_lock":                                        tableAzureManagementLock(ctx),
 			"azure_mariadb_server":                                         tableAzureMariaDBServer(ctx),
 			"azure_monitor_activity_log_event":                             tableAzureMonitorActivityLogEvent(ctx),
-			"azure_monitor_log_profile":                                    tableAzureMonitorLogProfile(ctx),
 			"azure_mssql_elasticpool":                                      tableAzureMSSQLElasticPool(ctx),
 			"azure_mssql_managed_instance":                                 tableAzureMSSQLManagedInstance(ctx),
 			"azure_mssql_virtual_machine":                                  tableAzureMSSQLVirtualMachine(ctx),
@@ -148,39 +213,86 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"azure_mysql_server":                                           tableAzureMySQLServer(ctx),
 			"azure_nat_gateway":                                            tableAzureNatGateway(ctx),
 			"azure_network_interface":                                      tableAzureNetworkInterface(ctx),
+			"azure_network_manager":                                        tableAzureNetworkManager(ctx),
+			"azure_network_manager_connectivity_configuration":             tableAzureNetworkManagerConnectivityConfiguration(ctx),
+			"azure_network_manager_network_group":                          tableAzureNetworkManagerNetworkGroup(ctx),
+			"azure_network_manager_security_admin_rule":                    tableAzureNetworkManagerSecurityAdminRule(ctx),
+			"azure_network_profile":                                        tableAzureNetworkProfile(ctx),
+			"azure_network_profile_container_network_interface":            tableAzureNetworkProfileContainerNetworkInterface(ctx),
 			"azure_network_security_group":                                 tableAzureNetworkSecurityGroup(ctx),
 			"azure_network_watcher":                                        tableAzureNetworkWatcher(ctx),
 			"azure_network_watcher_flow_log":                               tableAzureNetworkWatcherFlowLog(ctx),
 			"azure_policy_assignment":                                      tableAzurePolicyAssignment(ctx),
 			"azure_policy_definition":                                      tableAzurePolicyDefinition(ctx),
+			"azure_policy_exemption":                                       tableAzurePolicyExemption(ctx),
+			"azure_policy_remediation":                                     tableAzurePolicyRemediation(ctx),
+			"azure_policy_set_definition":                                  tableAzurePolicySetDefinition(ctx),
+			"azure_policy_state":                                           tableAzurePolicyState(ctx),
 			"azure_postgresql_flexible_server":                             tableAzurePostgreSqlFlexibleServer(ctx),
 			"azure_postgresql_server":                                      tableAzurePostgreSqlServer(ctx),
 			"azure_private_dns_zone":                                       tableAzurePrivateDNSZone(ctx),
 			"azure_private_endpoint":                                       tableAzurePrivateEndpoint(ctx),
+			"azure_private_link_service":                                   tableAzurePrivateLinkService(ctx),
 			"azure_provider":                                               tableAzureProvider(ctx),
 			"azure_public_ip":                                              tableAzurePublicIP(ctx),
+			"azure_quantum_workspace":                                      tableAzureQuantumWorkspace(ctx),
 			"azure_recovery_services_backup_job":                           tableAzureRecoveryServicesBackupJob(ctx),
 			"azure_recovery_services_vault":                                tableAzureRecoveryServicesVault(ctx),
 			"azure_redis_cache":                                            tableAzureRedisCache(ctx),
+			"azure_reservation":                                            tableAzureReservation(ctx),
+			"azure_reservation_order":                                      tableAzureReservationOrder(ctx),
+			"azure_reservation_recommendation":                             tableAzureReservationRecommendation(ctx),
+			"azure_resource_child":                                         tableAzureResourceChild(ctx),
 			"azure_resource_group":                                         tableAzureResourceGroup(ctx),
+			"azure_resource_health":                                        tableAzureResourceHealth(ctx),
+			"azure_resource_health_history":                                tableAzureResourceHealthHistory(ctx),
 			"azure_resource_link":                                          tableAzureResourceLink(ctx),
+			"azure_resource_mover_move_collection":                         tableAzureResourceMoverMoveCollection(ctx),
+			"azure_resource_service_principal_credential":                  tableAzureResourceServicePrincipalCredential(ctx),
+			"azure_retail_price":                                           tableAzureRetailPrice(ctx),
 			"azure_role_assignment":                                        tableAzureIamRoleAssignment(ctx),
+			"azure_role_assignment_resource":                               tableAzureRoleAssignmentResource(ctx),
+			"azure_role_assignment_schedule_instance":                      tableAzureRoleAssignmentScheduleInstance(ctx),
 			"azure_role_definition":                                        tableAzureIamRoleDefinition(ctx),
+			"azure_role_eligibility_schedule_instance":                     tableAzureRoleEligibilityScheduleInstance(ctx),
+			"azure_route_server":                                           tableAzureRouteServer(ctx),
 			"azure_route_table":                                            tableAzureRouteTable(ctx),
+			"azure_savings_plan":                                           tableAzureSavingsPlan(ctx),
+			"azure_savings_plan_recommendation":                            tableAzureSavingsPlanRecommendation(ctx),
+			"azure_scheduled_event":                                        tableAzureScheduledEvent(ctx),
 			"azure_search_service":                                         tableAzureSearchService(ctx),
+			"azure_security_center_alert":                                  tableAzureSecurityCenterAlert(ctx),
+			"azure_security_center_assessment":                             tableAzureSecurityCenterAssessment(ctx),
+			"azure_security_center_assessment_metadata":                    tableAzureSecurityCenterAssessmentMetadata(ctx),
 			"azure_security_center_auto_provisioning":                      tableAzureSecurityCenterAutoProvisioning(ctx),
 			"azure_security_center_automation":                             tableAzureSecurityCenterAutomation(ctx),
 			"azure_security_center_contact":                                tableAzureSecurityCenterContact(ctx),
+			"azure_security_center_governance_assignment":                  tableAzureSecurityCenterGovernanceAssignment(ctx),
+			"azure_security_center_governance_rule":                        tableAzureSecurityCenterGovernanceRule(ctx),
 			"azure_security_center_jit_network_access_policy":              tableAzureSecurityCenterJITNetworkAccessPolicy(ctx),
+			"azure_security_center_regulatory_compliance_assessment":       tableAzureSecurityCenterRegulatoryComplianceAssessment(ctx),
+			"azure_security_center_regulatory_compliance_control":          tableAzureSecurityCenterRegulatoryComplianceControl(ctx),
+			"azure_security_center_regulatory_compliance_standard":         tableAzureSecurityCenterRegulatoryComplianceStandard(ctx),
+			"azure_security_center_secure_score":                           tableAzureSecurityCenterSecureScore(ctx),
+			"azure_security_center_secure_score_control":                   tableAzureSecurityCenterSecureScoreControl(ctx),
 			"azure_security_center_setting":                                tableAzureSecurityCenterSetting(ctx),
 			"azure_security_center_sub_assessment":                         tableAzureSecurityCenterSubAssessment(ctx),
 			"azure_security_center_subscription_pricing":                   tableAzureSecurityCenterPricing(ctx),
+			"azure_sentinel_alert_rule":                                    tableAzureSentinelAlertRule(ctx),
+			"azure_sentinel_automation_rule":                               tableAzureSentinelAutomationRule(ctx),
+			"azure_sentinel_data_connector":                                tableAzureSentinelDataConnector(ctx),
+			"azure_sentinel_incident":                                      tableAzureSentinelIncident(ctx),
+			"azure_sentinel_watchlist":                                     tableAzureSentinelWatchlist(ctx),
 			"azure_service_fabric_cluster":                                 tableAzureServiceFabricCluster(ctx),
+			"azure_service_health_event":                                   tableAzureServiceHealthEvent(ctx),
 			"azure_servicebus_namespace":                                   tableAzureServiceBusNamespace(ctx),
 			"azure_signalr_service":                                        tableAzureSignalRService(ctx),
+			"azure_site_recovery_recovery_plan":                            tableAzureSiteRecoveryRecoveryPlan(ctx),
+			"azure_site_recovery_replicated_item":                          tableAzureSiteRecoveryReplicatedItem(ctx),
 			"azure_spring_cloud_service":                                   tableAzureSpringCloudService(ctx),
 			"azure_sql_database":                                           tableAzureSqlDatabase(ctx),
 			"azure_sql_server":                                             tableAzureSQLServer(ctx),
+			"azure_stack_hci_cluster":                                      tableAzureStackHCICluster(ctx),
 			"azure_storage_account":                                        tableAzureStorageAccount(ctx),
 			"azure_storage_blob":                                           tableAzureStorageBlob(ctx),
 			"azure_storage_blob_service":                                   tableAzureStorageBlobService(ctx),
@@ -193,16 +305,58 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"azure_stream_analytics_job":                                   tableAzureStreamAnalyticsJob(ctx),
 			"azure_subnet":                                                 tableAzureSubnet(ctx),
 			"azure_subscription":                                           tableAzureSubscription(ctx),
+			"azure_synapse_integration_runtime":                            tableAzureSynapseIntegrationRuntime(ctx),
+			"azure_synapse_managed_private_endpoint":                       tableAzureSynapseManagedPrivateEndpoint(ctx),
 			"azure_synapse_workspace":                                      tableAzureSynapseWorkspace(ctx),
+			"azure_tag":                                                    tableAzureTag(ctx),
 			"azure_tenant":                                                 tableAzureTenant(ctx),
+			"azure_trusted_signing_account":                                tableAzureTrustedSigningAccount(ctx),
+			"azure_trusted_signing_certificate_profile":                    tableAzureTrustedSigningCertificateProfile(ctx),
+			"azure_update_manager_patch_assessment":                        tableAzureUpdateManagerPatchAssessment(ctx),
+			"azure_user_assigned_identity":                                 tableAzureUserAssignedIdentity(ctx),
+			"azure_user_assigned_identity_federated_credential":            tableAzureUserAssignedIdentityFederatedCredential(ctx),
+			"azure_virtual_desktop_application_group":                      tableAzureVirtualDesktopApplicationGroup(ctx),
+			"azure_virtual_desktop_host_pool":                              tableAzureVirtualDesktopHostPool(ctx),
+			"azure_virtual_desktop_scaling_plan":                           tableAzureVirtualDesktopScalingPlan(ctx),
+			"azure_virtual_desktop_session_host":                           tableAzureVirtualDesktopSessionHost(ctx),
+			"azure_virtual_desktop_user_session":                           tableAzureVirtualDesktopUserSession(ctx),
+			"azure_virtual_desktop_workspace":                              tableAzureVirtualDesktopWorkspace(ctx),
 			"azure_virtual_network":                                        tableAzureVirtualNetwork(ctx),
 			"azure_virtual_network_gateway":                                tableAzureVirtualNetworkGateway(ctx),
+			"azure_virtual_network_peering":                                tableAzureVirtualNetworkPeering(ctx),
+			"azure_vmware_cluster":                                         tableAzureVMwareCluster(ctx),
+			"azure_vmware_express_route_authorization":                     tableAzureVMwareExpressRouteAuthorization(ctx),
+			"azure_vmware_private_cloud":                                   tableAzureVMwarePrivateCloud(ctx),
+			"azure_web_application_firewall_policy":                        tableAzureWebApplicationFirewallPolicy(ctx),
 		},
 	}
 
+	// The query results of volatile tables, e.g. metrics, alerts and costs, are never cached,
+	// while the other tables keep the cache TTL of the connection
+	for name, table := range p.TableMap {
+		for _, pattern := range cacheDisabledTablePatterns {
+			if matched, _ := path.Match(pattern, name); matched {
+				table.Cache = &plugin.TableCacheOptions{Enabled: false}
+			}
+		}
+	}
+
 	return p
 }
 
+// cacheDisabledTablePatterns are the name patterns of the tables whose data changes too often
+// to be served from the query cache.
+var cacheDisabledTablePatterns = []string{
+	"azure_*_metric_*",
+	"azure_alert_management",
+	"azure_costmanagement_anomaly_alert",
+	"azure_security_center_alert",
+	"azure_consumption_usage",
+	"azure_consumption_usage_detail",
+	"azure_cost_forecast",
+	"azure_cost_usage",
+}
+
 func getSubscriptionIdForConnection(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (any, error) {
 	subscriptionID, err := getSubscriptionIDMemoized(ctx, d, h)
 	if err != nil {
@@ -0,0 +1,149 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// Some properties are only returned by API versions newer than the ones of the SDK packages
+// used by the tables, and some resource providers have no SDK package at all, so the resources
+// are requested over REST using the credential of the updated session.
+
+// resourceManagerListPage is a page of results of a Resource Manager list operation.
+type resourceManagerListPage struct {
+	Value    []json.RawMessage `json:"value"`
+	NextLink *string           `json:"nextLink"`
+}
+
+// getResourceManagerResource sends a GET request for the resource at path, relative to the
+// Resource Manager endpoint, and decodes the response into result.
+// It reports false if the resource does not exist.
+func getResourceManagerResource(ctx context.Context, d *plugin.QueryData, path string, apiVersion string, result interface{}) (bool, error) {
+	client, err := newResourceManagerClient(ctx, d)
+	if err != nil {
+		return false, err
+	}
+
+	return sendResourceManagerRequest(ctx, client, http.MethodGet, runtime.JoinPaths(client.Endpoint(), path), apiVersion, nil, result)
+}
+
+// listResourceManagerResources sends GET requests for the collection at path, relative to the
+// Resource Manager endpoint, following the next links of the response. The items of each page
+// are passed to handle, which returns false to stop paging.
+func listResourceManagerResources(ctx context.Context, d *plugin.QueryData, path string, apiVersion string, handle func([]json.RawMessage) (bool, error)) error {
+	client, err := newResourceManagerClient(ctx, d)
+	if err != nil {
+		return err
+	}
+
+	url := runtime.JoinPaths(client.Endpoint(), path)
+	for {
+		var page resourceManagerListPage
+		found, err := sendResourceManagerRequest(ctx, client, http.MethodGet, url, apiVersion, nil, &page)
+		if err != nil || !found {
+			return err
+		}
+
+		more, err := handle(page.Value)
+		if err != nil || !more {
+			return err
+		}
+
+		if page.NextLink == nil || *page.NextLink == "" {
+			return nil
+		}
+		// Next links already carry the api-version and the continuation token
+		url = *page.NextLink
+		apiVersion = ""
+	}
+}
+
+// postResourceManagerRequest sends a POST request with body to url, which is either relative to
+// the Resource Manager endpoint or the next link of a previous response, and decodes the response
+// into result. It is used by the operations that take their parameters in the body, such as the
+// queries of Cost Management.
+func postResourceManagerRequest(ctx context.Context, d *plugin.QueryData, url string, apiVersion string, body interface{}, result interface{}) error {
+	client, err := newResourceManagerClient(ctx, d)
+	if err != nil {
+		return err
+	}
+
+	if !strings.HasPrefix(url, "https://") {
+		url = runtime.JoinPaths(client.Endpoint(), url)
+	}
+	found, err := sendResourceManagerRequest(ctx, client, http.MethodPost, url, apiVersion, body, result)
+	if err == nil && !found {
+		return fmt.Errorf("the resource at %s was not found", url)
+	}
+	return err
+}
+
+// ResourceManagerIdentity is the managed identity of a resource requested over REST, for the
+// resource types that have no SDK package or whose SDK package does not return it.
+type ResourceManagerIdentity struct {
+	Type                   *string                `json:"type,omitempty"`
+	PrincipalID            *string                `json:"principalId,omitempty"`
+	TenantID               *string                `json:"tenantId,omitempty"`
+	UserAssignedIdentities map[string]interface{} `json:"userAssignedIdentities,omitempty"`
+}
+
+// getResourceManagerIdentity returns the managed identity of the resource with the given ID, or
+// nil if the resource does not exist or has no managed identity.
+func getResourceManagerIdentity(ctx context.Context, d *plugin.QueryData, id string, apiVersion string) (*ResourceManagerIdentity, error) {
+	var resource struct {
+		Identity *ResourceManagerIdentity `json:"identity,omitempty"`
+	}
+	found, err := getResourceManagerResource(ctx, d, id, apiVersion, &resource)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	return resource.Identity, nil
+}
+
+func newResourceManagerClient(ctx context.Context, d *plugin.QueryData) (*arm.Client, error) {
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	return arm.NewClient("steampipe-plugin-azure", "v1", session.Cred, session.ClientOptions)
+}
+
+func sendResourceManagerRequest(ctx context.Context, client *arm.Client, method string, url string, apiVersion string, body interface{}, result interface{}) (bool, error) {
+	req, err := runtime.NewRequest(ctx, method, url)
+	if err != nil {
+		return false, err
+	}
+	if body != nil {
+		if err := runtime.MarshalAsJSON(req, body); err != nil {
+			return false, err
+		}
+	}
+	if apiVersion != "" {
+		query := req.Raw().URL.Query()
+		query.Set("api-version", apiVersion)
+		req.Raw().URL.RawQuery = query.Encode()
+	}
+	req.Raw().Header.Set("Accept", "application/json")
+
+	resp, err := client.Pipeline().Do(req)
+	if err != nil {
+		return false, err
+	}
+	if runtime.HasStatusCode(resp, http.StatusNotFound) {
+		return false, nil
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return false, runtime.NewResponseError(resp)
+	}
+
+	return true, runtime.UnmarshalAsJSON(resp, result)
+}
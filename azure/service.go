@@ -40,6 +40,7 @@ type Session struct {
 	CloudEnvironment        string
 	Expires                 *time.Time
 	GraphEndpoint           string
+	LogAnalyticsEndpoint    string
 	ResourceManagerEndpoint string
 	StorageEndpointSuffix   string
 	SubscriptionID          string
@@ -126,6 +127,21 @@ func GetNewSessionUpdated(ctx context.Context, d *plugin.QueryData) (session *Se
 	default:
 		cloudConfiguration = cloud.AzurePublic
 	}
+
+	// Azure Stack Hub stamps authenticate against their own identity provider,
+	// which credentials must be pointed at and which does not support instance discovery
+	var credentialOptions cloudPolicy.ClientOptions
+	var disableInstanceDiscovery bool
+	if resourceManagerEndpoint := getResourceManagerEndpoint(d); resourceManagerEndpoint != "" {
+		stackEnvironment, err := getAzureStackEnvironment(ctx, d, resourceManagerEndpoint)
+		if err != nil {
+			logger.Error("GetNewSessionUpdated", "azure_stack_environment_error", err)
+			return nil, err
+		}
+		cloudConfiguration = azureStackCloudConfiguration(stackEnvironment)
+		credentialOptions.Cloud = cloudConfiguration
+		disableInstanceDiscovery = true
+	}
 	clientOptions := policy.ClientOptions{ClientOptions: cloudPolicy.ClientOptions{Cloud: cloudConfiguration}}
 
 	if tenantID != "" && subscriptionID != "" && clientID != "" && clientSecret != "" { // Client secret authentication
@@ -133,7 +149,7 @@ func GetNewSessionUpdated(ctx context.Context, d *plugin.QueryData) (session *Se
 			tenantID,
 			clientID,
 			clientSecret,
-			nil,
+			&azidentity.ClientSecretCredentialOptions{ClientOptions: credentialOptions, DisableInstanceDiscovery: disableInstanceDiscovery},
 		)
 		if err != nil {
 			logger.Error("GetNewSessionUpdated", "client_secret_credential_error", err)
@@ -164,7 +180,7 @@ func GetNewSessionUpdated(ctx context.Context, d *plugin.QueryData) (session *Se
 			clientID,
 			certs,
 			key,
-			nil,
+			&azidentity.ClientCertificateCredentialOptions{ClientOptions: credentialOptions, DisableInstanceDiscovery: disableInstanceDiscovery},
 		)
 		if err != nil {
 			logger.Error("GetNewSessionUpdated", "client_certificate_credential_error", err)
@@ -176,7 +192,7 @@ func GetNewSessionUpdated(ctx context.Context, d *plugin.QueryData) (session *Se
 			clientID,
 			username,
 			password,
-			nil,
+			&azidentity.UsernamePasswordCredentialOptions{ClientOptions: credentialOptions, DisableInstanceDiscovery: disableInstanceDiscovery},
 		)
 		if err != nil {
 			logger.Error("GetNewSessionUpdated", "username_password_credential_error", err)
@@ -418,11 +434,28 @@ func GetNewSession(ctx context.Context, d *plugin.QueryData, tokenAudience strin
 		settings.Environment = env
 	}
 
+	// The endpoints of an Azure Stack Hub stamp are discovered from its Resource Manager
+	resourceManagerEndpoint := getResourceManagerEndpoint(d)
+	if resourceManagerEndpoint != "" {
+		env, err := getAzureStackEnvironment(ctx, d, resourceManagerEndpoint)
+		if err != nil {
+			logger.Error("GetNewSession", "azure_stack_environment_error", err)
+			return nil, err
+		}
+		settings.Environment = env
+		delete(settings.Values, auth.EnvironmentName)
+	}
+
 	authMethod, resource, err := getApplicableAuthorizationDetails(ctx, settings, tokenAudience)
 	if err != nil {
 		logger.Error("GetNewSession", "getApplicableAuthorizationDetails error", err)
 		return nil, err
 	}
+
+	// Azure Stack Hub issues Resource Manager tokens for the audience of the stamp
+	if resourceManagerEndpoint != "" && tokenAudience == "MANAGEMENT" {
+		resource = settings.Environment.TokenAudience
+	}
 	settings.Values[auth.Resource] = resource
 
 	var authorizer autorest.Authorizer
@@ -488,6 +521,7 @@ func GetNewSession(ctx context.Context, d *plugin.QueryData, tokenAudience strin
 		CloudEnvironment:        settings.Environment.Name,
 		Expires:                 expiresOn,
 		GraphEndpoint:           settings.Environment.GraphEndpoint,
+		LogAnalyticsEndpoint:    settings.Environment.ResourceIdentifiers.OperationalInsights,
 		ResourceManagerEndpoint: settings.Environment.ResourceManagerEndpoint,
 		StorageEndpointSuffix:   settings.Environment.StorageEndpointSuffix,
 		SubscriptionID:          subscriptionID,
@@ -508,6 +542,47 @@ func GetNewSession(ctx context.Context, d *plugin.QueryData, tokenAudience strin
 	return sess, err
 }
 
+// getResourceManagerEndpoint returns the Resource Manager endpoint of the Azure Stack Hub stamp
+// set in the connection config or the AZURE_RESOURCE_MANAGER_ENDPOINT environment variable
+func getResourceManagerEndpoint(d *plugin.QueryData) string {
+	azureConfig := GetConfig(d.Connection)
+	if azureConfig.ResourceManagerEndpoint != nil {
+		return *azureConfig.ResourceManagerEndpoint
+	}
+	return os.Getenv("AZURE_RESOURCE_MANAGER_ENDPOINT")
+}
+
+// getAzureStackEnvironment discovers the endpoints of an Azure Stack Hub stamp
+// from the metadata endpoint of its Resource Manager
+func getAzureStackEnvironment(ctx context.Context, d *plugin.QueryData, resourceManagerEndpoint string) (azure.Environment, error) {
+	cacheKey := "getAzureStackEnvironment"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(azure.Environment), nil
+	}
+
+	env, err := azure.EnvironmentFromURL(resourceManagerEndpoint, azure.OverrideProperty{Key: azure.EnvironmentName, Value: "AZURESTACKCLOUD"})
+	if err != nil {
+		return env, fmt.Errorf("error discovering the Azure Stack Hub endpoints from %s: %v", resourceManagerEndpoint, err)
+	}
+	plugin.Logger(ctx).Debug("getAzureStackEnvironment", "resource_manager_endpoint", env.ResourceManagerEndpoint, "active_directory_endpoint", env.ActiveDirectoryEndpoint)
+
+	d.ConnectionManager.Cache.Set(cacheKey, env)
+	return env, nil
+}
+
+// azureStackCloudConfiguration converts the endpoints of an Azure Stack Hub stamp for the updated SDK
+func azureStackCloudConfiguration(env azure.Environment) cloud.Configuration {
+	return cloud.Configuration{
+		ActiveDirectoryAuthorityHost: env.ActiveDirectoryEndpoint,
+		Services: map[cloud.ServiceName]cloud.ServiceConfiguration{
+			cloud.ResourceManager: {
+				Audience: env.TokenAudience,
+				Endpoint: env.ResourceManagerEndpoint,
+			},
+		},
+	}
+}
+
 func getApplicableAuthorizationDetails(ctx context.Context, settings auth.EnvironmentSettings, tokenAudience string) (authMethod string, resource string, err error) {
 	logger := plugin.Logger(ctx)
 	subscriptionID := settings.Values[auth.SubscriptionID]
@@ -528,10 +603,9 @@ func getApplicableAuthorizationDetails(ctx context.Context, settings auth.Enviro
 	logger.Debug("getApplicableAuthorizationDetails", "auth_method", authMethod)
 
 	var environment azure.Environment
-	// Get the environment endpoint to be used for authorization
-	if environmentName == "" {
-		settings.Environment = azure.PublicCloud
-	} else {
+	// Get the environment endpoint to be used for authorization, the environment
+	// of the settings is used if no name is set (public cloud, unless a stamp is configured)
+	if environmentName != "" {
 		environment, err = azure.EnvironmentFromName(environmentName)
 		if err != nil {
 			logger.Error("getApplicableAuthorizationDetails", "get_environment_name_error", err)
@@ -547,8 +621,16 @@ func getApplicableAuthorizationDetails(ctx context.Context, settings auth.Enviro
 		resource = settings.Environment.GraphEndpoint
 	case "VAULT":
 		resource = strings.TrimSuffix(settings.Environment.KeyVaultEndpoint, "/")
+	case "MANAGEDHSM":
+		// The managed HSM domain of the environment is the one of its key vaults, for example
+		// managedhsm.azure.net for vault.azure.net
+		resource = "https://" + strings.Replace(settings.Environment.KeyVaultDNSSuffix, "vault.", "managedhsm.", 1)
 	case "MANAGEMENT":
 		resource = settings.Environment.ResourceManagerEndpoint
+	case "LOGANALYTICS":
+		resource = settings.Environment.ResourceIdentifiers.OperationalInsights
+	case "SYNAPSE":
+		resource = settings.Environment.ResourceIdentifiers.Synapse
 	default:
 		resource = settings.Environment.ResourceManagerEndpoint
 	}
@@ -0,0 +1,217 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAADB2CTenant(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_aad_b2c_tenant",
+		Description: "Azure AD B2C Tenant, the Azure AD B2C directory resources provisioned in the subscription.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getAADB2CTenant,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAADB2CTenants,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the B2C directory resource, which is the initial domain name of the tenant.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the B2C directory resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the B2C directory resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: "The ID of the B2C tenant.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.TenantID"),
+			},
+			{
+				Name:        "sku_name",
+				Description: "The name of the SKU of the tenant. Possible values include: 'Standard', 'PremiumP1', 'PremiumP2'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Sku.Name"),
+			},
+			{
+				Name:        "sku_tier",
+				Description: "The tier of the SKU of the tenant.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Sku.Tier"),
+			},
+			{
+				Name:        "billing_type",
+				Description: "The type of billing of the tenant. Possible values include: 'MAU', 'Auths'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.BillingConfig.BillingType"),
+			},
+			{
+				Name:        "billing_effective_start_date",
+				Description: "The time the billing type became effective for the tenant.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.BillingConfig.EffectiveStartDateUtc"),
+			},
+			{
+				Name:        "is_go_local_tenant",
+				Description: "Indicates whether the tenant stores its data in the selected country only (Go-Local).",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.IsGoLocalTenant"),
+			},
+			{
+				Name:        "country_code",
+				Description: "The country code of the data residency location of the tenant.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.CountryCode"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: "The data residency location of the tenant, for example 'United States' or 'Europe'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+// There is no SDK package for the Microsoft.AzureActiveDirectory resource provider
+const aadB2CTenantAPIVersion = "2021-04-01"
+
+type AADB2CTenant struct {
+	ID         *string                 `json:"id,omitempty"`
+	Name       *string                 `json:"name,omitempty"`
+	Type       *string                 `json:"type,omitempty"`
+	Location   *string                 `json:"location,omitempty"`
+	Tags       map[string]*string      `json:"tags,omitempty"`
+	Sku        *AADB2CTenantSku        `json:"sku,omitempty"`
+	Properties *AADB2CTenantProperties `json:"properties,omitempty"`
+}
+
+type AADB2CTenantSku struct {
+	Name *string `json:"name,omitempty"`
+	Tier *string `json:"tier,omitempty"`
+}
+
+type AADB2CTenantProperties struct {
+	TenantID        *string                    `json:"tenantId,omitempty"`
+	IsGoLocalTenant *bool                      `json:"isGoLocalTenant,omitempty"`
+	CountryCode     *string                    `json:"countryCode,omitempty"`
+	BillingConfig   *AADB2CTenantBillingConfig `json:"billingConfig,omitempty"`
+}
+
+type AADB2CTenantBillingConfig struct {
+	BillingType           *string `json:"billingType,omitempty"`
+	EffectiveStartDateUtc *string `json:"effectiveStartDateUtc,omitempty"`
+}
+
+//// LIST FUNCTION
+
+func listAADB2CTenants(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_aad_b2c_tenant.listAADB2CTenants", "session_error", err)
+		return nil, err
+	}
+
+	path := "/subscriptions/" + session.SubscriptionID + "/providers/Microsoft.AzureActiveDirectory/b2cDirectories"
+	err = listResourceManagerResources(ctx, d, path, aadB2CTenantAPIVersion, func(items []json.RawMessage) (bool, error) {
+		for _, item := range items {
+			var tenant AADB2CTenant
+			if err := json.Unmarshal(item, &tenant); err != nil {
+				return false, err
+			}
+			d.StreamListItem(ctx, tenant)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_aad_b2c_tenant.listAADB2CTenants", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAADB2CTenant(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_aad_b2c_tenant.getAADB2CTenant", "session_error", err)
+		return nil, err
+	}
+
+	var tenant AADB2CTenant
+	path := "/subscriptions/" + session.SubscriptionID + "/resourceGroups/" + resourceGroup + "/providers/Microsoft.AzureActiveDirectory/b2cDirectories/" + name
+	found, err := getResourceManagerResource(ctx, d, path, aadB2CTenantAPIVersion, &tenant)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_aad_b2c_tenant.getAADB2CTenant", "api_error", err)
+		return nil, err
+	}
+
+	if found && tenant.ID != nil {
+		return tenant, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,339 @@
+package azure
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/advisor/mgmt/advisor"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdvisorRecommendation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_advisor_recommendation",
+		Description: "Azure Advisor Recommendation",
+		List: &plugin.ListConfig{
+			Hydrate: listAdvisorRecommendations,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "category",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the recommendation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the recommendation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource (Microsoft.Advisor/recommendations).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "category",
+				Description: "The category of the recommendation, 'Cost', 'HighAvailability', 'OperationalExcellence', 'Performance' or 'Security'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RecommendationProperties.Category").Transform(transform.ToString),
+			},
+			{
+				Name:        "impact",
+				Description: "The business impact of the recommendation, 'High', 'Medium' or 'Low'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RecommendationProperties.Impact").Transform(transform.ToString),
+			},
+			{
+				Name:        "risk",
+				Description: "The potential risk of not implementing the recommendation, 'Error', 'Warning' or 'None'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RecommendationProperties.Risk").Transform(transform.ToString),
+			},
+			{
+				Name:        "problem",
+				Description: "The issue or opportunity identified by the recommendation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RecommendationProperties.ShortDescription.Problem"),
+			},
+			{
+				Name:        "solution",
+				Description: "The remediation action suggested by the recommendation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RecommendationProperties.ShortDescription.Solution"),
+			},
+			{
+				Name:        "recommendation_type_id",
+				Description: "The ID of the type of the recommendation, shared by the recommendations of all the resources.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RecommendationProperties.RecommendationTypeID"),
+			},
+			{
+				Name:        "impacted_field",
+				Description: "The type of the resource impacted by the recommendation, for example 'Microsoft.Compute/virtualMachines'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RecommendationProperties.ImpactedField"),
+			},
+			{
+				Name:        "impacted_value",
+				Description: "The name of the resource impacted by the recommendation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RecommendationProperties.ImpactedValue"),
+			},
+			{
+				Name:        "resource_id",
+				Description: "The ID of the resource impacted by the recommendation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RecommendationProperties.ResourceMetadata.ResourceID"),
+			},
+			{
+				Name:        "last_updated",
+				Description: "The time when the recommendation was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("RecommendationProperties.LastUpdated").Transform(convertDateToTime),
+			},
+			{
+				Name:        "suppression_ids",
+				Description: "The IDs of the suppressions of the recommendation, if it has been postponed or dismissed.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("RecommendationProperties.SuppressionIds"),
+			},
+			{
+				Name:        "suppression_state",
+				Description: "The state of the suppression of the recommendation, 'Postponed' if it is hidden until the suppression expires, or 'Dismissed' if it is hidden permanently.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAdvisorRecommendationSuppressions,
+				Transform:   transform.FromField("State"),
+			},
+			{
+				Name:        "suppression_expiration_time",
+				Description: "The time when the recommendation is no longer postponed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getAdvisorRecommendationSuppressions,
+				Transform:   transform.FromField("ExpirationTime"),
+			},
+			{
+				Name:        "suppressions",
+				Description: "The suppressions of the recommendation, with their duration and expiration.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAdvisorRecommendationSuppressions,
+				Transform:   transform.FromField("Suppressions"),
+			},
+			{
+				Name:        "extended_properties",
+				Description: "The properties specific to the type of the recommendation, such as the savings of a cost recommendation.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("RecommendationProperties.ExtendedProperties"),
+			},
+			{
+				Name:        "resource_metadata",
+				Description: "The metadata of the resource impacted by the recommendation, such as the source of the recommendation and the action to implement it.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("RecommendationProperties.ResourceMetadata"),
+			},
+			{
+				Name:        "metadata",
+				Description: "The metadata of the recommendation.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("RecommendationProperties.Metadata"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RecommendationProperties.ShortDescription.Problem", "Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractAdvisorRecommendationResourceGroup),
+			},
+		}),
+	}
+}
+
+// advisorRecommendationInfo is a recommendation with the suppressions of the subscription, which
+// are listed at most once per query and shared by all the recommendations.
+type advisorRecommendationInfo struct {
+	advisor.ResourceRecommendationBase
+	sharedSuppressions *advisorSuppressions
+}
+
+type advisorSuppressions struct {
+	once         sync.Once
+	suppressions []advisor.SuppressionContract
+	err          error
+}
+
+type AdvisorRecommendationSuppressionInfo struct {
+	State          *string
+	ExpirationTime *time.Time
+	Suppressions   []advisor.SuppressionContract
+}
+
+//// LIST FUNCTION
+
+func listAdvisorRecommendations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_advisor_recommendation.listAdvisorRecommendations", "session_error", err)
+		return nil, err
+	}
+
+	client := advisor.NewRecommendationsClientWithBaseURI(session.ResourceManagerEndpoint, session.SubscriptionID)
+	client.Authorizer = session.Authorizer
+
+	filter := ""
+	if d.EqualsQualString("category") != "" {
+		filter = "Category eq '" + d.EqualsQualString("category") + "'"
+	}
+
+	result, err := client.List(ctx, filter, nil, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_advisor_recommendation.listAdvisorRecommendations", "api_error", err)
+		return nil, err
+	}
+
+	suppressions := &advisorSuppressions{}
+	for _, recommendation := range result.Values() {
+		d.StreamListItem(ctx, advisorRecommendationInfo{recommendation, suppressions})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_advisor_recommendation.listAdvisorRecommendations", "api_paging_error", err)
+			return nil, err
+		}
+		for _, recommendation := range result.Values() {
+			d.StreamListItem(ctx, advisorRecommendationInfo{recommendation, suppressions})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAdvisorRecommendationSuppressions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	recommendation := h.Item.(advisorRecommendationInfo)
+	if recommendation.RecommendationProperties == nil || recommendation.SuppressionIds == nil || len(*recommendation.SuppressionIds) == 0 {
+		return AdvisorRecommendationSuppressionInfo{}, nil
+	}
+
+	shared := recommendation.sharedSuppressions
+	shared.once.Do(func() {
+		shared.suppressions, shared.err = listAdvisorSuppressions(ctx, d)
+	})
+	if shared.err != nil {
+		return nil, shared.err
+	}
+
+	suppressionIDs := map[string]bool{}
+	for _, id := range *recommendation.SuppressionIds {
+		suppressionIDs[strings.ToLower(id.String())] = true
+	}
+
+	info := AdvisorRecommendationSuppressionInfo{}
+	for _, suppression := range shared.suppressions {
+		if suppression.SuppressionProperties == nil || suppression.SuppressionID == nil || !suppressionIDs[strings.ToLower(*suppression.SuppressionID)] {
+			continue
+		}
+		info.Suppressions = append(info.Suppressions, suppression)
+
+		// A suppression without a duration, or with a duration of -1, dismisses the recommendation
+		// permanently, while the other ones postpone it until they expire
+		if suppression.TTL == nil || *suppression.TTL == "" || *suppression.TTL == "-1" {
+			info.State = types.String("Dismissed")
+			continue
+		}
+		if info.State == nil {
+			info.State = types.String("Postponed")
+		}
+		if suppression.ExpirationTimeStamp != nil && (info.ExpirationTime == nil || suppression.ExpirationTimeStamp.Time.After(*info.ExpirationTime)) {
+			expirationTime := suppression.ExpirationTimeStamp.Time
+			info.ExpirationTime = &expirationTime
+		}
+	}
+
+	return info, nil
+}
+
+func listAdvisorSuppressions(ctx context.Context, d *plugin.QueryData) ([]advisor.SuppressionContract, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_advisor_recommendation.listAdvisorSuppressions", "session_error", err)
+		return nil, err
+	}
+
+	client := advisor.NewSuppressionsClientWithBaseURI(session.ResourceManagerEndpoint, session.SubscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListComplete(ctx, nil, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_advisor_recommendation.listAdvisorSuppressions", "api_error", err)
+		return nil, err
+	}
+
+	var suppressions []advisor.SuppressionContract
+	for result.NotDone() {
+		suppressions = append(suppressions, result.Value())
+		if err := result.NextWithContext(ctx); err != nil {
+			plugin.Logger(ctx).Error("azure_advisor_recommendation.listAdvisorSuppressions", "api_paging_error", err)
+			return nil, err
+		}
+	}
+
+	return suppressions, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// The recommendations of a subscription, such as the ones of Azure Advisor itself, are not in a resource group
+func extractAdvisorRecommendationResourceGroup(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	id, ok := d.Value.(*string)
+	if !ok || id == nil {
+		return nil, nil
+	}
+
+	parts := strings.Split(*id, "/")
+	if len(parts) > 4 && strings.EqualFold(parts[3], "resourceGroups") {
+		return strings.ToLower(parts[4]), nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,197 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAdvisorScore(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_advisor_score",
+		Description: "Azure Advisor Score, the score of the subscription overall and per category of Advisor recommendations.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getAdvisorScore,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAdvisorScores,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The category of the score, 'Advisor' for the overall score, or 'Cost', 'HighAvailability', 'OperationalExcellence', 'Performance' or 'Security'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the score.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource (Microsoft.Advisor/advisorScore).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "score",
+				Description: "The percentage of the score, from 0 to 100.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Properties.LastRefreshedScore.Score"),
+			},
+			{
+				Name:        "potential_score_increase",
+				Description: "The increase of the score if all the recommendations of the category were implemented.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Properties.LastRefreshedScore.PotentialScoreIncrease"),
+			},
+			{
+				Name:        "impacted_resource_count",
+				Description: "The number of resources impacted by the recommendations of the category.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Properties.LastRefreshedScore.ImpactedResourceCount"),
+			},
+			{
+				Name:        "consumption_units",
+				Description: "The consumption units the score is weighted by.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Properties.LastRefreshedScore.ConsumptionUnits"),
+			},
+			{
+				Name:        "category_count",
+				Description: "The number of categories the score is made of, for the overall score.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Properties.LastRefreshedScore.CategoryCount"),
+			},
+			{
+				Name:        "last_refreshed_date",
+				Description: "The date when the score was last refreshed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.LastRefreshedScore.Date"),
+			},
+			{
+				Name:        "time_series",
+				Description: "The history of the score, aggregated by day, week and month.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.TimeSeries"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+		}),
+	}
+}
+
+// The advisorScore resource type is more recent than the SDK package of the Microsoft.Advisor
+// resource provider
+const advisorScoreAPIVersion = "2023-01-01"
+
+type AdvisorScore struct {
+	ID         *string                 `json:"id,omitempty"`
+	Name       *string                 `json:"name,omitempty"`
+	Type       *string                 `json:"type,omitempty"`
+	Properties *AdvisorScoreProperties `json:"properties,omitempty"`
+}
+
+type AdvisorScoreProperties struct {
+	LastRefreshedScore *AdvisorScoreEntity      `json:"lastRefreshedScore,omitempty"`
+	TimeSeries         []AdvisorScoreTimeSeries `json:"timeSeries,omitempty"`
+}
+
+type AdvisorScoreEntity struct {
+	Date                   *string  `json:"date,omitempty"`
+	Score                  *float64 `json:"score,omitempty"`
+	ConsumptionUnits       *float64 `json:"consumptionUnits,omitempty"`
+	ImpactedResourceCount  *int64   `json:"impactedResourceCount,omitempty"`
+	PotentialScoreIncrease *float64 `json:"potentialScoreIncrease,omitempty"`
+	CategoryCount          *int64   `json:"categoryCount,omitempty"`
+}
+
+type AdvisorScoreTimeSeries struct {
+	AggregationLevel *string              `json:"aggregationLevel,omitempty"`
+	ScoreHistory     []AdvisorScoreEntity `json:"scoreHistory,omitempty"`
+}
+
+//// LIST FUNCTION
+
+func listAdvisorScores(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_advisor_score.listAdvisorScores", "session_error", err)
+		return nil, err
+	}
+
+	path := "/subscriptions/" + session.SubscriptionID + "/providers/Microsoft.Advisor/advisorScore"
+	err = listResourceManagerResources(ctx, d, path, advisorScoreAPIVersion, func(items []json.RawMessage) (bool, error) {
+		for _, item := range items {
+			var score AdvisorScore
+			if err := json.Unmarshal(item, &score); err != nil {
+				return false, err
+			}
+			d.StreamListItem(ctx, score)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_advisor_score.listAdvisorScores", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAdvisorScore(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Return nil, if no input provided
+	if name == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_advisor_score.getAdvisorScore", "session_error", err)
+		return nil, err
+	}
+
+	var score AdvisorScore
+	path := "/subscriptions/" + session.SubscriptionID + "/providers/Microsoft.Advisor/advisorScore/" + name
+	found, err := getResourceManagerResource(ctx, d, path, advisorScoreAPIVersion, &score)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_advisor_score.getAdvisorScore", "api_error", err)
+		return nil, err
+	}
+
+	if found && score.ID != nil {
+		return score, nil
+	}
+
+	return nil, nil
+}
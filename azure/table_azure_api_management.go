@@ -256,6 +256,18 @@ func tableAzureAPIManagement(_ context.Context) *plugin.Table {
 				Description: "A list of availability zones denoting where the resource needs to come from.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "system_assigned_principal_id",
+				Description: "The principal ID of the system-assigned managed identity of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityPrincipalID),
+			},
+			{
+				Name:        "user_assigned_identity_ids",
+				Description: "The resource IDs of the user-assigned managed identities associated with the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityUserAssignedIDs),
+			},
 
 			// Steampipe standard columns
 			{
@@ -0,0 +1,223 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/apimanagement/mgmt/apimanagement"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAPIManagementCertificate(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_api_management_certificate",
+		Description: "Azure API Management Certificate",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group", "service_name"}),
+			Hydrate:    getAPIManagementCertificate,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listAPIManagements,
+			Hydrate:       listAPIManagementCertificates,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:      "service_name",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+				{
+					Name:      "resource_group",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The identifier of the certificate.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the certificate.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "service_name",
+				Description: "The name of the API management service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the certificate.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "subject",
+				Description: "The subject attribute of the certificate.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CertificateContractProperties.Subject"),
+			},
+			{
+				Name:        "thumbprint",
+				Description: "The thumbprint of the certificate.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CertificateContractProperties.Thumbprint"),
+			},
+			{
+				Name:        "expiration_date",
+				Description: "The expiration date of the certificate.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CertificateContractProperties.ExpirationDate").Transform(convertDateToTime),
+			},
+			{
+				Name:        "key_vault_secret_identifier",
+				Description: "The identifier of the Key Vault secret the certificate is fetched from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CertificateContractProperties.KeyVault.SecretIdentifier"),
+			},
+			{
+				Name:        "key_vault_identity_client_id",
+				Description: "The client ID of the user-assigned managed identity used to access the Key Vault secret.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CertificateContractProperties.KeyVault.IdentityClientID"),
+			},
+			{
+				Name:        "key_vault_last_status",
+				Description: "The status of the last synchronization of the certificate from the Key Vault secret.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CertificateContractProperties.KeyVault.LastStatus"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type CertificateWithServiceName struct {
+	apimanagement.CertificateContract
+	ServiceName string
+}
+
+//// LIST FUNCTION
+
+func listAPIManagementCertificates(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	serviceInfo := h.Item.(apimanagement.ServiceResource)
+	serviceName := *serviceInfo.Name
+	resourceGroup := strings.Split(*serviceInfo.ID, "/")[4]
+
+	if d.EqualsQualString("service_name") != "" && d.EqualsQualString("service_name") != serviceName {
+		return nil, nil
+	}
+	if d.EqualsQualString("resource_group") != "" && d.EqualsQualString("resource_group") != resourceGroup {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_api_management_certificate.listAPIManagementCertificates", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := apimanagement.NewCertificateClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListByService(ctx, resourceGroup, serviceName, "", nil, nil, nil)
+	if err != nil {
+		// API throws error during the resource creation with status code 400.
+		if strings.Contains(err.Error(), "API Management service is activating") {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("azure_api_management_certificate.listAPIManagementCertificates", "api_error", err)
+		return nil, err
+	}
+
+	for _, certificate := range result.Values() {
+		d.StreamListItem(ctx, &CertificateWithServiceName{certificate, serviceName})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_api_management_certificate.listAPIManagementCertificates", "api_paging_error", err)
+			return nil, err
+		}
+
+		for _, certificate := range result.Values() {
+			d.StreamListItem(ctx, &CertificateWithServiceName{certificate, serviceName})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAPIManagementCertificate(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	serviceName := d.EqualsQualString("service_name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if name == "" || serviceName == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_api_management_certificate.getAPIManagementCertificate", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := apimanagement.NewCertificateClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, serviceName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_api_management_certificate.getAPIManagementCertificate", "api_error", err)
+		return nil, err
+	}
+
+	return &CertificateWithServiceName{op, serviceName}, nil
+}
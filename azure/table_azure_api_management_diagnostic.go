@@ -0,0 +1,241 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/apimanagement/mgmt/apimanagement"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAPIManagementDiagnostic(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_api_management_diagnostic",
+		Description: "Azure API Management Diagnostic",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group", "service_name"}),
+			Hydrate:    getAPIManagementDiagnostic,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listAPIManagements,
+			Hydrate:       listAPIManagementDiagnostics,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:      "service_name",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+				{
+					Name:      "resource_group",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The identifier of the diagnostic.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the diagnostic.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "service_name",
+				Description: "The name of the API management service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the diagnostic.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "logger_id",
+				Description: "The resource ID of the target logger.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DiagnosticContractProperties.LoggerID"),
+			},
+			{
+				Name:        "always_log",
+				Description: "Specifies for what type of messages sampling settings should not apply. Possible values include: 'AllErrors'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DiagnosticContractProperties.AlwaysLog").Transform(transform.ToString),
+			},
+			{
+				Name:        "log_client_ip",
+				Description: "Indicates whether the client IP address is logged.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("DiagnosticContractProperties.LogClientIP"),
+			},
+			{
+				Name:        "verbosity",
+				Description: "The verbosity level applied to traces emitted by trace policies. Possible values include: 'verbose', 'information', 'error'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DiagnosticContractProperties.Verbosity").Transform(transform.ToString),
+			},
+			{
+				Name:        "http_correlation_protocol",
+				Description: "The correlation protocol used for Application Insights diagnostics. Possible values include: 'None', 'Legacy', 'W3C'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DiagnosticContractProperties.HTTPCorrelationProtocol").Transform(transform.ToString),
+			},
+			{
+				Name:        "operation_name_format",
+				Description: "The format of the operation name for Application Insights telemetries. Possible values include: 'Name', 'URL'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DiagnosticContractProperties.OperationNameFormat").Transform(transform.ToString),
+			},
+			{
+				Name:        "sampling",
+				Description: "The sampling settings of the diagnostic.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DiagnosticContractProperties.Sampling"),
+			},
+			{
+				Name:        "frontend",
+				Description: "The diagnostic settings for incoming and outgoing HTTP messages to the gateway.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DiagnosticContractProperties.Frontend"),
+			},
+			{
+				Name:        "backend",
+				Description: "The diagnostic settings for incoming and outgoing HTTP messages to the backend.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DiagnosticContractProperties.Backend"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type DiagnosticWithServiceName struct {
+	apimanagement.DiagnosticContract
+	ServiceName string
+}
+
+//// LIST FUNCTION
+
+func listAPIManagementDiagnostics(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	serviceInfo := h.Item.(apimanagement.ServiceResource)
+	serviceName := *serviceInfo.Name
+	resourceGroup := strings.Split(*serviceInfo.ID, "/")[4]
+
+	if d.EqualsQualString("service_name") != "" && d.EqualsQualString("service_name") != serviceName {
+		return nil, nil
+	}
+	if d.EqualsQualString("resource_group") != "" && d.EqualsQualString("resource_group") != resourceGroup {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_api_management_diagnostic.listAPIManagementDiagnostics", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := apimanagement.NewDiagnosticClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListByService(ctx, resourceGroup, serviceName, "", nil, nil)
+	if err != nil {
+		// API throws error during the resource creation with status code 400.
+		if strings.Contains(err.Error(), "API Management service is activating") {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("azure_api_management_diagnostic.listAPIManagementDiagnostics", "api_error", err)
+		return nil, err
+	}
+
+	for _, diagnostic := range result.Values() {
+		d.StreamListItem(ctx, &DiagnosticWithServiceName{diagnostic, serviceName})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_api_management_diagnostic.listAPIManagementDiagnostics", "api_paging_error", err)
+			return nil, err
+		}
+
+		for _, diagnostic := range result.Values() {
+			d.StreamListItem(ctx, &DiagnosticWithServiceName{diagnostic, serviceName})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAPIManagementDiagnostic(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	serviceName := d.EqualsQualString("service_name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if name == "" || serviceName == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_api_management_diagnostic.getAPIManagementDiagnostic", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := apimanagement.NewDiagnosticClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, serviceName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_api_management_diagnostic.getAPIManagementDiagnostic", "api_error", err)
+		return nil, err
+	}
+
+	return &DiagnosticWithServiceName{op, serviceName}, nil
+}
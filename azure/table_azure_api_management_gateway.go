@@ -0,0 +1,274 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/apimanagement/mgmt/apimanagement"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAPIManagementGateway(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_api_management_gateway",
+		Description: "Azure API Management Gateway",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group", "service_name"}),
+			Hydrate:    getAPIManagementGateway,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listAPIManagements,
+			Hydrate:       listAPIManagementGateways,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:      "service_name",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+				{
+					Name:      "resource_group",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The identifier of the gateway.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the gateway.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "service_name",
+				Description: "The name of the API management service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the gateway.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the gateway.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GatewayContractProperties.Description"),
+			},
+			{
+				Name:        "location_name",
+				Description: "The name of the location where the gateway is deployed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GatewayContractProperties.LocationData.Name"),
+			},
+			{
+				Name:        "location_city",
+				Description: "The city where the gateway is deployed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GatewayContractProperties.LocationData.City"),
+			},
+			{
+				Name:        "location_district",
+				Description: "The district, state or province where the gateway is deployed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GatewayContractProperties.LocationData.District"),
+			},
+			{
+				Name:        "location_country_or_region",
+				Description: "The country or region where the gateway is deployed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GatewayContractProperties.LocationData.CountryOrRegion"),
+			},
+			{
+				Name:        "apis",
+				Description: "The APIs linked to the gateway.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listAPIManagementGatewayAPIs,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type GatewayWithServiceName struct {
+	apimanagement.GatewayContract
+	ServiceName string
+}
+
+//// LIST FUNCTION
+
+func listAPIManagementGateways(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	serviceInfo := h.Item.(apimanagement.ServiceResource)
+	serviceName := *serviceInfo.Name
+	resourceGroup := strings.Split(*serviceInfo.ID, "/")[4]
+
+	if d.EqualsQualString("service_name") != "" && d.EqualsQualString("service_name") != serviceName {
+		return nil, nil
+	}
+	if d.EqualsQualString("resource_group") != "" && d.EqualsQualString("resource_group") != resourceGroup {
+		return nil, nil
+	}
+
+	// Self-hosted gateways are only available in the Developer and Premium tiers
+	if serviceInfo.Sku == nil || (serviceInfo.Sku.Name != apimanagement.SkuTypeDeveloper && serviceInfo.Sku.Name != apimanagement.SkuTypePremium) {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_api_management_gateway.listAPIManagementGateways", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := apimanagement.NewGatewayClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListByService(ctx, resourceGroup, serviceName, "", nil, nil)
+	if err != nil {
+		// API throws error during the resource creation with status code 400.
+		if strings.Contains(err.Error(), "API Management service is activating") {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("azure_api_management_gateway.listAPIManagementGateways", "api_error", err)
+		return nil, err
+	}
+
+	for _, gateway := range result.Values() {
+		d.StreamListItem(ctx, &GatewayWithServiceName{gateway, serviceName})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_api_management_gateway.listAPIManagementGateways", "api_paging_error", err)
+			return nil, err
+		}
+
+		for _, gateway := range result.Values() {
+			d.StreamListItem(ctx, &GatewayWithServiceName{gateway, serviceName})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAPIManagementGateway(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	serviceName := d.EqualsQualString("service_name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if name == "" || serviceName == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_api_management_gateway.getAPIManagementGateway", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := apimanagement.NewGatewayClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, serviceName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_api_management_gateway.getAPIManagementGateway", "api_error", err)
+		return nil, err
+	}
+
+	return &GatewayWithServiceName{op, serviceName}, nil
+}
+
+func listAPIManagementGatewayAPIs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	gateway := h.Item.(*GatewayWithServiceName)
+	resourceGroup := strings.Split(*gateway.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_api_management_gateway.listAPIManagementGatewayAPIs", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := apimanagement.NewGatewayAPIClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListByServiceComplete(ctx, resourceGroup, gateway.ServiceName, *gateway.Name, "", nil, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_api_management_gateway.listAPIManagementGatewayAPIs", "api_error", err)
+		return nil, err
+	}
+
+	var apis []map[string]interface{}
+	for result.NotDone() {
+		api := result.Value()
+		objectMap := map[string]interface{}{
+			"id":   api.ID,
+			"name": api.Name,
+		}
+		if api.APIContractProperties != nil {
+			objectMap["displayName"] = api.DisplayName
+			objectMap["path"] = api.Path
+			objectMap["protocols"] = api.Protocols
+			objectMap["apiRevision"] = api.APIRevision
+			objectMap["isCurrent"] = api.IsCurrent
+		}
+		apis = append(apis, objectMap)
+
+		if err := result.NextWithContext(ctx); err != nil {
+			plugin.Logger(ctx).Error("azure_api_management_gateway.listAPIManagementGatewayAPIs", "api_paging_error", err)
+			return nil, err
+		}
+	}
+
+	return apis, nil
+}
@@ -0,0 +1,217 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/apimanagement/mgmt/apimanagement"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAPIManagementLogger(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_api_management_logger",
+		Description: "Azure API Management Logger",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group", "service_name"}),
+			Hydrate:    getAPIManagementLogger,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listAPIManagements,
+			Hydrate:       listAPIManagementLoggers,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:      "service_name",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+				{
+					Name:      "resource_group",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The identifier of the logger.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the logger.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "service_name",
+				Description: "The name of the API management service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the logger.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "logger_type",
+				Description: "The type of the logger. Possible values include: 'AzureEventHub', 'ApplicationInsights', 'AzureMonitor'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LoggerContractProperties.LoggerType").Transform(transform.ToString),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the logger.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LoggerContractProperties.Description"),
+			},
+			{
+				Name:        "is_buffered",
+				Description: "Indicates whether records are buffered in the logger before publishing.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("LoggerContractProperties.IsBuffered"),
+			},
+			{
+				Name:        "resource_id",
+				Description: "The Azure resource ID of the log target, either an Event Hub or an Application Insights resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LoggerContractProperties.ResourceID"),
+			},
+			{
+				Name:        "credentials",
+				Description: "The name and connection string of the event hub, or the instrumentation key of the Application Insights resource. Secrets are returned as references to named values.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LoggerContractProperties.Credentials"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type LoggerWithServiceName struct {
+	apimanagement.LoggerContract
+	ServiceName string
+}
+
+//// LIST FUNCTION
+
+func listAPIManagementLoggers(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	serviceInfo := h.Item.(apimanagement.ServiceResource)
+	serviceName := *serviceInfo.Name
+	resourceGroup := strings.Split(*serviceInfo.ID, "/")[4]
+
+	if d.EqualsQualString("service_name") != "" && d.EqualsQualString("service_name") != serviceName {
+		return nil, nil
+	}
+	if d.EqualsQualString("resource_group") != "" && d.EqualsQualString("resource_group") != resourceGroup {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_api_management_logger.listAPIManagementLoggers", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := apimanagement.NewLoggerClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListByService(ctx, resourceGroup, serviceName, "", nil, nil)
+	if err != nil {
+		// API throws error during the resource creation with status code 400.
+		if strings.Contains(err.Error(), "API Management service is activating") {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("azure_api_management_logger.listAPIManagementLoggers", "api_error", err)
+		return nil, err
+	}
+
+	for _, logger := range result.Values() {
+		d.StreamListItem(ctx, &LoggerWithServiceName{logger, serviceName})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_api_management_logger.listAPIManagementLoggers", "api_paging_error", err)
+			return nil, err
+		}
+
+		for _, logger := range result.Values() {
+			d.StreamListItem(ctx, &LoggerWithServiceName{logger, serviceName})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAPIManagementLogger(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	serviceName := d.EqualsQualString("service_name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if name == "" || serviceName == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_api_management_logger.getAPIManagementLogger", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := apimanagement.NewLoggerClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, serviceName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_api_management_logger.getAPIManagementLogger", "api_error", err)
+		return nil, err
+	}
+
+	return &LoggerWithServiceName{op, serviceName}, nil
+}
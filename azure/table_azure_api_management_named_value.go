@@ -0,0 +1,230 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/apimanagement/mgmt/apimanagement"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAPIManagementNamedValue(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_api_management_named_value",
+		Description: "Azure API Management Named Value",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group", "service_name"}),
+			Hydrate:    getAPIManagementNamedValue,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listAPIManagements,
+			Hydrate:       listAPIManagementNamedValues,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:      "service_name",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+				{
+					Name:      "resource_group",
+					Require:   plugin.Optional,
+					Operators: []string{"="},
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The identifier of the named value.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the named value.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "service_name",
+				Description: "The name of the API management service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the named value.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "display_name",
+				Description: "The unique name of the named value, used to reference it in policies.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NamedValueContractProperties.DisplayName"),
+			},
+			{
+				Name:        "secret",
+				Description: "Indicates whether the value is a secret and is stored encrypted.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("NamedValueContractProperties.Secret"),
+				Default:     false,
+			},
+			{
+				Name:        "value",
+				Description: "The value of the named value. Secret values are never returned.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NamedValueContractProperties.Value"),
+			},
+			{
+				Name:        "key_vault_secret_identifier",
+				Description: "The identifier of the Key Vault secret the value is fetched from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NamedValueContractProperties.KeyVault.SecretIdentifier"),
+			},
+			{
+				Name:        "key_vault_identity_client_id",
+				Description: "The client ID of the user-assigned managed identity used to access the Key Vault secret.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NamedValueContractProperties.KeyVault.IdentityClientID"),
+			},
+			{
+				Name:        "key_vault_last_status",
+				Description: "The status of the last synchronization of the value from the Key Vault secret.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("NamedValueContractProperties.KeyVault.LastStatus"),
+			},
+			{
+				Name:        "named_value_tags",
+				Description: "The tags of the named value, used to filter the list of named values.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("NamedValueContractProperties.Tags"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NamedValueContractProperties.DisplayName", "Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type NamedValueWithServiceName struct {
+	apimanagement.NamedValueContract
+	ServiceName string
+}
+
+//// LIST FUNCTION
+
+func listAPIManagementNamedValues(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	serviceInfo := h.Item.(apimanagement.ServiceResource)
+	serviceName := *serviceInfo.Name
+	resourceGroup := strings.Split(*serviceInfo.ID, "/")[4]
+
+	if d.EqualsQualString("service_name") != "" && d.EqualsQualString("service_name") != serviceName {
+		return nil, nil
+	}
+	if d.EqualsQualString("resource_group") != "" && d.EqualsQualString("resource_group") != resourceGroup {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_api_management_named_value.listAPIManagementNamedValues", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := apimanagement.NewNamedValueClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListByService(ctx, resourceGroup, serviceName, "", nil, nil, nil)
+	if err != nil {
+		// API throws error during the resource creation with status code 400.
+		if strings.Contains(err.Error(), "API Management service is activating") {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("azure_api_management_named_value.listAPIManagementNamedValues", "api_error", err)
+		return nil, err
+	}
+
+	for _, namedValue := range result.Values() {
+		d.StreamListItem(ctx, &NamedValueWithServiceName{namedValue, serviceName})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_api_management_named_value.listAPIManagementNamedValues", "api_paging_error", err)
+			return nil, err
+		}
+
+		for _, namedValue := range result.Values() {
+			d.StreamListItem(ctx, &NamedValueWithServiceName{namedValue, serviceName})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAPIManagementNamedValue(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	serviceName := d.EqualsQualString("service_name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if name == "" || serviceName == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_api_management_named_value.getAPIManagementNamedValue", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := apimanagement.NewNamedValueClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, serviceName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_api_management_named_value.getAPIManagementNamedValue", "api_error", err)
+		return nil, err
+	}
+
+	return &NamedValueWithServiceName{op, serviceName}, nil
+}
@@ -2,6 +2,7 @@ package azure
 
 import (
 	"context"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/web/mgmt/web"
 	"github.com/turbot/go-kit/types"
@@ -32,6 +33,10 @@ func tableAzureAppServiceWebApp(_ context.Context) *plugin.Table {
 				Func:    getAppServiceWebAppVnetConnection,
 				Depends: []plugin.HydrateFunc{getAppServiceWebAppSiteConfiguration},
 			},
+			{
+				Func:    getAppServiceWebAppPublicNetworkExposure,
+				Depends: []plugin.HydrateFunc{getAppServiceWebAppSiteConfiguration, listAppServiceWebAppPrivateEndpointConnections},
+			},
 		},
 		Columns: azureColumns([]*plugin.Column{
 			{
@@ -103,6 +108,24 @@ func tableAzureAppServiceWebApp(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.From(webAppIdentity),
 			},
+			{
+				Name:        "identity_type",
+				Description: "The type of managed identity used by the resource. Possible values include: 'SystemAssigned', 'UserAssigned', 'SystemAssigned, UserAssigned', 'None'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityType),
+			},
+			{
+				Name:        "system_assigned_principal_id",
+				Description: "The principal ID of the system-assigned managed identity of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityPrincipalID),
+			},
+			{
+				Name:        "user_assigned_identity_ids",
+				Description: "The resource IDs of the user-assigned managed identities associated with the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityUserAssignedIDs),
+			},
 			{
 				Name:        "outbound_ip_addresses",
 				Description: "List of IP addresses that the app uses for outbound connections (e.g. database access).",
@@ -168,6 +191,20 @@ func tableAzureAppServiceWebApp(_ context.Context) *plugin.Table {
 				Hydrate:     getAppServiceWebAppVnetConnection,
 				Transform:   transform.FromValue(),
 			},
+			{
+				Name:        "private_endpoint_connections",
+				Description: "The private endpoint connections of the app.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listAppServiceWebAppPrivateEndpointConnections,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "public_network_exposed",
+				Description: "Indicates whether the app is reachable from any address on the internet, derived from the public network access setting, the firewall default action and the presence of private endpoints.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getAppServiceWebAppPublicNetworkExposure,
+				Transform:   transform.FromValue(),
+			},
 
 			// Steampipe standard columns
 			{
@@ -407,6 +444,79 @@ func getAppServiceWebAppVnetConnection(ctx context.Context, d *plugin.QueryData,
 	return nil, nil
 }
 
+func listAppServiceWebAppPrivateEndpointConnections(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	data := h.Item.(web.Site)
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_app_service_web_app.listAppServiceWebAppPrivateEndpointConnections", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	webClient := web.NewAppsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	webClient.Authorizer = session.Authorizer
+
+	var connections []web.RemotePrivateEndpointConnectionARMResource
+	result, err := webClient.GetPrivateEndpointConnectionListComplete(ctx, *data.SiteProperties.ResourceGroup, *data.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_app_service_web_app.listAppServiceWebAppPrivateEndpointConnections", "api_error", err)
+		return nil, err
+	}
+	for result.NotDone() {
+		connections = append(connections, result.Value())
+		if err := result.NextWithContext(ctx); err != nil {
+			plugin.Logger(ctx).Error("azure_app_service_web_app.listAppServiceWebAppPrivateEndpointConnections", "api_paging_error", err)
+			return nil, err
+		}
+	}
+
+	return connections, nil
+}
+
+// The app allows all networks unless its access restrictions only allow
+// specific addresses, subnets or service tags
+func getAppServiceWebAppPublicNetworkExposure(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Web App Site Configuration will be nil if getAppServiceWebAppSiteConfiguration returned an error but
+	// was ignored through ignore_error_codes config arg
+	if h.HydrateResults["getAppServiceWebAppSiteConfiguration"] == nil {
+		return nil, nil
+	}
+	config := h.HydrateResults["getAppServiceWebAppSiteConfiguration"].(web.SiteConfigResource)
+	if config.SiteConfig == nil {
+		return nil, nil
+	}
+
+	allowsAllNetworks := config.IPSecurityRestrictions == nil || len(*config.IPSecurityRestrictions) == 0
+	if config.IPSecurityRestrictions != nil {
+		for _, restriction := range *config.IPSecurityRestrictions {
+			if restriction.IPAddress != nil && strings.EqualFold(*restriction.IPAddress, "Any") &&
+				restriction.Action != nil && strings.EqualFold(*restriction.Action, "Allow") {
+				allowsAllNetworks = true
+				break
+			}
+		}
+	}
+
+	hasPrivateEndpoint := false
+	if connections, ok := h.HydrateResults["listAppServiceWebAppPrivateEndpointConnections"].([]web.RemotePrivateEndpointConnectionARMResource); ok {
+		hasPrivateEndpoint = len(connections) > 0
+	}
+
+	publicNetworkAccess := ""
+	if config.PublicNetworkAccess != nil {
+		publicNetworkAccess = *config.PublicNetworkAccess
+	}
+
+	// App Service disables public access implicitly when the app has private endpoints
+	// and public network access is not set explicitly
+	if publicNetworkAccess == "" && hasPrivateEndpoint {
+		return false, nil
+	}
+
+	return isPublicNetworkExposed(publicNetworkAccess, allowsAllNetworks), nil
+}
+
 func getWebAppDiagnosticLogsConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	data := h.Item.(web.Site)
 
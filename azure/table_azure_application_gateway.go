@@ -165,6 +165,24 @@ func tableAzureApplicationGateway(_ context.Context) *plugin.Table {
 				Description: "The identity of the application gateway, if configured.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "identity_type",
+				Description: "The type of managed identity used by the resource. Possible values include: 'SystemAssigned', 'UserAssigned', 'SystemAssigned, UserAssigned', 'None'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityType),
+			},
+			{
+				Name:        "system_assigned_principal_id",
+				Description: "The principal ID of the system-assigned managed identity of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityPrincipalID),
+			},
+			{
+				Name:        "user_assigned_identity_ids",
+				Description: "The resource IDs of the user-assigned managed identities associated with the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityUserAssignedIDs),
+			},
 			{
 				Name:        "private_endpoint_connections",
 				Description: "Private endpoint connections on application gateway.",
@@ -96,6 +96,34 @@ func tableAzureApAutomationAccount(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Sku.Capacity"),
 			},
+			{
+				Name:        "identity",
+				Description: "The managed identity of the account.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAutomationAccountIdentity,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "identity_type",
+				Description: "The type of managed identity used by the resource. Possible values include: 'SystemAssigned', 'UserAssigned', 'SystemAssigned, UserAssigned', 'None'.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAutomationAccountIdentity,
+				Transform:   transform.FromValue().Transform(extractManagedIdentityType),
+			},
+			{
+				Name:        "system_assigned_principal_id",
+				Description: "The principal ID of the system-assigned managed identity of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAutomationAccountIdentity,
+				Transform:   transform.FromValue().Transform(extractManagedIdentityPrincipalID),
+			},
+			{
+				Name:        "user_assigned_identity_ids",
+				Description: "The resource IDs of the user-assigned managed identities associated with the resource.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAutomationAccountIdentity,
+				Transform:   transform.FromValue().Transform(extractManagedIdentityUserAssignedIDs),
+			},
 
 			// Steampipe standard columns
 			{
@@ -211,3 +239,19 @@ func getAutomationAccount(ctx context.Context, d *plugin.QueryData, h *plugin.Hy
 
 	return nil, nil
 }
+
+// The SDK package of the Microsoft.Automation resource provider does not return the managed
+// identity of the account
+const automationAccountIdentityAPIVersion = "2023-11-01"
+
+func getAutomationAccountIdentity(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	account := h.Item.(automation.Account)
+
+	identity, err := getResourceManagerIdentity(ctx, d, *account.ID, automationAccountIdentityAPIVersion)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_automation_account.getAutomationAccountIdentity", "api_error", err)
+		return nil, err
+	}
+
+	return identity, nil
+}
@@ -0,0 +1,315 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAutomationChangeTracking(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_automation_change_tracking",
+		Description: "Azure Automation Change Tracking, the software, file, registry, service and daemon changes recorded by Change Tracking and Inventory on the machines connected to a Log Analytics workspace.",
+		List: &plugin.ListConfig{
+			Hydrate: listAutomationChangeTrackingRecords,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "workspace_id",
+					Require: plugin.Required,
+				},
+				{
+					Name:      "time_generated",
+					Require:   plugin.Optional,
+					Operators: []string{">", "<", ">=", "<="},
+				},
+				{
+					Name:    "config_change_type",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "computer",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "workspace_id",
+				Description: "The workspace (customer) ID of the Log Analytics workspace the change was recorded in.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("workspace_id"),
+			},
+			{
+				Name:        "time_generated",
+				Description: "The time the change was recorded. Defaults to the last 7 days if not specified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "computer",
+				Description: "The name of the machine the change was made on.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "config_change_type",
+				Description: "The type of the configuration item that changed. Possible values include: 'Software', 'Files', 'Registry', 'WindowsServices', 'Daemons'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "change_category",
+				Description: "The kind of change. Possible values include: 'Added', 'Removed', 'Modified'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "software_name",
+				Description: "The name of the software that changed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "software_type",
+				Description: "The type of the software that changed, for example 'Application' or 'Update'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "publisher",
+				Description: "The publisher of the software that changed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "previous",
+				Description: "The previous version of the software.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "current",
+				Description: "The current version of the software.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "svc_name",
+				Description: "The name of the service or daemon that changed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "svc_display_name",
+				Description: "The display name of the service that changed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "svc_state",
+				Description: "The current state of the service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "svc_previous_state",
+				Description: "The previous state of the service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "svc_startup_type",
+				Description: "The current startup type of the service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "svc_previous_startup_type",
+				Description: "The previous startup type of the service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "svc_account",
+				Description: "The account the service runs as.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "svc_path",
+				Description: "The path of the executable of the service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "file_system_path",
+				Description: "The path of the file that changed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "file_date_modified",
+				Description: "The time the file was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("DateModified"),
+			},
+			{
+				Name:        "file_size",
+				Description: "The size of the file, in bytes.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Size"),
+			},
+			{
+				Name:        "registry_key",
+				Description: "The registry key that changed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "value_name",
+				Description: "The name of the registry value that changed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "value_data",
+				Description: "The data of the registry value that changed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "value_type",
+				Description: "The type of the registry value that changed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "fields_changed",
+				Description: "The fields of the configuration item that changed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source_computer_id",
+				Description: "The unique ID of the machine the change was made on.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SourceComputerID"),
+			},
+			{
+				Name:        "resource_id",
+				Description: "The resource ID of the machine the change was made on.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceID"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Computer"),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+// Change tracking records are stored in the ConfigurationChange table of the workspace
+const changeTrackingQuery = `ConfigurationChange
+%s| project TimeGenerated, Computer, ConfigChangeType, ChangeCategory, SoftwareName, SoftwareType, Publisher, Previous, Current, SvcName, SvcDisplayName, SvcState, SvcPreviousState, SvcStartupType, SvcPreviousStartupType, SvcAccount, SvcPath, FileSystemPath, DateModified, Size, RegistryKey, ValueName, ValueData, ValueType, FieldsChanged, SourceComputerId, _ResourceId
+| sort by TimeGenerated desc`
+
+// The default time window of the query, if the time_generated column is not qualified
+const changeTrackingDefaultWindow = 7 * 24 * time.Hour
+
+type ChangeTrackingRecord struct {
+	TimeGenerated          *string `json:"TimeGenerated,omitempty"`
+	Computer               *string `json:"Computer,omitempty"`
+	ConfigChangeType       *string `json:"ConfigChangeType,omitempty"`
+	ChangeCategory         *string `json:"ChangeCategory,omitempty"`
+	SoftwareName           *string `json:"SoftwareName,omitempty"`
+	SoftwareType           *string `json:"SoftwareType,omitempty"`
+	Publisher              *string `json:"Publisher,omitempty"`
+	Previous               *string `json:"Previous,omitempty"`
+	Current                *string `json:"Current,omitempty"`
+	SvcName                *string `json:"SvcName,omitempty"`
+	SvcDisplayName         *string `json:"SvcDisplayName,omitempty"`
+	SvcState               *string `json:"SvcState,omitempty"`
+	SvcPreviousState       *string `json:"SvcPreviousState,omitempty"`
+	SvcStartupType         *string `json:"SvcStartupType,omitempty"`
+	SvcPreviousStartupType *string `json:"SvcPreviousStartupType,omitempty"`
+	SvcAccount             *string `json:"SvcAccount,omitempty"`
+	SvcPath                *string `json:"SvcPath,omitempty"`
+	FileSystemPath         *string `json:"FileSystemPath,omitempty"`
+	DateModified           *string `json:"DateModified,omitempty"`
+	Size                   *int64  `json:"Size,omitempty"`
+	RegistryKey            *string `json:"RegistryKey,omitempty"`
+	ValueName              *string `json:"ValueName,omitempty"`
+	ValueData              *string `json:"ValueData,omitempty"`
+	ValueType              *string `json:"ValueType,omitempty"`
+	FieldsChanged          *string `json:"FieldsChanged,omitempty"`
+	SourceComputerID       *string `json:"SourceComputerId,omitempty"`
+	ResourceID             *string `json:"_ResourceId,omitempty"`
+}
+
+//// LIST FUNCTION
+
+func listAutomationChangeTrackingRecords(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	workspaceID := d.EqualsQualString("workspace_id")
+	if workspaceID == "" {
+		return nil, nil
+	}
+
+	var filters string
+	if value := d.EqualsQualString("config_change_type"); value != "" {
+		filters += fmt.Sprintf("| where ConfigChangeType =~ '%s'\n", escapeKustoString(value))
+	}
+	if value := d.EqualsQualString("computer"); value != "" {
+		filters += fmt.Sprintf("| where Computer =~ '%s'\n", escapeKustoString(value))
+	}
+	query := fmt.Sprintf(changeTrackingQuery, filters)
+
+	// Limiting the results
+	if d.QueryContext.Limit != nil {
+		query += fmt.Sprintf("\n| take %d", *d.QueryContext.Limit)
+	}
+
+	var records []ChangeTrackingRecord
+	err := queryLogAnalyticsWorkspace(ctx, d, workspaceID, query, changeTrackingTimespan(d.Quals), &records)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_automation_change_tracking.listAutomationChangeTrackingRecords", "api_error", err)
+		return nil, err
+	}
+
+	for _, record := range records {
+		d.StreamListItem(ctx, record)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// changeTrackingTimespan returns the ISO 8601 interval of the time_generated quals, which defaults
+// to the last 7 days
+func changeTrackingTimespan(quals plugin.KeyColumnQualMap) string {
+	var from, to time.Time
+	if quals["time_generated"] != nil {
+		for _, q := range quals["time_generated"].Quals {
+			value := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case ">", ">=":
+				if from.IsZero() || value.After(from) {
+					from = value
+				}
+			case "<", "<=":
+				if to.IsZero() || value.Before(to) {
+					to = value
+				}
+			}
+		}
+	}
+
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if from.IsZero() {
+		from = to.Add(-changeTrackingDefaultWindow)
+	}
+	return from.UTC().Format(time.RFC3339) + "/" + to.UTC().Format(time.RFC3339)
+}
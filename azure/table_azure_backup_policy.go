@@ -2,6 +2,7 @@ package azure
 
 import (
 	"context"
+	"encoding/json"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/recoveryservices/mgmt/backup"
@@ -60,6 +61,42 @@ func tableAzureBackupPolicy(_ context.Context) *plugin.Table {
 				Description: "The name of the vault associated with the backup policy.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "backup_management_type",
+				Description: "The type of backup management of the policy, for example 'AzureIaasVM', 'AzureStorage', 'AzureWorkload', 'AzureSql' or 'MAB'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties").TransformP(extractBackupPolicyProperty, "backupManagementType"),
+			},
+			{
+				Name:        "protected_items_count",
+				Description: "The number of items backed up with the policy.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Properties").TransformP(extractBackupPolicyProperty, "protectedItemsCount"),
+			},
+			{
+				Name:        "instant_rp_retention_range_in_days",
+				Description: "The number of days the instant recovery points of virtual machine backups are retained.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Properties").TransformP(extractBackupPolicyProperty, "instantRpRetentionRangeInDays"),
+			},
+			{
+				Name:        "schedule_policy",
+				Description: "The backup schedule of the policy.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties").TransformP(extractBackupPolicyProperty, "schedulePolicy"),
+			},
+			{
+				Name:        "retention_policy",
+				Description: "The retention ranges of the backup copies of the policy, with the daily, weekly, monthly and yearly schedules.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties").TransformP(extractBackupPolicyProperty, "retentionPolicy"),
+			},
+			{
+				Name:        "sub_protection_policy",
+				Description: "The schedule and retention of each backup type of workload policies, like full, differential and log backups of SQL databases.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties").TransformP(extractBackupPolicyProperty, "subProtectionPolicy"),
+			},
 			{
 				Name:        "tags",
 				Description: "The resource tags.",
@@ -245,3 +282,23 @@ func listBackupPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 	}
 	return nil, err
 }
+
+//// TRANSFORM FUNCTIONS
+
+// The properties of a policy depend on its backup management type, so they are read from its JSON representation
+func extractBackupPolicyProperty(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	policy, ok := d.Value.(backup.BasicProtectionPolicy)
+	if !ok || policy == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(policy)
+	if err != nil {
+		return nil, err
+	}
+	var properties map[string]interface{}
+	if err := json.Unmarshal(data, &properties); err != nil {
+		return nil, err
+	}
+	return properties[d.Param.(string)], nil
+}
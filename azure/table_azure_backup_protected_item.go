@@ -0,0 +1,348 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/recoveryservices/mgmt/recoveryservices"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/recoveryservices/armrecoveryservicesbackup/v3"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureBackupProtectedItem(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_backup_protected_item",
+		Description: "Azure Backup Protected Item, the virtual machines, file shares, databases and other items backed up to a Recovery Services vault.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listRecoveryServicesVaults,
+			Hydrate:       listBackupProtectedItems,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "404"}),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "vault_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "resource_group",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the protected item.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the protected item.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the protected item.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vault_name",
+				Description: "The name of the Recovery Services vault the item is backed up to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "friendly_name",
+				Description: "The friendly name of the protected item.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.FriendlyName"),
+			},
+			{
+				Name:        "protected_item_type",
+				Description: "The backup item type, for example 'Microsoft.Compute/virtualMachines', 'AzureFileShareProtectedItem' or 'AzureVmWorkloadSQLDatabase'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ProtectedItemType"),
+			},
+			{
+				Name:        "backup_management_type",
+				Description: "The type of backup management for the item. Possible values include: 'AzureIaasVM', 'AzureStorage', 'AzureWorkload', 'MAB', 'DPM', 'AzureBackupServer', 'AzureSql'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.BackupManagementType"),
+			},
+			{
+				Name:        "workload_type",
+				Description: "The type of workload the item represents, for example 'VM', 'AzureFileShare' or 'SQLDataBase'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.WorkloadType"),
+			},
+			{
+				Name:        "source_resource_id",
+				Description: "The resource ID of the resource that is backed up.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.SourceResourceID"),
+			},
+			{
+				Name:        "virtual_machine_id",
+				Description: "The resource ID of the virtual machine, for virtual machine items.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.VirtualMachineID"),
+			},
+			{
+				Name:        "container_name",
+				Description: "The unique name of the container of the item.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ContainerName"),
+			},
+			{
+				Name:        "policy_id",
+				Description: "The ID of the backup policy the item is backed up with.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.PolicyID"),
+			},
+			{
+				Name:        "policy_name",
+				Description: "The name of the backup policy the item is backed up with.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.PolicyName"),
+			},
+			{
+				Name:        "protection_state",
+				Description: "The backup state of the item. Possible values include: 'IRPending', 'Protected', 'ProtectionError', 'ProtectionStopped', 'ProtectionPaused'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ProtectionState"),
+			},
+			{
+				Name:        "protection_status",
+				Description: "The backup status of the item.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ProtectionStatus"),
+			},
+			{
+				Name:        "health_status",
+				Description: "The health status of the item. Possible values include: 'Passed', 'ActionRequired', 'ActionSuggested', 'Healthy', 'Unhealthy', 'NotReachable'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(backupProtectedItemHealthStatus),
+			},
+			{
+				Name:        "last_backup_status",
+				Description: "The status of the last backup operation of the item.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.LastBackupStatus"),
+			},
+			{
+				Name:        "last_backup_time",
+				Description: "The time of the last backup operation of the item.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.LastBackupTime"),
+			},
+			{
+				Name:        "last_recovery_point",
+				Description: "The time the latest backup copy of the item was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.LastRecoveryPoint"),
+			},
+			{
+				Name:        "is_scheduled_for_deferred_delete",
+				Description: "Indicates whether the backup data of the item is scheduled for deferred (soft) delete.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.IsScheduledForDeferredDelete"),
+			},
+			{
+				Name:        "deferred_delete_time_in_utc",
+				Description: "The time the item was marked for deferred delete.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.DeferredDeleteTimeInUTC"),
+			},
+			{
+				Name:        "is_archive_enabled",
+				Description: "Indicates whether the item is protected in the archive tier.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.IsArchiveEnabled"),
+			},
+			{
+				Name:        "etag",
+				Description: "An unique read-only string that changes whenever the resource is updated.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ETag"),
+			},
+			{
+				Name:        "properties",
+				Description: "The properties of the protected item, which depend on the backup management type of the item.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("RawProperties"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type BackupProtectedItemInfo struct {
+	VaultName     *string
+	ETag          *string
+	Location      *string
+	Properties    *BackupProtectedItemProperties
+	RawProperties map[string]interface{}
+	Tags          map[string]*string
+	ID            *string
+	Name          *string
+	Type          *string
+}
+
+// The properties of a protected item are polymorphic on its backup management type, the properties
+// shared by most of the item types are decoded from their JSON representation
+type BackupProtectedItemProperties struct {
+	ProtectedItemType            *string `json:"protectedItemType,omitempty"`
+	FriendlyName                 *string `json:"friendlyName,omitempty"`
+	BackupManagementType         *string `json:"backupManagementType,omitempty"`
+	WorkloadType                 *string `json:"workloadType,omitempty"`
+	SourceResourceID             *string `json:"sourceResourceId,omitempty"`
+	VirtualMachineID             *string `json:"virtualMachineId,omitempty"`
+	ContainerName                *string `json:"containerName,omitempty"`
+	PolicyID                     *string `json:"policyId,omitempty"`
+	PolicyName                   *string `json:"policyName,omitempty"`
+	ProtectionState              *string `json:"protectionState,omitempty"`
+	ProtectionStatus             *string `json:"protectionStatus,omitempty"`
+	HealthStatus                 *string `json:"healthStatus,omitempty"`
+	ProtectedItemHealthStatus    *string `json:"protectedItemHealthStatus,omitempty"`
+	LastBackupStatus             *string `json:"lastBackupStatus,omitempty"`
+	LastBackupTime               *string `json:"lastBackupTime,omitempty"`
+	LastRecoveryPoint            *string `json:"lastRecoveryPoint,omitempty"`
+	IsScheduledForDeferredDelete *bool   `json:"isScheduledForDeferredDelete,omitempty"`
+	DeferredDeleteTimeInUTC      *string `json:"deferredDeleteTimeInUTC,omitempty"`
+	IsArchiveEnabled             *bool   `json:"isArchiveEnabled,omitempty"`
+}
+
+//// LIST FUNCTION
+
+func listBackupProtectedItems(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	vault := h.Item.(recoveryservices.Vault)
+	resourceGroup := strings.Split(*vault.ID, "/")[4]
+
+	vaultName := d.EqualsQualString("vault_name")
+	rgName := d.EqualsQualString("resource_group")
+
+	if vaultName != "" && vaultName != *vault.Name {
+		return nil, nil
+	}
+	if rgName != "" && !strings.EqualFold(rgName, resourceGroup) {
+		return nil, nil
+	}
+
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_backup_protected_item.listBackupProtectedItems", "session_error", err)
+		return nil, err
+	}
+
+	client, err := armrecoveryservicesbackup.NewBackupProtectedItemsClient(session.SubscriptionID, session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_backup_protected_item.listBackupProtectedItems", "client_error", err)
+		return nil, err
+	}
+
+	pager := client.NewListPager(*vault.Name, resourceGroup, nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_backup_protected_item.listBackupProtectedItems", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range page.Value {
+			info, err := newBackupProtectedItemInfo(item, vault.Name)
+			if err != nil {
+				plugin.Logger(ctx).Error("azure_backup_protected_item.listBackupProtectedItems", "unmarshal_error", err)
+				return nil, err
+			}
+			d.StreamListItem(ctx, info)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// Virtual machine items report a health status, while workload items report a protected item health status
+func backupProtectedItemHealthStatus(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(BackupProtectedItemInfo)
+	if data.Properties == nil {
+		return nil, nil
+	}
+	if data.Properties.HealthStatus != nil {
+		return data.Properties.HealthStatus, nil
+	}
+	return data.Properties.ProtectedItemHealthStatus, nil
+}
+
+//// UTILITY FUNCTIONS
+
+func newBackupProtectedItemInfo(item *armrecoveryservicesbackup.ProtectedItemResource, vaultName *string) (BackupProtectedItemInfo, error) {
+	info := BackupProtectedItemInfo{
+		VaultName: vaultName,
+		ETag:      item.ETag,
+		Location:  item.Location,
+		Tags:      item.Tags,
+		ID:        item.ID,
+		Name:      item.Name,
+		Type:      item.Type,
+	}
+	if item.Properties == nil {
+		return info, nil
+	}
+
+	data, err := json.Marshal(item.Properties)
+	if err != nil {
+		return info, err
+	}
+	if err := json.Unmarshal(data, &info.Properties); err != nil {
+		return info, err
+	}
+	if err := json.Unmarshal(data, &info.RawProperties); err != nil {
+		return info, err
+	}
+	return info, nil
+}
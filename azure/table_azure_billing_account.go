@@ -0,0 +1,200 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/billing/mgmt/2020-05-01-preview/billing"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// The details of the Enterprise Agreement accounts are only returned if expanded
+const billingAccountExpand = "soldTo,enrollmentDetails,departments,enrollmentAccounts"
+
+//// TABLE DEFINITION
+
+func tableAzureBillingAccount(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_billing_account",
+		Description: "Azure Billing Account",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getBillingAccount,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "BillingAccountNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listBillingAccounts,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the billing account.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the billing account.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the billing account.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccountProperties.DisplayName"),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource (Microsoft.Billing/billingAccounts).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "agreement_type",
+				Description: "The type of agreement of the billing account. Possible values include: 'MicrosoftCustomerAgreement', 'EnterpriseAgreement', 'MicrosoftOnlineServicesProgram', 'MicrosoftPartnerAgreement'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccountProperties.AgreementType").Transform(transform.ToString),
+			},
+			{
+				Name:        "account_type",
+				Description: "The type of customer of the billing account. Possible values include: 'Enterprise', 'Individual', 'Partner'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccountProperties.AccountType").Transform(transform.ToString),
+			},
+			{
+				Name:        "account_status",
+				Description: "The status of the billing account. Possible values include: 'Active', 'Deleted', 'Disabled', 'Expired', 'Transferred', 'Extended', 'Terminated'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccountProperties.AccountStatus").Transform(transform.ToString),
+			},
+			{
+				Name:        "has_read_access",
+				Description: "Indicates whether the user has read access to the billing account.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("AccountProperties.HasReadAccess"),
+			},
+			{
+				Name:        "sold_to",
+				Description: "The address of the individual or organization responsible for the billing account.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AccountProperties.SoldTo"),
+			},
+			{
+				Name:        "enrollment_details",
+				Description: "The details of the enrollment, for the Enterprise Agreement billing accounts.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AccountProperties.EnrollmentDetails"),
+			},
+			{
+				Name:        "departments",
+				Description: "The departments of the billing account, for the Enterprise Agreement billing accounts.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AccountProperties.Departments"),
+			},
+			{
+				Name:        "enrollment_accounts",
+				Description: "The enrollment accounts of the billing account, for the Enterprise Agreement billing accounts.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AccountProperties.EnrollmentAccounts"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccountProperties.DisplayName", "Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listBillingAccounts(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_billing_account.listBillingAccounts", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := billing.NewAccountsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx, billingAccountExpand)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_billing_account.listBillingAccounts", "api_error", err)
+		return nil, err
+	}
+
+	for _, account := range result.Values() {
+		d.StreamListItem(ctx, account)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_billing_account.listBillingAccounts", "api_paging_error", err)
+			return nil, err
+		}
+		for _, account := range result.Values() {
+			d.StreamListItem(ctx, account)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getBillingAccount(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Return nil, if no input provided
+	if name == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_billing_account.getBillingAccount", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := billing.NewAccountsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, name, billingAccountExpand)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_billing_account.getBillingAccount", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
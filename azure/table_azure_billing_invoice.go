@@ -0,0 +1,380 @@
+package azure
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/billing/mgmt/2020-05-01-preview/billing"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureBillingInvoice(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_billing_invoice",
+		Description: "Azure Billing Invoice",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"billing_account_name", "name"}),
+			Hydrate:    getBillingInvoice,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "InvoiceNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate:       listBillingInvoices,
+			ParentHydrate: listBillingAccounts,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "billing_account_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:      "invoice_date",
+					Require:   plugin.Optional,
+					Operators: []string{">", ">=", "<", "<=", "="},
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the invoice, which is its invoice number.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the invoice.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource (Microsoft.Billing/billingAccounts/invoices).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "billing_account_name",
+				Description: "The name of the billing account of the invoice.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the invoice. Possible values include: 'Due', 'OverDue', 'Paid', 'Void'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("InvoiceProperties.Status").Transform(transform.ToString),
+			},
+			{
+				Name:        "invoice_date",
+				Description: "The date when the invoice was generated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("InvoiceProperties.InvoiceDate").Transform(convertDateToTime),
+			},
+			{
+				Name:        "due_date",
+				Description: "The due date of the invoice.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("InvoiceProperties.DueDate").Transform(convertDateToTime),
+			},
+			{
+				Name:        "invoice_period_start_date",
+				Description: "The start date of the billing period of the invoice.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("InvoiceProperties.InvoicePeriodStartDate").Transform(convertDateToTime),
+			},
+			{
+				Name:        "invoice_period_end_date",
+				Description: "The end date of the billing period of the invoice.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("InvoiceProperties.InvoicePeriodEndDate").Transform(convertDateToTime),
+			},
+			{
+				Name:        "invoice_type",
+				Description: "The type of the invoice. Possible values include: 'AzureService', 'AzureMarketplace', 'AzureSupport'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("InvoiceProperties.InvoiceType").Transform(transform.ToString),
+			},
+			{
+				Name:        "document_type",
+				Description: "The type of the document. Possible values include: 'Invoice', 'CreditNote'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("InvoiceProperties.DocumentType").Transform(transform.ToString),
+			},
+			{
+				Name:        "is_monthly_invoice",
+				Description: "Indicates whether the invoice is generated as part of the monthly invoice run, or not.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("InvoiceProperties.IsMonthlyInvoice"),
+			},
+			{
+				Name:        "currency",
+				Description: "The currency of the amounts of the invoice.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("InvoiceProperties.TotalAmount.Currency"),
+			},
+			{
+				Name:        "total_amount",
+				Description: "The amount of the invoice, after the credits and taxes.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("InvoiceProperties.TotalAmount.Value"),
+			},
+			{
+				Name:        "amount_due",
+				Description: "The amount of the invoice that remains to be paid.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("InvoiceProperties.AmountDue.Value"),
+			},
+			{
+				Name:        "billed_amount",
+				Description: "The amount billed by the invoice.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("InvoiceProperties.BilledAmount.Value"),
+			},
+			{
+				Name:        "sub_total",
+				Description: "The amount of the invoice, before the taxes.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("InvoiceProperties.SubTotal.Value"),
+			},
+			{
+				Name:        "tax_amount",
+				Description: "The amount of the taxes of the invoice.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("InvoiceProperties.TaxAmount.Value"),
+			},
+			{
+				Name:        "credit_amount",
+				Description: "The amount refunded by the invoice, if it is a credit note.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("InvoiceProperties.CreditAmount.Value"),
+			},
+			{
+				Name:        "azure_prepayment_applied",
+				Description: "The amount of the Azure prepayment applied to the charges of the invoice.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("InvoiceProperties.AzurePrepaymentApplied.Value"),
+			},
+			{
+				Name:        "free_azure_credit_applied",
+				Description: "The amount of the free Azure credits applied to the charges of the invoice.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("InvoiceProperties.FreeAzureCreditApplied.Value"),
+			},
+			{
+				Name:        "billing_profile_id",
+				Description: "The ID of the billing profile of the invoice.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("InvoiceProperties.BillingProfileID"),
+			},
+			{
+				Name:        "billing_profile_display_name",
+				Description: "The display name of the billing profile of the invoice.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("InvoiceProperties.BillingProfileDisplayName"),
+			},
+			{
+				Name:        "purchase_order_number",
+				Description: "The purchase order number of the invoice.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("InvoiceProperties.PurchaseOrderNumber"),
+			},
+			{
+				Name:        "billed_document_id",
+				Description: "The ID of the invoice the credit note is issued for, if the document is a credit note.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("InvoiceProperties.BilledDocumentID"),
+			},
+			{
+				Name:        "download_available",
+				Description: "Indicates whether the documents of the invoice are available to download, or not.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("InvoiceProperties.Documents").Transform(billingInvoiceDownloadAvailable),
+			},
+			{
+				Name:        "documents",
+				Description: "The documents of the invoice, such as the invoice itself, its tax receipt or its credit note.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("InvoiceProperties.Documents"),
+			},
+			{
+				Name:        "payments",
+				Description: "The payments of the invoice.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("InvoiceProperties.Payments"),
+			},
+			{
+				Name:        "rebill_details",
+				Description: "The details of the rebills of the invoice, if it was corrected.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("InvoiceProperties.RebillDetails"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+		}),
+	}
+}
+
+type BillingInvoiceInfo struct {
+	BillingAccountName string
+	billing.Invoice
+}
+
+//// LIST FUNCTION
+
+func listBillingInvoices(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	account := h.Item.(billing.Account)
+
+	if accountName := d.EqualsQualString("billing_account_name"); accountName != "" && !strings.EqualFold(accountName, *account.Name) {
+		return nil, nil
+	}
+
+	// The invoices are listed by billing account for the Microsoft Customer Agreement and
+	// Microsoft Partner Agreement billing accounts, and by subscription for the Microsoft
+	// Online Services Program ones. The invoices of the Enterprise Agreements are not available.
+	hasProfiles := billingAccountHasProfiles(account)
+	if !hasProfiles && (account.AccountProperties == nil || account.AgreementType != billing.MicrosoftOnlineServicesProgram) {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_billing_invoice.listBillingInvoices", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := billing.NewInvoicesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	periodStartDate, periodEndDate := billingInvoicePeriod(d.Quals)
+
+	var result billing.InvoiceListResultPage
+	if hasProfiles {
+		result, err = client.ListByBillingAccount(ctx, *account.Name, periodStartDate, periodEndDate)
+	} else {
+		result, err = client.ListByBillingSubscription(ctx, periodStartDate, periodEndDate)
+	}
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_billing_invoice.listBillingInvoices", "api_error", err)
+		return nil, err
+	}
+
+	for _, invoice := range result.Values() {
+		d.StreamLeafListItem(ctx, BillingInvoiceInfo{*account.Name, invoice})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_billing_invoice.listBillingInvoices", "api_paging_error", err)
+			return nil, err
+		}
+		for _, invoice := range result.Values() {
+			d.StreamLeafListItem(ctx, BillingInvoiceInfo{*account.Name, invoice})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getBillingInvoice(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	accountName := d.EqualsQualString("billing_account_name")
+	name := d.EqualsQualString("name")
+
+	// Return nil, if no input provided
+	if accountName == "" || name == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_billing_invoice.getBillingInvoice", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := billing.NewInvoicesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, accountName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_billing_invoice.getBillingInvoice", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return BillingInvoiceInfo{accountName, op}, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func billingInvoiceDownloadAvailable(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	documents, ok := d.Value.(*[]billing.Document)
+	if !ok || documents == nil {
+		return false, nil
+	}
+	return len(*documents) > 0, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// billingInvoicePeriod returns the period of the invoice_date quals, which is required by
+// the API and defaults to the last 12 months
+func billingInvoicePeriod(quals plugin.KeyColumnQualMap) (string, string) {
+	var from, to time.Time
+	if quals["invoice_date"] != nil {
+		for _, q := range quals["invoice_date"].Quals {
+			value := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case ">", ">=":
+				from = value
+			case "<", "<=":
+				to = value
+			case "=":
+				from, to = value, value
+			}
+		}
+	}
+
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if from.IsZero() {
+		from = to.AddDate(-1, 0, 0)
+	}
+
+	layout := "2006-01-02"
+	return from.Format(layout), to.Format(layout)
+}
@@ -0,0 +1,220 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/billing/mgmt/2020-05-01-preview/billing"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureBillingInvoiceSection(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_billing_invoice_section",
+		Description: "Azure Billing Invoice Section, the invoice sections of the billing profiles of the Microsoft Customer Agreement and Microsoft Partner Agreement billing accounts.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"billing_account_name", "billing_profile_name", "name"}),
+			Hydrate:    getBillingInvoiceSection,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "InvoiceSectionNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate:       listBillingInvoiceSections,
+			ParentHydrate: listBillingAccounts,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "billing_account_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "billing_profile_name",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the invoice section.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the invoice section.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the invoice section.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("InvoiceSectionProperties.DisplayName"),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource (Microsoft.Billing/billingAccounts/billingProfiles/invoiceSections).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "billing_account_name",
+				Description: "The name of the billing account of the invoice section.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "billing_profile_name",
+				Description: "The name of the billing profile of the invoice section.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the invoice section. Possible values include: 'Active', 'Restricted'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("InvoiceSectionProperties.State").Transform(transform.ToString),
+			},
+			{
+				Name:        "system_id",
+				Description: "The system generated unique identifier of the invoice section.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("InvoiceSectionProperties.SystemID"),
+			},
+			{
+				Name:        "target_cloud",
+				Description: "The cloud the invoice section can be used to create subscriptions in.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("InvoiceSectionProperties.TargetCloud").Transform(transform.ToString),
+			},
+			{
+				Name:        "labels",
+				Description: "The labels of the invoice section, up to five key value pairs.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("InvoiceSectionProperties.Labels"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("InvoiceSectionProperties.DisplayName", "Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+		}),
+	}
+}
+
+type BillingInvoiceSectionInfo struct {
+	BillingAccountName string
+	BillingProfileName string
+	billing.InvoiceSection
+}
+
+//// LIST FUNCTION
+
+// The invoice sections are listed by billing profile, so the billing profiles of each
+// billing account are listed first
+func listBillingInvoiceSections(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	account := h.Item.(billing.Account)
+
+	if accountName := d.EqualsQualString("billing_account_name"); accountName != "" && !strings.EqualFold(accountName, *account.Name) {
+		return nil, nil
+	}
+	if !billingAccountHasProfiles(account) {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_billing_invoice_section.listBillingInvoiceSections", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	profilesClient := billing.NewProfilesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	profilesClient.Authorizer = session.Authorizer
+
+	profiles, err := profilesClient.ListByBillingAccountComplete(ctx, *account.Name, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_billing_invoice_section.listBillingInvoiceSections", "profiles_api_error", err)
+		return nil, err
+	}
+
+	client := billing.NewInvoiceSectionsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	for profiles.NotDone() {
+		profile := profiles.Value()
+		if profileName := d.EqualsQualString("billing_profile_name"); profile.Name != nil && (profileName == "" || strings.EqualFold(profileName, *profile.Name)) {
+			result, err := client.ListByBillingProfileComplete(ctx, *account.Name, *profile.Name)
+			if err != nil {
+				plugin.Logger(ctx).Error("azure_billing_invoice_section.listBillingInvoiceSections", "api_error", err)
+				return nil, err
+			}
+			for result.NotDone() {
+				d.StreamLeafListItem(ctx, BillingInvoiceSectionInfo{*account.Name, *profile.Name, result.Value()})
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+				if err := result.NextWithContext(ctx); err != nil {
+					plugin.Logger(ctx).Error("azure_billing_invoice_section.listBillingInvoiceSections", "api_paging_error", err)
+					return nil, err
+				}
+			}
+		}
+
+		if err := profiles.NextWithContext(ctx); err != nil {
+			plugin.Logger(ctx).Error("azure_billing_invoice_section.listBillingInvoiceSections", "profiles_api_paging_error", err)
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getBillingInvoiceSection(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	accountName := d.EqualsQualString("billing_account_name")
+	profileName := d.EqualsQualString("billing_profile_name")
+	name := d.EqualsQualString("name")
+
+	// Return nil, if no input provided
+	if accountName == "" || profileName == "" || name == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_billing_invoice_section.getBillingInvoiceSection", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := billing.NewInvoiceSectionsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, accountName, profileName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_billing_invoice_section.getBillingInvoiceSection", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return BillingInvoiceSectionInfo{accountName, profileName, op}, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,276 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/billing/mgmt/2020-05-01-preview/billing"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureBillingProfile(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_billing_profile",
+		Description: "Azure Billing Profile, the billing profiles of the Microsoft Customer Agreement and Microsoft Partner Agreement billing accounts.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"billing_account_name", "name"}),
+			Hydrate:    getBillingProfile,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "BillingProfileNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate:       listBillingProfiles,
+			ParentHydrate: listBillingAccounts,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "billing_account_name",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the billing profile.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the billing profile.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the billing profile.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProfileProperties.DisplayName"),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource (Microsoft.Billing/billingAccounts/billingProfiles).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "billing_account_name",
+				Description: "The name of the billing account of the billing profile.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the billing profile. Possible values include: 'Active', 'Disabled', 'Warned'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProfileProperties.Status").Transform(transform.ToString),
+			},
+			{
+				Name:        "status_reason_code",
+				Description: "The reason for the status of the billing profile. Possible values include: 'PastDue', 'SpendingLimitReached', 'SpendingLimitExpired'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProfileProperties.StatusReasonCode").Transform(transform.ToString),
+			},
+			{
+				Name:        "currency",
+				Description: "The currency in which the charges of the billing profile are billed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProfileProperties.Currency"),
+			},
+			{
+				Name:        "invoice_day",
+				Description: "The day of the month when the invoice of the billing profile is generated.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ProfileProperties.InvoiceDay"),
+			},
+			{
+				Name:        "invoice_email_opt_in",
+				Description: "Indicates whether the invoices of the billing profile are sent by email.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("ProfileProperties.InvoiceEmailOptIn"),
+			},
+			{
+				Name:        "po_number",
+				Description: "The purchase order number that appears on the invoices of the billing profile.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProfileProperties.PoNumber"),
+			},
+			{
+				Name:        "billing_relationship_type",
+				Description: "The relationship between the billing account and the billing profile. Possible values include: 'Direct', 'IndirectCustomer', 'IndirectPartner', 'CSPPartner'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProfileProperties.BillingRelationshipType").Transform(transform.ToString),
+			},
+			{
+				Name:        "spending_limit",
+				Description: "Indicates whether the spending limit of the billing profile is 'On' or 'Off'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProfileProperties.SpendingLimit").Transform(transform.ToString),
+			},
+			{
+				Name:        "system_id",
+				Description: "The system generated unique identifier of the billing profile.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProfileProperties.SystemID"),
+			},
+			{
+				Name:        "has_read_access",
+				Description: "Indicates whether the user has read access to the billing profile.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("ProfileProperties.HasReadAccess"),
+			},
+			{
+				Name:        "bill_to",
+				Description: "The billing address of the billing profile.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ProfileProperties.BillTo"),
+			},
+			{
+				Name:        "enabled_azure_plans",
+				Description: "The Azure plans enabled for the billing profile.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ProfileProperties.EnabledAzurePlans"),
+			},
+			{
+				Name:        "indirect_relationship_info",
+				Description: "The details of the partner or the customer, for the billing profiles of the indirect relationships.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ProfileProperties.IndirectRelationshipInfo"),
+			},
+			{
+				Name:        "target_clouds",
+				Description: "The clouds the billing profile can be used to create subscriptions in.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ProfileProperties.TargetClouds"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProfileProperties.DisplayName", "Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+		}),
+	}
+}
+
+type BillingProfileInfo struct {
+	BillingAccountName string
+	billing.Profile
+}
+
+//// LIST FUNCTION
+
+func listBillingProfiles(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	account := h.Item.(billing.Account)
+
+	if accountName := d.EqualsQualString("billing_account_name"); accountName != "" && !strings.EqualFold(accountName, *account.Name) {
+		return nil, nil
+	}
+
+	// Only the Microsoft Customer Agreement and Microsoft Partner Agreement billing accounts have billing profiles
+	if !billingAccountHasProfiles(account) {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_billing_profile.listBillingProfiles", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := billing.NewProfilesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListByBillingAccount(ctx, *account.Name, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_billing_profile.listBillingProfiles", "api_error", err)
+		return nil, err
+	}
+
+	for _, profile := range result.Values() {
+		d.StreamLeafListItem(ctx, BillingProfileInfo{*account.Name, profile})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_billing_profile.listBillingProfiles", "api_paging_error", err)
+			return nil, err
+		}
+		for _, profile := range result.Values() {
+			d.StreamLeafListItem(ctx, BillingProfileInfo{*account.Name, profile})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getBillingProfile(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	accountName := d.EqualsQualString("billing_account_name")
+	name := d.EqualsQualString("name")
+
+	// Return nil, if no input provided
+	if accountName == "" || name == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_billing_profile.getBillingProfile", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := billing.NewProfilesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, accountName, name, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_billing_profile.getBillingProfile", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return BillingProfileInfo{accountName, op}, nil
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+func billingAccountHasProfiles(account billing.Account) bool {
+	if account.AccountProperties == nil {
+		return false
+	}
+	switch account.AgreementType {
+	case billing.MicrosoftCustomerAgreement, billing.MicrosoftPartnerAgreement:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,256 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/compute/mgmt/compute"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureCapacityReservation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_capacity_reservation",
+		Description: "Azure Capacity Reservation",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"capacity_reservation_group_name", "name", "resource_group"}),
+			Hydrate:    getCapacityReservation,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listCapacityReservationGroups,
+			Hydrate:       listCapacityReservations,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the capacity reservation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the capacity reservation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "capacity_reservation_group_name",
+				Description: "The name of the capacity reservation group the reservation belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractCapacityReservationGroupNameFromID),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the capacity reservation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "reservation_id",
+				Description: "A unique id generated and assigned to the capacity reservation by the platform.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CapacityReservationProperties.ReservationID"),
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the capacity reservation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CapacityReservationProperties.ProvisioningState"),
+			},
+			{
+				Name:        "sku_name",
+				Description: "The VM size reserved by the capacity reservation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Sku.Name"),
+			},
+			{
+				Name:        "sku_capacity",
+				Description: "The number of virtual machine instances reserved by the capacity reservation.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Sku.Capacity"),
+			},
+			{
+				Name:        "platform_fault_domain_count",
+				Description: "The number of fault domains the capacity reservation is spread across.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("CapacityReservationProperties.PlatformFaultDomainCount"),
+			},
+			{
+				Name:        "current_capacity",
+				Description: "The number of virtual machine instances currently allocated against the capacity reservation.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getCapacityReservation,
+				Transform:   transform.FromField("CapacityReservationProperties.InstanceView.UtilizationInfo.CurrentCapacity"),
+			},
+			{
+				Name:        "provisioning_time",
+				Description: "The date when the capacity reservation was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CapacityReservationProperties.ProvisioningTime").Transform(convertDateToTime),
+			},
+			{
+				Name:        "time_created",
+				Description: "The time at which the capacity reservation resource was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CapacityReservationProperties.TimeCreated").Transform(convertDateToTime),
+			},
+			{
+				Name:        "virtual_machines_associated",
+				Description: "A list of references to all virtual machines associated with the capacity reservation.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CapacityReservationProperties.VirtualMachinesAssociated"),
+			},
+			{
+				Name:        "virtual_machines_allocated",
+				Description: "A list of references to all virtual machines allocated against the capacity reservation.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCapacityReservation,
+				Transform:   transform.FromField("CapacityReservationProperties.InstanceView.UtilizationInfo.VirtualMachinesAllocated"),
+			},
+			{
+				Name:        "statuses",
+				Description: "The resource status information of the capacity reservation.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCapacityReservation,
+				Transform:   transform.FromField("CapacityReservationProperties.InstanceView.Statuses"),
+			},
+			{
+				Name:        "zones",
+				Description: "The availability zone of the capacity reservation.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCapacityReservations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	group := h.Item.(compute.CapacityReservationGroup)
+	resourceGroup := strings.Split(*group.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_capacity_reservation.listCapacityReservations", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := compute.NewCapacityReservationsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListByCapacityReservationGroup(ctx, resourceGroup, *group.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_capacity_reservation.listCapacityReservations", "api_error", err)
+		return nil, err
+	}
+
+	for _, reservation := range result.Values() {
+		d.StreamListItem(ctx, reservation)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_capacity_reservation.listCapacityReservations", "api_paging_error", err)
+			return nil, err
+		}
+		for _, reservation := range result.Values() {
+			d.StreamListItem(ctx, reservation)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCapacityReservation(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var groupName, name, resourceGroup string
+	if h.Item != nil {
+		segments := strings.Split(*h.Item.(compute.CapacityReservation).ID, "/")
+		resourceGroup, groupName, name = segments[4], segments[8], segments[10]
+	} else {
+		groupName = d.EqualsQualString("capacity_reservation_group_name")
+		name = d.EqualsQualString("name")
+		resourceGroup = d.EqualsQualString("resource_group")
+	}
+
+	// Return nil, if no input provided
+	if groupName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_capacity_reservation.getCapacityReservation", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := compute.NewCapacityReservationsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	// The utilization is only returned as part of the instance view
+	op, err := client.Get(ctx, resourceGroup, groupName, name, compute.CapacityReservationInstanceViewTypesInstanceView)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_capacity_reservation.getCapacityReservation", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,209 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/compute/mgmt/compute"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureCapacityReservationGroup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_capacity_reservation_group",
+		Description: "Azure Capacity Reservation Group",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getCapacityReservationGroup,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCapacityReservationGroups,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the capacity reservation group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the capacity reservation group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the capacity reservation group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "capacity_reservations",
+				Description: "A list of references to all capacity reservations in the capacity reservation group.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CapacityReservationGroupProperties.CapacityReservations"),
+			},
+			{
+				Name:        "virtual_machines_associated",
+				Description: "A list of references to all virtual machines associated with the capacity reservation group.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CapacityReservationGroupProperties.VirtualMachinesAssociated"),
+			},
+			{
+				Name:        "instance_view",
+				Description: "The utilization and status of the capacity reservations in the group.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCapacityReservationGroup,
+				Transform:   transform.FromField("CapacityReservationGroupProperties.InstanceView.CapacityReservations"),
+			},
+			{
+				Name:        "zones",
+				Description: "The availability zones the capacity reservation group can use.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCapacityReservationGroups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_capacity_reservation_group.listCapacityReservationGroups", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := compute.NewCapacityReservationGroupsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	// The associated virtual machines are only returned when requested explicitly
+	result, err := client.ListBySubscription(ctx, compute.VirtualMachinesref)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_capacity_reservation_group.listCapacityReservationGroups", "api_error", err)
+		return nil, err
+	}
+
+	for _, group := range result.Values() {
+		d.StreamListItem(ctx, group)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_capacity_reservation_group.listCapacityReservationGroups", "api_paging_error", err)
+			return nil, err
+		}
+		for _, group := range result.Values() {
+			d.StreamListItem(ctx, group)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCapacityReservationGroup(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var name, resourceGroup string
+	if h.Item != nil {
+		group := h.Item.(compute.CapacityReservationGroup)
+		name = *group.Name
+		resourceGroup = strings.Split(*group.ID, "/")[4]
+	} else {
+		name = d.EqualsQualString("name")
+		resourceGroup = d.EqualsQualString("resource_group")
+	}
+
+	// Return nil, if no input provided
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_capacity_reservation_group.getCapacityReservationGroup", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := compute.NewCapacityReservationGroupsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, name, compute.InstanceView)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_capacity_reservation_group.getCapacityReservationGroup", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// Capacity reservation IDs have the form
+// /subscriptions/{id}/resourceGroups/{rg}/providers/Microsoft.Compute/capacityReservationGroups/{group}/capacityReservations/{reservation}
+func extractCapacityReservationGroupNameFromID(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	segments := strings.Split(types.SafeString(d.Value), "/")
+	if len(segments) < 9 {
+		return nil, nil
+	}
+	return segments[8], nil
+}
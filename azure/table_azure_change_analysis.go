@@ -0,0 +1,226 @@
+package azure
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/changeanalysis/mgmt/changeanalysis"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureChangeAnalysis(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_change_analysis",
+		Description: "Azure Change Analysis, the changes detected on the resources of the subscription, with the properties that changed.",
+		List: &plugin.ListConfig{
+			Hydrate: listChangeAnalysisChanges,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "resource_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "resource_group",
+					Require: plugin.Optional,
+				},
+				{
+					Name:      "change_time",
+					Require:   plugin.Optional,
+					Operators: []string{">", ">=", "<", "<="},
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the change.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the change.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "resource_id",
+				Description: "The ID of the resource that changed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ResourceID"),
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the resource that changed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ResourceID").Transform(extractChangeAnalysisResourceType),
+			},
+			{
+				Name:        "change_time",
+				Description: "The time the change was detected. Defaults to the last 7 days if not specified, and spans at most 14 days.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.TimeStamp").Transform(convertDateToTime),
+			},
+			{
+				Name:        "change_type",
+				Description: "The type of the change. Possible values include: 'Add', 'Remove', 'Update'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ChangeType"),
+			},
+			{
+				Name:        "property_change_count",
+				Description: "The number of properties that changed.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Properties.PropertyChanges").Transform(countChangeAnalysisPropertyChanges),
+			},
+			{
+				Name:        "initiated_by_list",
+				Description: "The email addresses or application IDs of the principals that initiated the change.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.InitiatedByList"),
+			},
+			{
+				Name:        "property_changes",
+				Description: "The properties that changed, with their old and new values, change category and level.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.PropertyChanges"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ResourceID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+// The default time window of the changes, if the change_time column is not qualified
+const changeAnalysisDefaultWindow = 7 * 24 * time.Hour
+
+//// LIST FUNCTION
+
+func listChangeAnalysisChanges(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_change_analysis.listChangeAnalysisChanges", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	startTime, endTime := changeAnalysisTimeRange(d.Quals)
+
+	// The changes are listed for the narrowest scope that is qualified
+	var result changeanalysis.ChangeListPage
+	if resourceID := d.EqualsQualString("resource_id"); resourceID != "" {
+		client := changeanalysis.NewResourceChangesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+		client.Authorizer = session.Authorizer
+		result, err = client.List(ctx, resourceID, startTime, endTime, "")
+	} else {
+		client := changeanalysis.NewChangesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+		client.Authorizer = session.Authorizer
+		if resourceGroup := d.EqualsQualString("resource_group"); resourceGroup != "" {
+			result, err = client.ListChangesByResourceGroup(ctx, resourceGroup, startTime, endTime, "")
+		} else {
+			result, err = client.ListChangesBySubscription(ctx, startTime, endTime, "")
+		}
+	}
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_change_analysis.listChangeAnalysisChanges", "api_error", err)
+		return nil, err
+	}
+
+	for _, change := range result.Values() {
+		d.StreamListItem(ctx, change)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_change_analysis.listChangeAnalysisChanges", "api_paging_error", err)
+			return nil, err
+		}
+		for _, change := range result.Values() {
+			d.StreamListItem(ctx, change)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func extractChangeAnalysisResourceType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	segments := strings.Split(strings.Trim(types.SafeString(d.Value), "/"), "/")
+	for i, segment := range segments {
+		if strings.EqualFold(segment, "providers") && i+2 < len(segments) {
+			return segments[i+1] + "/" + segments[i+2], nil
+		}
+	}
+	return nil, nil
+}
+
+func countChangeAnalysisPropertyChanges(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	changes, ok := d.Value.(*[]changeanalysis.PropertyChange)
+	if !ok || changes == nil {
+		return 0, nil
+	}
+	return len(*changes), nil
+}
+
+//// UTILITY FUNCTIONS
+
+// changeAnalysisTimeRange returns the time range of the change_time quals, which defaults to
+// the last 7 days
+func changeAnalysisTimeRange(quals plugin.KeyColumnQualMap) (date.Time, date.Time) {
+	var from, to time.Time
+	if quals["change_time"] != nil {
+		for _, q := range quals["change_time"].Quals {
+			value := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case ">", ">=":
+				if from.IsZero() || value.After(from) {
+					from = value
+				}
+			case "<", "<=":
+				if to.IsZero() || value.Before(to) {
+					to = value
+				}
+			}
+		}
+	}
+
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if from.IsZero() {
+		from = to.Add(-changeAnalysisDefaultWindow)
+	}
+	return date.Time{Time: from.UTC()}, date.Time{Time: to.UTC()}
+}
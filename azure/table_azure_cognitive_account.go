@@ -6,6 +6,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/cognitiveservices/mgmt/cognitiveservices"
 	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/monitor/mgmt/insights"
 
+	"github.com/turbot/go-kit/types"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 
@@ -105,6 +106,12 @@ func tableAzureCognitiveAccount(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Properties.PublicNetworkAccess"),
 			},
+			{
+				Name:        "public_network_exposed",
+				Description: "Indicates whether the account is reachable from any address on the internet, derived from the public network access setting and the firewall default action.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.From(cognitiveAccountPublicNetworkExposed),
+			},
 			{
 				Name:        "restore",
 				Description: "Checks if restore is enabled for the resource.",
@@ -156,6 +163,18 @@ func tableAzureCognitiveAccount(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("Properties.Encryption"),
 			},
+			{
+				Name:        "customer_managed_key_enabled",
+				Description: "Indicates whether the account is encrypted with a customer-managed key stored in Azure Key Vault.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromP(extractCognitiveAccountCustomerManagedKey, "enabled"),
+			},
+			{
+				Name:        "customer_managed_key_uri",
+				Description: "The URI of the Key Vault key used to encrypt the account.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(extractCognitiveAccountCustomerManagedKey, "uri"),
+			},
 			{
 				Name:        "endpoints",
 				Description: "All endpoints of the cognitive services account.",
@@ -167,6 +186,24 @@ func tableAzureCognitiveAccount(_ context.Context) *plugin.Table {
 				Description: "The identity for the resource.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "identity_type",
+				Description: "The type of managed identity used by the resource. Possible values include: 'SystemAssigned', 'UserAssigned', 'SystemAssigned, UserAssigned', 'None'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityType),
+			},
+			{
+				Name:        "system_assigned_principal_id",
+				Description: "The principal ID of the system-assigned managed identity of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityPrincipalID),
+			},
+			{
+				Name:        "user_assigned_identity_ids",
+				Description: "The resource IDs of the user-assigned managed identities associated with the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityUserAssignedIDs),
+			},
 			{
 				Name:        "network_acls",
 				Description: "A collection of rules governing the accessibility from specific network locations.",
@@ -402,3 +439,38 @@ func extractAccountPrivateEndpointConnections(ctx context.Context, d *transform.
 
 	return privateEndpointConnectionInfo, nil
 }
+
+func cognitiveAccountPublicNetworkExposed(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	account := d.HydrateItem.(cognitiveservices.Account)
+	if account.Properties == nil {
+		return false, nil
+	}
+
+	acls := account.Properties.NetworkAcls
+	allowsAllNetworks := acls == nil || acls.DefaultAction != cognitiveservices.NetworkRuleActionDeny
+
+	return isPublicNetworkExposed(string(account.Properties.PublicNetworkAccess), allowsAllNetworks), nil
+}
+
+func extractCognitiveAccountCustomerManagedKey(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	account := d.HydrateItem.(cognitiveservices.Account)
+
+	var enabled bool
+	var keyURI string
+	if account.Properties != nil && account.Properties.Encryption != nil {
+		encryption := account.Properties.Encryption
+		enabled = encryption.KeySource == cognitiveservices.KeySourceMicrosoftKeyVault
+		if enabled && encryption.KeyVaultProperties != nil {
+			properties := encryption.KeyVaultProperties
+			keyURI = buildKeyVaultKeyURI(types.SafeString(properties.KeyVaultURI), types.SafeString(properties.KeyName), types.SafeString(properties.KeyVersion))
+		}
+	}
+
+	if d.Param.(string) == "enabled" {
+		return enabled, nil
+	}
+	if keyURI == "" {
+		return nil, nil
+	}
+	return keyURI, nil
+}
@@ -2,6 +2,7 @@ package azure
 
 import (
 	"context"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/compute/mgmt/compute"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
@@ -168,12 +169,43 @@ func tableAzureComputeDisk(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("DiskProperties.Encryption.Type"),
 			},
+			{
+				Name:        "customer_managed_key_enabled",
+				Description: "Indicates whether the disk is encrypted with a customer-managed key stored in Azure Key Vault.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromP(extractComputeDiskCustomerManagedKey, "enabled"),
+			},
+			{
+				Name:        "customer_managed_key_uri",
+				Description: "The URI of the Key Vault key used by the disk encryption set of the disk.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAzureComputeDiskEncryptionSetKey,
+				Transform:   transform.FromP(extractComputeDiskCustomerManagedKey, "uri"),
+			},
 			{
 				Name:        "network_access_policy",
 				Description: "Policy for accessing the disk via network",
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("DiskProperties.NetworkAccessPolicy"),
 			},
+			{
+				Name:        "public_network_access",
+				Description: "Policy for controlling export on the disk. Possible values include: 'Enabled', 'Disabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DiskProperties.PublicNetworkAccess").Transform(transform.ToString),
+			},
+			{
+				Name:        "data_access_auth_mode",
+				Description: "Additional authentication requirements when exporting or uploading to the disk. Possible values include: 'AzureActiveDirectory', 'None'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DiskProperties.DataAccessAuthMode").Transform(transform.ToString),
+			},
+			{
+				Name:        "sas_export_active",
+				Description: "Indicates whether a SAS URI granting read access to the disk is currently active.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("DiskProperties.DiskState").Transform(isComputeDiskSASExportActive),
+			},
 			{
 				Name:        "creation_data_option",
 				Description: "This enumerates the possible sources of a disk's creation",
@@ -367,3 +399,63 @@ func getAzureComputeDisk(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 
 	return nil, nil
 }
+
+func getAzureComputeDiskEncryptionSetKey(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	disk := h.Item.(compute.Disk)
+	if disk.DiskProperties == nil || disk.Encryption == nil || disk.Encryption.DiskEncryptionSetID == nil {
+		return nil, nil
+	}
+
+	pathParts := strings.Split(*disk.Encryption.DiskEncryptionSetID, "/")
+	resourceGroup := pathParts[4]
+	name := pathParts[len(pathParts)-1]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_compute_disk.getAzureComputeDiskEncryptionSetKey", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+	client := compute.NewDiskEncryptionSetsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_compute_disk.getAzureComputeDiskEncryptionSetKey", "api_error", err)
+		return nil, err
+	}
+
+	if op.EncryptionSetProperties != nil && op.ActiveKey != nil {
+		return op.ActiveKey, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTION ////
+
+// The key URI is only available from the disk encryption set of the disk
+func extractComputeDiskCustomerManagedKey(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	if d.Param.(string) == "uri" {
+		if key, ok := d.HydrateItem.(*compute.KeyForDiskEncryptionSet); ok && key.KeyURL != nil {
+			return *key.KeyURL, nil
+		}
+		return nil, nil
+	}
+
+	disk := d.HydrateItem.(compute.Disk)
+	if disk.DiskProperties == nil || disk.Encryption == nil {
+		return false, nil
+	}
+	return disk.Encryption.Type == compute.EncryptionTypeEncryptionAtRestWithCustomerKey ||
+		disk.Encryption.Type == compute.EncryptionTypeEncryptionAtRestWithPlatformAndCustomerKeys, nil
+}
+
+// A disk or snapshot is in one of the ActiveSAS states while an export SAS URI granted on it is valid
+func isComputeDiskSASExportActive(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	state, ok := d.Value.(compute.DiskState)
+	if !ok {
+		return false, nil
+	}
+	return state == compute.ActiveSAS || state == compute.ActiveSASFrozen, nil
+}
@@ -0,0 +1,240 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/compute/mgmt/compute"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureComputeGallery(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_compute_gallery",
+		Description: "Azure Compute Gallery",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getComputeGallery,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listComputeGalleries,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the gallery.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the gallery.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the gallery.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the gallery.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GalleryProperties.Description"),
+			},
+			{
+				Name:        "unique_name",
+				Description: "The unique name of the gallery.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GalleryProperties.Identifier.UniqueName"),
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the gallery. Possible values include: 'Creating', 'Updating', 'Failed', 'Succeeded', 'Deleting', 'Migrating'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GalleryProperties.ProvisioningState").Transform(transform.ToString),
+			},
+			{
+				Name:        "sharing_permissions",
+				Description: "The permission with which the gallery is shared. Possible values include: 'Private', 'Groups', 'Community'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GalleryProperties.SharingProfile.Permissions").Transform(transform.ToString),
+			},
+			{
+				Name:        "sharing_state",
+				Description: "The aggregated sharing state of the gallery. Possible values include: 'Succeeded', 'InProgress', 'Failed', 'Unknown'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GalleryProperties.SharingStatus.AggregatedState").Transform(transform.ToString),
+			},
+			{
+				Name:        "soft_delete_enabled",
+				Description: "Indicates whether soft deletion is enabled for the gallery.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("GalleryProperties.SoftDeletePolicy.IsSoftDeleteEnabled"),
+			},
+			{
+				Name:        "community_gallery_info",
+				Description: "The information of the community gallery, if the gallery is shared to the community.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("GalleryProperties.SharingProfile.CommunityGalleryInfo"),
+			},
+			{
+				Name:        "sharing_groups",
+				Description: "A list of subscriptions and tenants the gallery is shared with.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getComputeGallery,
+				Transform:   transform.FromField("GalleryProperties.SharingProfile.Groups"),
+			},
+			{
+				Name:        "sharing_status",
+				Description: "The sharing status of the gallery in each region.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("GalleryProperties.SharingStatus.Summary"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeGalleries(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_compute_gallery.listComputeGalleries", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := compute.NewGalleriesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_compute_gallery.listComputeGalleries", "api_error", err)
+		return nil, err
+	}
+
+	for _, gallery := range result.Values() {
+		d.StreamListItem(ctx, gallery)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_compute_gallery.listComputeGalleries", "api_paging_error", err)
+			return nil, err
+		}
+		for _, gallery := range result.Values() {
+			d.StreamListItem(ctx, gallery)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getComputeGallery(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var name, resourceGroup string
+	if h.Item != nil {
+		gallery := h.Item.(compute.Gallery)
+		name = *gallery.Name
+		resourceGroup = strings.Split(*gallery.ID, "/")[4]
+	} else {
+		name = d.EqualsQualString("name")
+		resourceGroup = d.EqualsQualString("resource_group")
+	}
+
+	// Return nil, if no input provided
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_compute_gallery.getComputeGallery", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := compute.NewGalleriesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	// The sharing groups are only returned when requested explicitly
+	op, err := client.Get(ctx, resourceGroup, name, compute.Permissions, compute.SharingProfileGroups)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_compute_gallery.getComputeGallery", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// Gallery resource IDs have the form
+// /subscriptions/{id}/resourceGroups/{rg}/providers/Microsoft.Compute/galleries/{gallery}/images/{image}/versions/{version}
+func extractComputeGalleryNameFromID(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	segments := strings.Split(types.SafeString(d.Value), "/")
+	if len(segments) < 9 {
+		return nil, nil
+	}
+	return segments[8], nil
+}
@@ -0,0 +1,277 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/compute/mgmt/compute"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureComputeGalleryImage(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_compute_gallery_image",
+		Description: "Azure Compute Gallery Image",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"gallery_name", "name", "resource_group"}),
+			Hydrate:    getComputeGalleryImage,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listComputeGalleries,
+			Hydrate:       listComputeGalleryImages,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the image definition.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the image definition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "gallery_name",
+				Description: "The name of the gallery the image definition belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractComputeGalleryNameFromID),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the image definition.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the image definition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GalleryImageProperties.Description"),
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the image definition. Possible values include: 'Creating', 'Updating', 'Failed', 'Succeeded', 'Deleting', 'Migrating'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GalleryImageProperties.ProvisioningState").Transform(transform.ToString),
+			},
+			{
+				Name:        "publisher",
+				Description: "The name of the image definition publisher.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GalleryImageProperties.Identifier.Publisher"),
+			},
+			{
+				Name:        "offer",
+				Description: "The name of the image definition offer.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GalleryImageProperties.Identifier.Offer"),
+			},
+			{
+				Name:        "sku",
+				Description: "The name of the image definition SKU.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GalleryImageProperties.Identifier.Sku"),
+			},
+			{
+				Name:        "os_type",
+				Description: "The type of the operating system in the image. Possible values include: 'Windows', 'Linux'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GalleryImageProperties.OsType").Transform(transform.ToString),
+			},
+			{
+				Name:        "os_state",
+				Description: "Indicates whether the virtual machines created from the image are 'Generalized' or 'Specialized'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GalleryImageProperties.OsState").Transform(transform.ToString),
+			},
+			{
+				Name:        "hyper_v_generation",
+				Description: "The hypervisor generation of the virtual machines created from the image. Possible values include: 'V1', 'V2'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GalleryImageProperties.HyperVGeneration").Transform(transform.ToString),
+			},
+			{
+				Name:        "architecture",
+				Description: "The architecture of the image. Possible values include: 'x64', 'Arm64'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GalleryImageProperties.Architecture").Transform(transform.ToString),
+			},
+			{
+				Name:        "end_of_life_date",
+				Description: "The end of life date of the image definition.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("GalleryImageProperties.EndOfLifeDate").Transform(convertDateToTime),
+			},
+			{
+				Name:        "eula",
+				Description: "The Eula agreement for the image definition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GalleryImageProperties.Eula"),
+			},
+			{
+				Name:        "privacy_statement_uri",
+				Description: "The privacy statement uri of the image definition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GalleryImageProperties.PrivacyStatementURI"),
+			},
+			{
+				Name:        "release_note_uri",
+				Description: "The release note uri of the image definition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GalleryImageProperties.ReleaseNoteURI"),
+			},
+			{
+				Name:        "disallowed",
+				Description: "The disk types that are not allowed for the image.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("GalleryImageProperties.Disallowed"),
+			},
+			{
+				Name:        "features",
+				Description: "A list of gallery image features, such as the security type.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("GalleryImageProperties.Features"),
+			},
+			{
+				Name:        "purchase_plan",
+				Description: "The purchase plan of the image definition, used to enable marketplace images.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("GalleryImageProperties.PurchasePlan"),
+			},
+			{
+				Name:        "recommended",
+				Description: "The recommended machine configuration for the image.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("GalleryImageProperties.Recommended"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeGalleryImages(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	gallery := h.Item.(compute.Gallery)
+	resourceGroup := strings.Split(*gallery.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_compute_gallery_image.listComputeGalleryImages", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := compute.NewGalleryImagesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListByGallery(ctx, resourceGroup, *gallery.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_compute_gallery_image.listComputeGalleryImages", "api_error", err)
+		return nil, err
+	}
+
+	for _, image := range result.Values() {
+		d.StreamListItem(ctx, image)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_compute_gallery_image.listComputeGalleryImages", "api_paging_error", err)
+			return nil, err
+		}
+		for _, image := range result.Values() {
+			d.StreamListItem(ctx, image)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getComputeGalleryImage(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	galleryName := d.EqualsQualString("gallery_name")
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if galleryName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_compute_gallery_image.getComputeGalleryImage", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := compute.NewGalleryImagesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, galleryName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_compute_gallery_image.getComputeGalleryImage", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
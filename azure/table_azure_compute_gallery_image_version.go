@@ -0,0 +1,292 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/compute/mgmt/compute"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureComputeGalleryImageVersion(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_compute_gallery_image_version",
+		Description: "Azure Compute Gallery Image Version",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"gallery_name", "image_name", "name", "resource_group"}),
+			Hydrate:    getComputeGalleryImageVersion,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listComputeGalleries,
+			Hydrate:       listComputeGalleryImageVersions,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the image version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the image version.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "gallery_name",
+				Description: "The name of the gallery the image version belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractComputeGalleryNameFromID),
+			},
+			{
+				Name:        "image_name",
+				Description: "The name of the image definition the image version belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractComputeGalleryImageNameFromID),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the image version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the image version. Possible values include: 'Creating', 'Updating', 'Failed', 'Succeeded', 'Deleting', 'Migrating'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GalleryImageVersionProperties.ProvisioningState").Transform(transform.ToString),
+			},
+			{
+				Name:        "published_date",
+				Description: "The timestamp for when the image version is published.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("GalleryImageVersionProperties.PublishingProfile.PublishedDate").Transform(convertDateToTime),
+			},
+			{
+				Name:        "end_of_life_date",
+				Description: "The end of life date of the image version.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("GalleryImageVersionProperties.PublishingProfile.EndOfLifeDate").Transform(convertDateToTime),
+			},
+			{
+				Name:        "exclude_from_latest",
+				Description: "Indicates whether virtual machines deployed from the latest version of the image definition won't use this image version.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("GalleryImageVersionProperties.PublishingProfile.ExcludeFromLatest"),
+			},
+			{
+				Name:        "replica_count",
+				Description: "The number of replicas of the image version to be created per region.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("GalleryImageVersionProperties.PublishingProfile.ReplicaCount"),
+			},
+			{
+				Name:        "replication_mode",
+				Description: "The mode to be used for replication. Possible values include: 'Full', 'Shallow'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GalleryImageVersionProperties.PublishingProfile.ReplicationMode").Transform(transform.ToString),
+			},
+			{
+				Name:        "storage_account_type",
+				Description: "The storage account type used to store the image. Possible values include: 'Standard_LRS', 'Standard_ZRS', 'Premium_LRS'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GalleryImageVersionProperties.PublishingProfile.StorageAccountType").Transform(transform.ToString),
+			},
+			{
+				Name:        "source_id",
+				Description: "The ID of the managed image, snapshot, disk or virtual machine the image version was created from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GalleryImageVersionProperties.StorageProfile.Source.ID"),
+			},
+			{
+				Name:        "replication_state",
+				Description: "The aggregated replication state of the image version. Possible values include: 'Unknown', 'InProgress', 'Completed', 'Failed'.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getComputeGalleryImageVersion,
+				Transform:   transform.FromField("GalleryImageVersionProperties.ReplicationStatus.AggregatedState").Transform(transform.ToString),
+			},
+			{
+				Name:        "replication_status",
+				Description: "The replication status of the image version in each target region.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getComputeGalleryImageVersion,
+				Transform:   transform.FromField("GalleryImageVersionProperties.ReplicationStatus.Summary"),
+			},
+			{
+				Name:        "target_regions",
+				Description: "The target regions where the image version is going to be replicated to.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("GalleryImageVersionProperties.PublishingProfile.TargetRegions"),
+			},
+			{
+				Name:        "storage_profile",
+				Description: "The storage profile of the image version, including the OS and data disk images.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("GalleryImageVersionProperties.StorageProfile"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeGalleryImageVersions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	gallery := h.Item.(compute.Gallery)
+	resourceGroup := strings.Split(*gallery.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_compute_gallery_image_version.listComputeGalleryImageVersions", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	imagesClient := compute.NewGalleryImagesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	imagesClient.Authorizer = session.Authorizer
+
+	client := compute.NewGalleryImageVersionsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	images, err := imagesClient.ListByGalleryComplete(ctx, resourceGroup, *gallery.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_compute_gallery_image_version.listComputeGalleryImageVersions", "api_error", err)
+		return nil, err
+	}
+
+	for images.NotDone() {
+		image := images.Value()
+
+		result, err := client.ListByGalleryImage(ctx, resourceGroup, *gallery.Name, *image.Name)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_compute_gallery_image_version.listComputeGalleryImageVersions", "api_error", err)
+			return nil, err
+		}
+
+		for _, version := range result.Values() {
+			d.StreamListItem(ctx, version)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		for result.NotDone() {
+			err = result.NextWithContext(ctx)
+			if err != nil {
+				plugin.Logger(ctx).Error("azure_compute_gallery_image_version.listComputeGalleryImageVersions", "api_paging_error", err)
+				return nil, err
+			}
+			for _, version := range result.Values() {
+				d.StreamListItem(ctx, version)
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+
+		if err := images.NextWithContext(ctx); err != nil {
+			plugin.Logger(ctx).Error("azure_compute_gallery_image_version.listComputeGalleryImageVersions", "api_paging_error", err)
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getComputeGalleryImageVersion(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var galleryName, imageName, name, resourceGroup string
+	if h.Item != nil {
+		segments := strings.Split(*h.Item.(compute.GalleryImageVersion).ID, "/")
+		resourceGroup, galleryName, imageName, name = segments[4], segments[8], segments[10], segments[12]
+	} else {
+		galleryName = d.EqualsQualString("gallery_name")
+		imageName = d.EqualsQualString("image_name")
+		name = d.EqualsQualString("name")
+		resourceGroup = d.EqualsQualString("resource_group")
+	}
+
+	// Return nil, if no input provided
+	if galleryName == "" || imageName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_compute_gallery_image_version.getComputeGalleryImageVersion", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := compute.NewGalleryImageVersionsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	// The replication status is only returned when requested explicitly
+	op, err := client.Get(ctx, resourceGroup, galleryName, imageName, name, compute.ReplicationStatusTypesReplicationStatus)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_compute_gallery_image_version.getComputeGalleryImageVersion", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func extractComputeGalleryImageNameFromID(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	segments := strings.Split(types.SafeString(d.Value), "/")
+	if len(segments) < 11 {
+		return nil, nil
+	}
+	return segments[10], nil
+}
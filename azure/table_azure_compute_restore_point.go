@@ -0,0 +1,212 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/compute/mgmt/compute"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureComputeRestorePoint(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_compute_restore_point",
+		Description: "Azure Compute Restore Point",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"restore_point_collection_name", "name", "resource_group"}),
+			Hydrate:    getComputeRestorePoint,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listComputeRestorePointCollections,
+			Hydrate:       listComputeRestorePoints,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the restore point.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the restore point.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "restore_point_collection_name",
+				Description: "The name of the restore point collection the restore point belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractComputeRestorePointCollectionNameFromID),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the restore point.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the restore point.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RestorePointProperties.ProvisioningState"),
+			},
+			{
+				Name:        "consistency_mode",
+				Description: "The consistency mode of the restore point. Possible values include: 'CrashConsistent', 'FileSystemConsistent', 'ApplicationConsistent'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RestorePointProperties.ConsistencyMode").Transform(transform.ToString),
+			},
+			{
+				Name:        "time_created",
+				Description: "The creation time of the restore point.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("RestorePointProperties.TimeCreated").Transform(convertDateToTime),
+			},
+			{
+				Name:        "source_vm_id",
+				Description: "The unique ID of the virtual machine the restore point was created from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RestorePointProperties.SourceMetadata.VMID"),
+			},
+			{
+				Name:        "source_restore_point_id",
+				Description: "The resource ID of the source restore point, if the restore point was copied from another region.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RestorePointProperties.SourceRestorePoint.ID"),
+			},
+			{
+				Name:        "exclude_disks",
+				Description: "A list of the disks excluded from the restore point.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("RestorePointProperties.ExcludeDisks"),
+			},
+			{
+				Name:        "source_metadata",
+				Description: "The properties of the virtual machine the restore point was created from, such as its hardware, storage and OS profile.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("RestorePointProperties.SourceMetadata"),
+			},
+			{
+				Name:        "disk_restore_points",
+				Description: "The replication status of the disk restore points of the restore point.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getComputeRestorePoint,
+				Transform:   transform.FromField("RestorePointProperties.InstanceView.DiskRestorePoints"),
+			},
+			{
+				Name:        "statuses",
+				Description: "The resource status information of the restore point.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getComputeRestorePoint,
+				Transform:   transform.FromField("RestorePointProperties.InstanceView.Statuses"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RestorePointProperties.SourceMetadata.Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeRestorePoints(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// The restore points are only available from the restore point collection
+	collection, err := getComputeRestorePointCollection(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_compute_restore_point.listComputeRestorePoints", "api_error", err)
+		return nil, err
+	}
+	if collection == nil {
+		return nil, nil
+	}
+
+	properties := collection.(compute.RestorePointCollection).RestorePointCollectionProperties
+	if properties == nil || properties.RestorePoints == nil {
+		return nil, nil
+	}
+
+	for _, restorePoint := range *properties.RestorePoints {
+		d.StreamListItem(ctx, restorePoint)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getComputeRestorePoint(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var collectionName, name, resourceGroup string
+	if h.Item != nil {
+		segments := strings.Split(*h.Item.(compute.RestorePoint).ID, "/")
+		resourceGroup, collectionName, name = segments[4], segments[8], segments[10]
+	} else {
+		collectionName = d.EqualsQualString("restore_point_collection_name")
+		name = d.EqualsQualString("name")
+		resourceGroup = d.EqualsQualString("resource_group")
+	}
+
+	// Return nil, if no input provided
+	if collectionName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_compute_restore_point.getComputeRestorePoint", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := compute.NewRestorePointsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, collectionName, name, compute.RestorePointExpandOptionsInstanceView)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_compute_restore_point.getComputeRestorePoint", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,216 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/compute/mgmt/compute"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureComputeRestorePointCollection(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_compute_restore_point_collection",
+		Description: "Azure Compute Restore Point Collection",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getComputeRestorePointCollection,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listComputeRestorePointCollections,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the restore point collection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the restore point collection.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the restore point collection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "restore_point_collection_id",
+				Description: "The unique id of the restore point collection.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RestorePointCollectionProperties.RestorePointCollectionID"),
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the restore point collection.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RestorePointCollectionProperties.ProvisioningState"),
+			},
+			{
+				Name:        "source_id",
+				Description: "The resource ID of the virtual machine the restore points are created from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RestorePointCollectionProperties.Source.ID"),
+			},
+			{
+				Name:        "source_location",
+				Description: "The location of the virtual machine the restore points are created from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RestorePointCollectionProperties.Source.Location"),
+			},
+			{
+				Name:        "restore_points",
+				Description: "A list of the restore points in the restore point collection.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getComputeRestorePointCollection,
+				Transform:   transform.FromField("RestorePointCollectionProperties.RestorePoints"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeRestorePointCollections(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_compute_restore_point_collection.listComputeRestorePointCollections", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := compute.NewRestorePointCollectionsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListAll(ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_compute_restore_point_collection.listComputeRestorePointCollections", "api_error", err)
+		return nil, err
+	}
+
+	for _, collection := range result.Values() {
+		d.StreamListItem(ctx, collection)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_compute_restore_point_collection.listComputeRestorePointCollections", "api_paging_error", err)
+			return nil, err
+		}
+		for _, collection := range result.Values() {
+			d.StreamListItem(ctx, collection)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getComputeRestorePointCollection(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var name, resourceGroup string
+	if h.Item != nil {
+		collection := h.Item.(compute.RestorePointCollection)
+		name = *collection.Name
+		resourceGroup = strings.Split(*collection.ID, "/")[4]
+	} else {
+		name = d.EqualsQualString("name")
+		resourceGroup = d.EqualsQualString("resource_group")
+	}
+
+	// Return nil, if no input provided
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_compute_restore_point_collection.getComputeRestorePointCollection", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := compute.NewRestorePointCollectionsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	// The restore points are only returned when requested explicitly
+	op, err := client.Get(ctx, resourceGroup, name, compute.RestorePoints)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_compute_restore_point_collection.getComputeRestorePointCollection", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// Restore point IDs have the form
+// /subscriptions/{id}/resourceGroups/{rg}/providers/Microsoft.Compute/restorePointCollections/{collection}/restorePoints/{restorePoint}
+func extractComputeRestorePointCollectionNameFromID(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	segments := strings.Split(types.SafeString(d.Value), "/")
+	if len(segments) < 9 {
+		return nil, nil
+	}
+	return segments[8], nil
+}
@@ -137,6 +137,30 @@ func tableAzureComputeSnapshot(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("SnapshotProperties.NetworkAccessPolicy").Transform(transform.ToString),
 			},
+			{
+				Name:        "public_network_access",
+				Description: "Policy for controlling export on the snapshot. Possible values include: 'Enabled', 'Disabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SnapshotProperties.PublicNetworkAccess").Transform(transform.ToString),
+			},
+			{
+				Name:        "data_access_auth_mode",
+				Description: "Additional authentication requirements when exporting or uploading to the snapshot. Possible values include: 'AzureActiveDirectory', 'None'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SnapshotProperties.DataAccessAuthMode").Transform(transform.ToString),
+			},
+			{
+				Name:        "disk_state",
+				Description: "The state of the snapshot. Possible values include: 'Unattached', 'Attached', 'Reserved', 'Frozen', 'ActiveSAS', 'ActiveSASFrozen', 'ReadyToUpload', 'ActiveUpload'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SnapshotProperties.DiskState").Transform(transform.ToString),
+			},
+			{
+				Name:        "sas_export_active",
+				Description: "Indicates whether a SAS URI granting read access to the snapshot is currently active.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("SnapshotProperties.DiskState").Transform(isComputeDiskSASExportActive),
+			},
 			{
 				Name:        "os_type",
 				Description: "Contains the type of operating system",
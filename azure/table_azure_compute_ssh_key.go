@@ -2,11 +2,16 @@ package azure
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/compute/mgmt/compute"
+	"github.com/turbot/go-kit/types"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"golang.org/x/crypto/ssh"
 )
 
 //// TABLE DEFINITION ////
@@ -48,6 +53,36 @@ func tableAzureComputeSshKey(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("SSHPublicKeyResourceProperties.PublicKey"),
 			},
+			{
+				Name:        "key_type",
+				Description: "The algorithm of the SSH public key, for example 'ssh-rsa' or 'ssh-ed25519'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SSHPublicKeyResourceProperties.PublicKey").TransformP(extractSshPublicKeyDetail, "key_type"),
+			},
+			{
+				Name:        "key_size",
+				Description: "The size of the SSH public key in bits.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("SSHPublicKeyResourceProperties.PublicKey").TransformP(extractSshPublicKeyDetail, "key_size"),
+			},
+			{
+				Name:        "fingerprint_sha256",
+				Description: "The SHA256 fingerprint of the SSH public key, in the format used by OpenSSH.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SSHPublicKeyResourceProperties.PublicKey").TransformP(extractSshPublicKeyDetail, "fingerprint_sha256"),
+			},
+			{
+				Name:        "fingerprint_md5",
+				Description: "The legacy MD5 fingerprint of the SSH public key, as colon separated hex bytes.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SSHPublicKeyResourceProperties.PublicKey").TransformP(extractSshPublicKeyDetail, "fingerprint_md5"),
+			},
+			{
+				Name:        "key_comment",
+				Description: "The comment stored with the SSH public key, usually identifying the key owner.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SSHPublicKeyResourceProperties.PublicKey").TransformP(extractSshPublicKeyDetail, "key_comment"),
+			},
 
 			// Azure standard columns
 			{
@@ -163,3 +198,47 @@ func getAzureComputeSshKey(ctx context.Context, d *plugin.QueryData, h *plugin.H
 
 	return nil, nil
 }
+
+//// TRANSFORM FUNCTION ////
+
+func extractSshPublicKeyDetail(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	publicKey := types.SafeString(d.Value)
+	if publicKey == "" {
+		return nil, nil
+	}
+
+	key, comment, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+	if err != nil {
+		// Keys that cannot be parsed have no fingerprint
+		return nil, nil
+	}
+
+	switch d.Param.(string) {
+	case "key_type":
+		return key.Type(), nil
+	case "key_size":
+		cryptoKey, ok := key.(ssh.CryptoPublicKey)
+		if !ok {
+			return nil, nil
+		}
+		switch k := cryptoKey.CryptoPublicKey().(type) {
+		case *rsa.PublicKey:
+			return k.N.BitLen(), nil
+		case *ecdsa.PublicKey:
+			return k.Curve.Params().BitSize, nil
+		case ed25519.PublicKey:
+			return len(k) * 8, nil
+		}
+		return nil, nil
+	case "fingerprint_sha256":
+		return ssh.FingerprintSHA256(key), nil
+	case "fingerprint_md5":
+		return ssh.FingerprintLegacyMD5(key), nil
+	case "key_comment":
+		if comment == "" {
+			return nil, nil
+		}
+		return comment, nil
+	}
+	return nil, nil
+}
@@ -315,6 +315,76 @@ func tableAzureComputeVirtualMachine(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Hydrate:     getComputeVirtualMachineInstanceView,
 			},
+			{
+				Name:        "hyper_v_generation",
+				Description: "The hypervisor generation of the virtual machine. Possible values include: 'V1', 'V2'.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getComputeVirtualMachineInstanceView,
+				Transform:   transform.FromField("HyperVGeneration").Transform(transform.ToString),
+			},
+			{
+				Name:        "assigned_host",
+				Description: "The resource id of the dedicated host on which the virtual machine is allocated through automatic placement.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getComputeVirtualMachineInstanceView,
+				Transform:   transform.FromField("AssignedHost"),
+			},
+			{
+				Name:        "vm_agent_version",
+				Description: "The full version of the VM agent running on the virtual machine.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getComputeVirtualMachineInstanceView,
+				Transform:   transform.FromField("VMAgent.VMAgentVersion"),
+			},
+			{
+				Name:        "vm_agent_statuses",
+				Description: "The resource status information of the VM agent running on the virtual machine.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getComputeVirtualMachineInstanceView,
+				Transform:   transform.FromField("VMAgent.Statuses"),
+			},
+			{
+				Name:        "patch_available_summary",
+				Description: "The available patch summary of the latest patch assessment operation for the virtual machine.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getComputeVirtualMachineInstanceView,
+				Transform:   transform.FromField("PatchStatus.AvailablePatchSummary"),
+			},
+			{
+				Name:        "patch_last_installation_summary",
+				Description: "The installation summary of the latest patch installation operation for the virtual machine.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getComputeVirtualMachineInstanceView,
+				Transform:   transform.FromField("PatchStatus.LastPatchInstallationSummary"),
+			},
+			{
+				Name:        "patch_configuration_statuses",
+				Description: "The enablement status of the patch mode configured on the virtual machine.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getComputeVirtualMachineInstanceView,
+				Transform:   transform.FromField("PatchStatus.ConfigurationStatuses"),
+			},
+			{
+				Name:        "boot_diagnostics_console_screenshot_blob_uri",
+				Description: "The console screenshot blob URI. This is not set if boot diagnostics is enabled with managed storage.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getComputeVirtualMachineInstanceView,
+				Transform:   transform.FromField("BootDiagnostics.ConsoleScreenshotBlobURI"),
+			},
+			{
+				Name:        "boot_diagnostics_serial_console_log_blob_uri",
+				Description: "The serial console log blob URI. This is not set if boot diagnostics is enabled with managed storage.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getComputeVirtualMachineInstanceView,
+				Transform:   transform.FromField("BootDiagnostics.SerialConsoleLogBlobURI"),
+			},
+			{
+				Name:        "boot_diagnostics_status",
+				Description: "The boot diagnostics status information for the virtual machine. This is only set if errors were encountered in enabling boot diagnostics.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getComputeVirtualMachineInstanceView,
+				Transform:   transform.FromField("BootDiagnostics.Status"),
+			},
 			{
 				Name:        "extensions",
 				Description: "Specifies the details of VM Extensions.",
@@ -334,6 +404,24 @@ func tableAzureComputeVirtualMachine(_ context.Context) *plugin.Table {
 				Description: "The identity of the virtual machine, if configured.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "identity_type",
+				Description: "The type of managed identity used by the resource. Possible values include: 'SystemAssigned', 'UserAssigned', 'SystemAssigned, UserAssigned', 'None'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityType),
+			},
+			{
+				Name:        "system_assigned_principal_id",
+				Description: "The principal ID of the system-assigned managed identity of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityPrincipalID),
+			},
+			{
+				Name:        "user_assigned_identity_ids",
+				Description: "The resource IDs of the user-assigned managed identities associated with the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityUserAssignedIDs),
+			},
 			{
 				Name:        "security_profile",
 				Description: "Specifies the security related profile settings for the virtual machine.",
@@ -0,0 +1,223 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/confidentialledger/mgmt/confidentialledger"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureConfidentialLedger(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_confidential_ledger",
+		Description: "Azure Confidential Ledger",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getConfidentialLedger,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listConfidentialLedgers,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the confidential ledger.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the confidential ledger.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource (Microsoft.ConfidentialLedger/ledgers).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the confidential ledger. Possible values include: 'Unknown', 'Succeeded', 'Failed', 'Canceled', 'Creating', 'Deleting', 'Updating'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ProvisioningState"),
+			},
+			{
+				Name:        "ledger_type",
+				Description: "The type of the confidential ledger, 'Public' if its transactions can be read by anyone with access to the ledger, or 'Private' if they are encrypted. Possible values include: 'Unknown', 'Public', 'Private'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.LedgerType"),
+			},
+			{
+				Name:        "ledger_uri",
+				Description: "The endpoint of the confidential ledger, which is only reachable over TLS with the certificate of its identity service.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.LedgerURI"),
+			},
+			{
+				Name:        "identity_service_uri",
+				Description: "The endpoint of the identity service of the confidential ledger, which serves the TLS certificate of the ledger.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.IdentityServiceURI"),
+			},
+			{
+				Name:        "ledger_internal_namespace",
+				Description: "The internal namespace of the confidential ledger.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.LedgerInternalNamespace"),
+			},
+			{
+				Name:        "ledger_storage_account",
+				Description: "The name of the storage account the confidential ledger is backed up to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.LedgerStorageAccount"),
+			},
+			{
+				Name:        "created_by",
+				Description: "The identity that created the confidential ledger.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SystemData.CreatedBy"),
+			},
+			{
+				Name:        "created_at",
+				Description: "The time the confidential ledger was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("SystemData.CreatedAt").Transform(convertDateToTime),
+			},
+			{
+				Name:        "aad_based_security_principals",
+				Description: "The Microsoft Entra ID principals that have access to the confidential ledger, with their ledger role.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.AadBasedSecurityPrincipals"),
+			},
+			{
+				Name:        "cert_based_security_principals",
+				Description: "The certificate based principals that have access to the confidential ledger, with their ledger role.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.CertBasedSecurityPrincipals"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listConfidentialLedgers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_confidential_ledger.listConfidentialLedgers", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := confidentialledger.NewLedgerClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListBySubscription(ctx, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_confidential_ledger.listConfidentialLedgers", "api_error", err)
+		return nil, err
+	}
+
+	for _, ledger := range result.Values() {
+		d.StreamListItem(ctx, ledger)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_confidential_ledger.listConfidentialLedgers", "api_paging_error", err)
+			return nil, err
+		}
+		for _, ledger := range result.Values() {
+			d.StreamListItem(ctx, ledger)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getConfidentialLedger(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_confidential_ledger.getConfidentialLedger", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := confidentialledger.NewLedgerClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_confidential_ledger.getConfidentialLedger", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
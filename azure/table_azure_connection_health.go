@@ -0,0 +1,308 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/resources/mgmt/resources"
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/resources/mgmt/subscriptions"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureConnectionHealth(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_connection_health",
+		Description: "Azure Connection Health, the checks of the authentication and the access of the connection, one row per check.",
+		List: &plugin.ListConfig{
+			Hydrate: listConnectionHealthChecks,
+		},
+		// The checks always run against the current state of the connection
+		Cache: &plugin.TableCacheOptions{
+			Enabled: false,
+		},
+		// The common columns are not used, as their hydrate functions fail when the connection does
+		// not authenticate, which is what the checks report on
+		Columns: []*plugin.Column{
+			{
+				Name:        "check_name",
+				Description: "The name of the check. Possible values include: 'token_acquisition', 'resource_manager', 'subscription_access', 'microsoft_graph', 'provider_registration'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the check. Possible values include: 'ok', 'warning', 'error', 'skipped'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "message",
+				Description: "The result of the check, or the error it failed with.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "duration_ms",
+				Description: "The time the check took, in milliseconds.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "details",
+				Description: "The details of the result of the check, which depend on the check.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "cloud_environment",
+				Description: ColumnDescriptionCloudEnvironment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "subscription_id",
+				Description: ColumnDescriptionSubscription,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SubscriptionID"),
+			},
+		},
+	}
+}
+
+type ConnectionHealthCheck struct {
+	CheckName        string
+	Status           string
+	Message          string
+	DurationMs       int64
+	Details          map[string]interface{}
+	CloudEnvironment string
+	SubscriptionID   string
+}
+
+const (
+	connectionHealthStatusOk      = "ok"
+	connectionHealthStatusWarning = "warning"
+	connectionHealthStatusError   = "error"
+	connectionHealthStatusSkipped = "skipped"
+)
+
+//// LIST FUNCTION
+
+func listConnectionHealthChecks(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	var session *Session
+
+	// The checks run in order, and the checks of Resource Manager are skipped when no token could
+	// be acquired for it
+	checks := []struct {
+		name string
+		run  func() (string, string, map[string]interface{})
+	}{
+		{"token_acquisition", func() (string, string, map[string]interface{}) {
+			var status, message string
+			var details map[string]interface{}
+			session, status, message, details = checkConnectionTokenAcquisition(ctx, d)
+			return status, message, details
+		}},
+		{"resource_manager", func() (string, string, map[string]interface{}) {
+			return checkConnectionResourceManager(ctx, session)
+		}},
+		{"subscription_access", func() (string, string, map[string]interface{}) {
+			return checkConnectionSubscriptionAccess(ctx, session)
+		}},
+		{"microsoft_graph", func() (string, string, map[string]interface{}) {
+			return checkConnectionMicrosoftGraph(ctx, d)
+		}},
+		{"provider_registration", func() (string, string, map[string]interface{}) {
+			return checkConnectionProviderRegistration(ctx, session)
+		}},
+	}
+
+	for _, check := range checks {
+		start := time.Now()
+		status, message, details := check.run()
+		result := ConnectionHealthCheck{
+			CheckName:  check.name,
+			Status:     status,
+			Message:    message,
+			DurationMs: time.Since(start).Milliseconds(),
+			Details:    details,
+		}
+		if session != nil {
+			result.CloudEnvironment = session.CloudEnvironment
+			result.SubscriptionID = session.SubscriptionID
+		}
+		if status == connectionHealthStatusError {
+			plugin.Logger(ctx).Warn("azure_connection_health.listConnectionHealthChecks", "check", check.name, "error", message)
+		}
+
+		d.StreamListItem(ctx, result)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// checkConnectionTokenAcquisition creates the session of the connection and acquires a token for
+// Resource Manager with it, which some authentication methods only do on the first request
+func checkConnectionTokenAcquisition(ctx context.Context, d *plugin.QueryData) (*Session, string, string, map[string]interface{}) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, connectionHealthStatusError, err.Error(), nil
+	}
+
+	details := map[string]interface{}{
+		"tenant_id":                 session.TenantID,
+		"resource_manager_endpoint": session.ResourceManagerEndpoint,
+	}
+	if session.Expires != nil {
+		details["expires_on"] = session.Expires.UTC().Format(time.RFC3339)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, session.ResourceManagerEndpoint, nil)
+	if err != nil {
+		return nil, connectionHealthStatusError, err.Error(), details
+	}
+	if _, err := autorest.Prepare(req, session.Authorizer.WithAuthorization()); err != nil {
+		return nil, connectionHealthStatusError, err.Error(), details
+	}
+
+	return session, connectionHealthStatusOk, "A token was acquired for Resource Manager.", details
+}
+
+// checkConnectionResourceManager lists the subscriptions the identity of the connection has access to
+func checkConnectionResourceManager(ctx context.Context, session *Session) (string, string, map[string]interface{}) {
+	if session == nil {
+		return connectionHealthStatusSkipped, "No token was acquired for Resource Manager.", nil
+	}
+
+	client := subscriptions.NewClientWithBaseURI(session.ResourceManagerEndpoint)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListComplete(ctx)
+	if err != nil {
+		return connectionHealthStatusError, err.Error(), nil
+	}
+	var subscriptionIDs []string
+	for result.NotDone() {
+		if result.Value().SubscriptionID != nil {
+			subscriptionIDs = append(subscriptionIDs, *result.Value().SubscriptionID)
+		}
+		if err := result.NextWithContext(ctx); err != nil {
+			return connectionHealthStatusError, err.Error(), nil
+		}
+	}
+
+	details := map[string]interface{}{
+		"subscription_ids": subscriptionIDs,
+	}
+	if len(subscriptionIDs) == 0 {
+		return connectionHealthStatusWarning, "Resource Manager is reachable, but the identity has no access to any subscription.", details
+	}
+	return connectionHealthStatusOk, fmt.Sprintf("Resource Manager is reachable, and the identity has access to %d subscription(s).", len(subscriptionIDs)), details
+}
+
+// checkConnectionSubscriptionAccess gets the subscription of the connection and checks its state
+func checkConnectionSubscriptionAccess(ctx context.Context, session *Session) (string, string, map[string]interface{}) {
+	if session == nil {
+		return connectionHealthStatusSkipped, "No token was acquired for Resource Manager.", nil
+	}
+	if session.SubscriptionID == "" {
+		return connectionHealthStatusError, "No subscription is set for the connection, in its config, in the environment or in the Azure CLI.", nil
+	}
+
+	client := subscriptions.NewClientWithBaseURI(session.ResourceManagerEndpoint)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, session.SubscriptionID)
+	if err != nil {
+		return connectionHealthStatusError, err.Error(), nil
+	}
+
+	details := map[string]interface{}{
+		"display_name": op.DisplayName,
+		"state":        op.State,
+	}
+	if op.State != subscriptions.StateEnabled {
+		return connectionHealthStatusWarning, fmt.Sprintf("The subscription is accessible, but its state is '%s'.", op.State), details
+	}
+	return connectionHealthStatusOk, "The subscription is accessible and enabled.", details
+}
+
+// checkConnectionMicrosoftGraph reads the organization of the tenant from Microsoft Graph, which is
+// reachable even when the identity is not allowed to read it
+func checkConnectionMicrosoftGraph(ctx context.Context, d *plugin.QueryData) (string, string, map[string]interface{}) {
+	client, err := getMicrosoftGraphClient(ctx, d)
+	if err != nil {
+		return connectionHealthStatusError, err.Error(), nil
+	}
+
+	var result struct {
+		Value []struct {
+			ID          *string `json:"id,omitempty"`
+			DisplayName *string `json:"displayName,omitempty"`
+		} `json:"value"`
+	}
+	err = client.Get(ctx, "organization", url.Values{"$select": []string{"id,displayName"}}, &result)
+	if err != nil {
+		if respErr, ok := err.(*azcore.ResponseError); ok && respErr.StatusCode == http.StatusForbidden {
+			return connectionHealthStatusWarning, "Microsoft Graph is reachable, but the identity is not allowed to read the directory, which the Microsoft Entra ID tables require.", nil
+		}
+		return connectionHealthStatusError, err.Error(), nil
+	}
+
+	details := map[string]interface{}{}
+	if len(result.Value) > 0 {
+		details["tenant_id"] = result.Value[0].ID
+		details["tenant_display_name"] = result.Value[0].DisplayName
+	}
+	return connectionHealthStatusOk, "Microsoft Graph is reachable, and the identity can read the directory.", details
+}
+
+// checkConnectionProviderRegistration summarizes the registration of the resource providers of
+// the subscription, as the resources of unregistered providers are not listed
+func checkConnectionProviderRegistration(ctx context.Context, session *Session) (string, string, map[string]interface{}) {
+	if session == nil {
+		return connectionHealthStatusSkipped, "No token was acquired for Resource Manager.", nil
+	}
+	if session.SubscriptionID == "" {
+		return connectionHealthStatusSkipped, "No subscription is set for the connection.", nil
+	}
+
+	client := resources.NewProvidersClientWithBaseURI(session.ResourceManagerEndpoint, session.SubscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListComplete(ctx, "")
+	if err != nil {
+		return connectionHealthStatusError, err.Error(), nil
+	}
+	registered := []string{}
+	unregistered := []string{}
+	for result.NotDone() {
+		provider := result.Value()
+		if provider.Namespace != nil && provider.RegistrationState != nil {
+			if *provider.RegistrationState == "Registered" {
+				registered = append(registered, *provider.Namespace)
+			} else {
+				unregistered = append(unregistered, *provider.Namespace)
+			}
+		}
+		if err := result.NextWithContext(ctx); err != nil {
+			return connectionHealthStatusError, err.Error(), nil
+		}
+	}
+
+	details := map[string]interface{}{
+		"registered":     registered,
+		"not_registered": unregistered,
+	}
+	return connectionHealthStatusOk, fmt.Sprintf("%d of %d resource providers are registered in the subscription.", len(registered), len(registered)+len(unregistered)), details
+}
@@ -0,0 +1,537 @@
+package azure
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/consumption/mgmt/consumption"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureConsumptionUsageDetail(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_consumption_usage_detail",
+		Description: "Azure Consumption Usage Detail, the line items of the usage and charges of a scope.",
+		List: &plugin.ListConfig{
+			Hydrate: listConsumptionUsageDetails,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:      "date",
+					Require:   plugin.Optional,
+					Operators: []string{">", ">=", "<", "<=", "="},
+				},
+				{
+					Name:    "resource_group",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "resource_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "charge_type",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "metric",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "scope",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the usage detail.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the usage detail.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "kind",
+				Description: "The kind of the usage detail, 'legacy' for the Enterprise Agreement and pay-as-you-go subscriptions or 'modern' for the Microsoft Customer Agreement ones.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Kind").Transform(transform.ToString),
+			},
+			{
+				Name:        "date",
+				Description: "The date of the usage.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Date").Transform(convertDateToTime),
+			},
+			{
+				Name:        "billing_period_start_date",
+				Description: "The start date of the billing period.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("BillingPeriodStartDate").Transform(convertDateToTime),
+			},
+			{
+				Name:        "billing_period_end_date",
+				Description: "The end date of the billing period.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("BillingPeriodEndDate").Transform(convertDateToTime),
+			},
+			{
+				Name:        "meter_id",
+				Description: "The ID of the meter of the usage.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MeterID"),
+			},
+			{
+				Name:        "meter_name",
+				Description: "The name of the meter of the usage. It is only returned for the legacy usage details if 'properties/meterDetails' is expanded.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "meter_category",
+				Description: "The category of the meter, for example 'Virtual Machines' or 'Storage'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "meter_sub_category",
+				Description: "The subcategory of the meter.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_family",
+				Description: "The service family of the meter, for example 'Compute'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "product",
+				Description: "The name of the product of the usage.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "quantity",
+				Description: "The quantity of the usage, in the unit of measure.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "unit_of_measure",
+				Description: "The unit of measure of the quantity.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "effective_price",
+				Description: "The effective price of a unit, after discounts. It is only returned for the legacy usage details.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "unit_price",
+				Description: "The price of a unit.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "cost",
+				Description: "The cost of the usage in the billing currency.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "cost_usd",
+				Description: "The cost of the usage in US dollars. It is only returned for the modern usage details.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("CostUSD"),
+			},
+			{
+				Name:        "billing_currency",
+				Description: "The billing currency of the cost.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_id",
+				Description: "The ID of the resource of the usage.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceID"),
+			},
+			{
+				Name:        "resource_name",
+				Description: "The name of the resource of the usage.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_location",
+				Description: "The location of the resource of the usage.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "consumed_service",
+				Description: "The resource provider of the usage, for example 'Microsoft.Compute'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "charge_type",
+				Description: "The type of the charge, for example 'Usage', 'Purchase' or 'Refund'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "frequency",
+				Description: "The frequency of the charge, for example 'UsageBased', 'OneTime' or 'Recurring'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "publisher_type",
+				Description: "The type of the publisher, for example 'Azure' or 'Marketplace'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "reservation_id",
+				Description: "The ID of the reservation the usage benefits from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ReservationID"),
+			},
+			{
+				Name:        "reservation_name",
+				Description: "The name of the reservation the usage benefits from.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cost_center",
+				Description: "The cost center of the usage.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "billing_account_id",
+				Description: "The ID of the billing account of the usage.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BillingAccountID"),
+			},
+			{
+				Name:        "billing_profile_id",
+				Description: "The ID of the billing profile of the usage.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BillingProfileID"),
+			},
+			{
+				Name:        "invoice_section_id",
+				Description: "The ID of the invoice section of the usage. It is only returned for the modern usage details.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("InvoiceSectionID"),
+			},
+			{
+				Name:        "invoice_section_name",
+				Description: "The name of the invoice section of the usage.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "subscription_name",
+				Description: "The name of the subscription of the usage.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "metric",
+				Description: "The type of the cost of the usage details, 'actualcost', 'amortizedcost' or 'usage'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("metric"),
+			},
+			{
+				Name:        "scope",
+				Description: "The scope of the usage details, for example '/subscriptions/{subscriptionId}' or '/providers/Microsoft.Billing/billingAccounts/{billingAccountId}'. Defaults to the subscription of the connection.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+			},
+		}),
+	}
+}
+
+type ConsumptionUsageDetailInfo struct {
+	ID                     *string
+	Name                   *string
+	Kind                   consumption.Kind
+	Tags                   map[string]*string
+	Scope                  string
+	Date                   *date.Time
+	BillingPeriodStartDate *date.Time
+	BillingPeriodEndDate   *date.Time
+	MeterID                *string
+	MeterName              *string
+	MeterCategory          *string
+	MeterSubCategory       *string
+	ServiceFamily          *string
+	Product                *string
+	Quantity               *float64
+	UnitOfMeasure          *string
+	EffectivePrice         *float64
+	UnitPrice              *float64
+	Cost                   *float64
+	CostUSD                *float64
+	BillingCurrency        *string
+	ResourceID             *string
+	ResourceName           *string
+	ResourceGroup          *string
+	ResourceLocation       *string
+	ConsumedService        *string
+	ChargeType             *string
+	Frequency              *string
+	PublisherType          *string
+	ReservationID          *string
+	ReservationName        *string
+	CostCenter             *string
+	BillingAccountID       *string
+	BillingProfileID       *string
+	InvoiceSectionID       *string
+	InvoiceSectionName     *string
+	SubscriptionName       *string
+}
+
+//// LIST FUNCTION
+
+func listConsumptionUsageDetails(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_consumption_usage_detail.listConsumptionUsageDetails", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := consumption.NewUsageDetailsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	scope := "/subscriptions/" + subscriptionID
+	if d.EqualsQualString("scope") != "" {
+		scope = d.EqualsQualString("scope")
+	}
+
+	var metric consumption.Metrictype
+	switch strings.ToLower(d.EqualsQualString("metric")) {
+	case "actualcost":
+		metric = consumption.MetrictypeActualCostMetricType
+	case "amortizedcost":
+		metric = consumption.MetrictypeAmortizedCostMetricType
+	case "usage":
+		metric = consumption.MetrictypeUsageMetricType
+	}
+
+	// The meter details of the legacy usage details are only returned if expanded
+	filter := buildConsumptionUsageDetailFilter(d.Quals)
+	result, err := client.List(ctx, scope, "properties/meterDetails", filter, "", nil, metric)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_consumption_usage_detail.listConsumptionUsageDetails", "api_error", err)
+		return nil, err
+	}
+
+	for _, detail := range result.Values() {
+		if info := consumptionUsageDetailInfo(detail, scope); info != nil {
+			d.StreamListItem(ctx, info)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_consumption_usage_detail.listConsumptionUsageDetails", "api_paging_error", err)
+			return nil, err
+		}
+		for _, detail := range result.Values() {
+			if info := consumptionUsageDetailInfo(detail, scope); info != nil {
+				d.StreamListItem(ctx, info)
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// buildConsumptionUsageDetailFilter builds the filter of the usage details from the quals. The API
+// requires a usage period, which defaults to the current month to date.
+func buildConsumptionUsageDetailFilter(quals plugin.KeyColumnQualMap) string {
+	var from, to time.Time
+	if quals["date"] != nil {
+		for _, q := range quals["date"].Quals {
+			value := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case ">", ">=":
+				from = value
+			case "<", "<=":
+				to = value
+			case "=":
+				from, to = value, value
+			}
+		}
+	}
+
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if from.IsZero() {
+		from = time.Date(to.Year(), to.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	layout := "2006-01-02"
+	filters := []string{
+		"properties/usageStart ge '" + from.Format(layout) + "'",
+		"properties/usageEnd le '" + to.Format(layout) + "'",
+	}
+
+	filterQuals := map[string]string{
+		"resource_group": "properties/resourceGroup",
+		"resource_id":    "properties/resourceId",
+		"charge_type":    "properties/chargeType",
+	}
+	for columnName, filterName := range filterQuals {
+		if quals[columnName] != nil {
+			for _, q := range quals[columnName].Quals {
+				if q.Operator == "=" {
+					filters = append(filters, filterName+" eq '"+q.Value.GetStringValue()+"'")
+				}
+			}
+		}
+	}
+
+	return strings.Join(filters, " and ")
+}
+
+// consumptionUsageDetailInfo flattens the properties of the legacy and the modern usage details,
+// which name most of them differently.
+func consumptionUsageDetailInfo(detail consumption.BasicUsageDetail, scope string) *ConsumptionUsageDetailInfo {
+	if legacy, ok := detail.AsLegacyUsageDetail(); ok {
+		info := &ConsumptionUsageDetailInfo{
+			ID:    legacy.ID,
+			Name:  legacy.Name,
+			Kind:  legacy.Kind,
+			Tags:  legacy.Tags,
+			Scope: scope,
+		}
+		if p := legacy.LegacyUsageDetailProperties; p != nil {
+			info.Date = p.Date
+			info.BillingPeriodStartDate = p.BillingPeriodStartDate
+			info.BillingPeriodEndDate = p.BillingPeriodEndDate
+			if p.MeterID != nil {
+				meterID := p.MeterID.String()
+				info.MeterID = &meterID
+			}
+			if p.MeterDetails != nil {
+				info.MeterName = p.MeterDetails.MeterName
+				info.MeterCategory = p.MeterDetails.MeterCategory
+				info.MeterSubCategory = p.MeterDetails.MeterSubCategory
+				info.ServiceFamily = p.MeterDetails.ServiceFamily
+				info.UnitOfMeasure = p.MeterDetails.UnitOfMeasure
+			}
+			info.Product = p.Product
+			info.Quantity = decimalToFloat64(p.Quantity)
+			info.EffectivePrice = decimalToFloat64(p.EffectivePrice)
+			info.UnitPrice = decimalToFloat64(p.UnitPrice)
+			info.Cost = decimalToFloat64(p.Cost)
+			info.BillingCurrency = p.BillingCurrency
+			info.ResourceID = p.ResourceID
+			info.ResourceName = p.ResourceName
+			info.ResourceGroup = p.ResourceGroup
+			info.ResourceLocation = p.ResourceLocation
+			info.ConsumedService = p.ConsumedService
+			info.ChargeType = p.ChargeType
+			info.Frequency = p.Frequency
+			info.PublisherType = p.PublisherType
+			info.ReservationID = p.ReservationID
+			info.ReservationName = p.ReservationName
+			info.CostCenter = p.CostCenter
+			info.BillingAccountID = p.BillingAccountID
+			info.BillingProfileID = p.BillingProfileID
+			info.InvoiceSectionName = p.InvoiceSection
+			info.SubscriptionName = p.SubscriptionName
+		}
+		return info
+	}
+
+	if modern, ok := detail.AsModernUsageDetail(); ok {
+		info := &ConsumptionUsageDetailInfo{
+			ID:    modern.ID,
+			Name:  modern.Name,
+			Kind:  modern.Kind,
+			Tags:  modern.Tags,
+			Scope: scope,
+		}
+		if p := modern.ModernUsageDetailProperties; p != nil {
+			info.Date = p.Date
+			info.BillingPeriodStartDate = p.BillingPeriodStartDate
+			info.BillingPeriodEndDate = p.BillingPeriodEndDate
+			info.MeterID = p.MeterID
+			info.MeterName = p.MeterName
+			info.MeterCategory = p.MeterCategory
+			info.MeterSubCategory = p.MeterSubCategory
+			info.ServiceFamily = p.ServiceFamily
+			info.Product = p.Product
+			info.Quantity = decimalToFloat64(p.Quantity)
+			info.UnitOfMeasure = p.UnitOfMeasure
+			info.UnitPrice = decimalToFloat64(p.UnitPrice)
+			info.Cost = decimalToFloat64(p.CostInBillingCurrency)
+			info.CostUSD = decimalToFloat64(p.CostInUSD)
+			info.BillingCurrency = p.BillingCurrencyCode
+			// The instance name of the modern usage details is the ID of the resource
+			info.ResourceID = p.InstanceName
+			if p.InstanceName != nil {
+				resourceName := getLastPathElement(*p.InstanceName)
+				info.ResourceName = &resourceName
+			}
+			info.ResourceGroup = p.ResourceGroup
+			info.ResourceLocation = p.ResourceLocation
+			info.ConsumedService = p.ConsumedService
+			info.ChargeType = p.ChargeType
+			info.Frequency = p.Frequency
+			info.PublisherType = p.PublisherType
+			info.ReservationID = p.ReservationID
+			info.ReservationName = p.ReservationName
+			info.CostCenter = p.CostCenter
+			info.BillingAccountID = p.BillingAccountID
+			info.BillingProfileID = p.BillingProfileID
+			info.InvoiceSectionID = p.InvoiceSectionID
+			info.InvoiceSectionName = p.InvoiceSectionName
+			info.SubscriptionName = p.SubscriptionName
+		}
+		return info
+	}
+
+	return nil
+}
+
+// The amounts of the usage details are decimals, which are reported as floats
+func decimalToFloat64[T interface{ Float64() (float64, bool) }](value *T) *float64 {
+	if value == nil {
+		return nil
+	}
+	f, _ := (*value).Float64()
+	return &f
+}
@@ -127,11 +127,48 @@ func tableAzureContainerGroup(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("ContainerGroupProperties.ImageRegistryCredentials"),
 			},
+			{
+				Name:        "image_registry_credential_identities",
+				Description: "The managed identities used to pull images from the image registries, as a list of registry server and identity.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ContainerGroupProperties.ImageRegistryCredentials").Transform(extractContainerGroupRegistryIdentities),
+			},
+			{
+				Name:        "container_probes",
+				Description: "The liveness and readiness probes of each container of the container group.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ContainerGroupProperties.Containers").Transform(extractContainerGroupProbes),
+			},
+			{
+				Name:        "confidential_compute_properties",
+				Description: "The confidential compute properties of a container group with the 'Confidential' SKU, including the confidential computing enforcement policy.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getContainerGroupConfidentialComputeProperties,
+				Transform:   transform.FromField("Properties.ConfidentialComputeProperties"),
+			},
 			{
 				Name:        "identity",
 				Description: "The identity of the container group.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "identity_type",
+				Description: "The type of managed identity used by the resource. Possible values include: 'SystemAssigned', 'UserAssigned', 'SystemAssigned, UserAssigned', 'None'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityType),
+			},
+			{
+				Name:        "system_assigned_principal_id",
+				Description: "The principal ID of the system-assigned managed identity of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityPrincipalID),
+			},
+			{
+				Name:        "user_assigned_identity_ids",
+				Description: "The resource IDs of the user-assigned managed identities associated with the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityUserAssignedIDs),
+			},
 
 			// Steampipe standard columns
 			{
@@ -246,4 +283,82 @@ func getContainerGroup(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 	}
 
 	return op, nil
-}
\ No newline at end of file
+}
+
+// The confidential compute properties are not available in the Container Instance API version of the SDK
+const containerGroupConfidentialComputeAPIVersion = "2023-05-01"
+
+type ContainerGroupConfidentialCompute struct {
+	Properties *ContainerGroupConfidentialComputeGroupProperties `json:"properties,omitempty"`
+}
+
+type ContainerGroupConfidentialComputeGroupProperties struct {
+	ConfidentialComputeProperties *ContainerGroupConfidentialComputeProperties `json:"confidentialComputeProperties,omitempty"`
+}
+
+type ContainerGroupConfidentialComputeProperties struct {
+	CcePolicy *string `json:"ccePolicy,omitempty"`
+}
+
+func getContainerGroupConfidentialComputeProperties(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	group := h.Item.(containerinstance.ContainerGroup)
+
+	// Only container groups with the Confidential SKU have confidential compute properties
+	if group.ContainerGroupProperties == nil || group.Sku != "Confidential" {
+		return nil, nil
+	}
+
+	var result ContainerGroupConfidentialCompute
+	found, err := getResourceManagerResource(ctx, d, *group.ID, containerGroupConfidentialComputeAPIVersion, &result)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_container_group.getContainerGroupConfidentialComputeProperties", "api_error", err)
+		return nil, err
+	}
+	if !found {
+		return nil, nil
+	}
+
+	return result, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func extractContainerGroupRegistryIdentities(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	credentials, ok := d.Value.(*[]containerinstance.ImageRegistryCredential)
+	if !ok || credentials == nil {
+		return nil, nil
+	}
+
+	identities := []map[string]interface{}{}
+	for _, credential := range *credentials {
+		if credential.Identity == nil {
+			continue
+		}
+		identities = append(identities, map[string]interface{}{
+			"server":      credential.Server,
+			"identity":    credential.Identity,
+			"identityUrl": credential.IdentityURL,
+		})
+	}
+	return identities, nil
+}
+
+func extractContainerGroupProbes(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	containers, ok := d.Value.(*[]containerinstance.Container)
+	if !ok || containers == nil {
+		return nil, nil
+	}
+
+	probes := []map[string]interface{}{}
+	for _, container := range *containers {
+		probe := map[string]interface{}{
+			"name": container.Name,
+		}
+		if container.ContainerProperties != nil {
+			probe["livenessProbe"] = container.LivenessProbe
+			probe["readinessProbe"] = container.ReadinessProbe
+		}
+		probes = append(probes, probe)
+	}
+	return probes, nil
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/cosmos-db/mgmt/documentdb"
+	"github.com/turbot/go-kit/types"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 
@@ -155,6 +156,18 @@ func tableAzureCosmosDBAccount(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("DatabaseAccount.DatabaseAccountGetProperties.KeyVaultKeyURI"),
 			},
+			{
+				Name:        "customer_managed_key_enabled",
+				Description: "Indicates whether the account is encrypted with a customer-managed key stored in Azure Key Vault.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromP(extractCosmosDBAccountCustomerManagedKey, "enabled"),
+			},
+			{
+				Name:        "customer_managed_key_uri",
+				Description: "The URI of the Key Vault key used to encrypt the account.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(extractCosmosDBAccountCustomerManagedKey, "uri"),
+			},
 			{
 				Name:        "provisioning_state",
 				Description: "The provisioning state of the database account resource.",
@@ -167,6 +180,12 @@ func tableAzureCosmosDBAccount(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("DatabaseAccount.DatabaseAccountGetProperties.PublicNetworkAccess").Transform(transform.ToString),
 			},
+			{
+				Name:        "public_network_exposed",
+				Description: "Indicates whether the account is reachable from any address on the internet, derived from the public network access setting and the firewall default action.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.From(cosmosDBAccountPublicNetworkExposed),
+			},
 			{
 				Name:        "server_version",
 				Description: "Describes the ServerVersion of an a MongoDB account.",
@@ -239,6 +258,24 @@ func tableAzureCosmosDBAccount(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("DatabaseAccount.DatabaseAccountGetProperties.WriteLocations"),
 			},
+			{
+				Name:        "identity_type",
+				Description: "The type of managed identity used by the resource. Possible values include: 'SystemAssigned', 'UserAssigned', 'SystemAssigned, UserAssigned', 'None'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DatabaseAccount.Identity").Transform(extractManagedIdentityType),
+			},
+			{
+				Name:        "system_assigned_principal_id",
+				Description: "The principal ID of the system-assigned managed identity of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DatabaseAccount.Identity").Transform(extractManagedIdentityPrincipalID),
+			},
+			{
+				Name:        "user_assigned_identity_ids",
+				Description: "The resource IDs of the user-assigned managed identities associated with the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DatabaseAccount.Identity").Transform(extractManagedIdentityUserAssignedIDs),
+			},
 
 			// Steampipe standard columns
 			{
@@ -345,6 +382,21 @@ func getCosmosDBAccount(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 
 //// TRANSFORM FUNCTIONS
 
+// The account allows all networks unless IP rules or virtual network rules
+// restrict access to it
+func cosmosDBAccountPublicNetworkExposed(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	info := d.HydrateItem.(databaseAccountInfo)
+	properties := info.DatabaseAccount.DatabaseAccountGetProperties
+	if properties == nil {
+		return false, nil
+	}
+
+	hasIPRules := properties.IPRules != nil && len(*properties.IPRules) > 0
+	hasVirtualNetworkFilter := properties.IsVirtualNetworkFilterEnabled != nil && *properties.IsVirtualNetworkFilterEnabled
+
+	return isPublicNetworkExposed(string(properties.PublicNetworkAccess), !hasIPRules && !hasVirtualNetworkFilter), nil
+}
+
 func extractCosmosDBVirtualNetworkRule(ctx context.Context, d *transform.TransformData) (interface{}, error) {
 	info := d.HydrateItem.(databaseAccountInfo)
 	if info.DatabaseAccount.DatabaseAccountGetProperties != nil {
@@ -404,3 +456,21 @@ func cosmosDBPrivateEndpointConnectionMap(ctx context.Context, d *transform.Tran
 
 	return privateEndpointConnections, nil
 }
+
+func extractCosmosDBAccountCustomerManagedKey(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	info := d.HydrateItem.(databaseAccountInfo)
+
+	var keyURI string
+	if info.DatabaseAccount.DatabaseAccountGetProperties != nil {
+		keyURI = types.SafeString(info.DatabaseAccount.KeyVaultKeyURI)
+	}
+	enabled := keyURI != ""
+
+	if d.Param.(string) == "enabled" {
+		return enabled, nil
+	}
+	if keyURI == "" {
+		return nil, nil
+	}
+	return keyURI, nil
+}
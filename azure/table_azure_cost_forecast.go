@@ -0,0 +1,232 @@
+package azure
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/costmanagement/armcostmanagement/v2"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureCostForecast(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_cost_forecast",
+		Description: "Azure Cost Forecast, the daily forecast of the cost of a scope, along with its actual cost to date.",
+		List: &plugin.ListConfig{
+			Hydrate: listCostForecasts,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "cost_type",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "scope",
+					Require: plugin.Optional,
+				},
+				{
+					Name:      "usage_date",
+					Require:   plugin.Optional,
+					Operators: []string{">", "<", ">=", "<="},
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "usage_date",
+				Description: "The day of the cost.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "cost_status",
+				Description: "Indicates whether the cost of the day is the 'Actual' cost or a 'Forecast'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cost",
+				Description: "The actual or forecasted cost in the billing currency.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "cost_lower_bound",
+				Description: "The lower bound of the confidence interval of the forecasted cost, if returned by the forecast.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "cost_upper_bound",
+				Description: "The upper bound of the confidence interval of the forecasted cost, if returned by the forecast.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "currency",
+				Description: "The billing currency of the cost.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cost_type",
+				Description: "The type of the cost, 'ActualCost' or 'AmortizedCost'. Defaults to 'ActualCost'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "scope",
+				Description: "The scope of the forecast, for example '/subscriptions/{subscriptionId}' or '/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}'. Defaults to the subscription of the connection.",
+				Type:        proto.ColumnType_STRING,
+			},
+		}),
+	}
+}
+
+type CostForecastInfo struct {
+	UsageDate      *time.Time
+	CostStatus     *string
+	Cost           *float64
+	CostLowerBound *float64
+	CostUpperBound *float64
+	Currency       *string
+	CostType       string
+	Scope          string
+}
+
+//// LIST FUNCTION
+
+func listCostForecasts(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_cost_forecast.listCostForecasts", "session_error", err)
+		return nil, err
+	}
+
+	query := CostForecastInfo{
+		CostType: string(armcostmanagement.ForecastTypeActualCost),
+		Scope:    "/subscriptions/" + session.SubscriptionID,
+	}
+	if value := d.EqualsQualString("cost_type"); value != "" {
+		query.CostType = value
+	}
+	if value := d.EqualsQualString("scope"); value != "" {
+		query.Scope = value
+	}
+
+	// The forecast is for the current month by default, with the actual cost of its past days
+	timePeriod := costForecastTimePeriod(d.Quals)
+	definition := armcostmanagement.ForecastDefinition{
+		Type:                    to.Ptr(armcostmanagement.ForecastType(query.CostType)),
+		Timeframe:               to.Ptr(armcostmanagement.ForecastTimeframeCustom),
+		TimePeriod:              &timePeriod,
+		IncludeActualCost:       to.Ptr(true),
+		IncludeFreshPartialCost: to.Ptr(false),
+		Dataset: &armcostmanagement.ForecastDataset{
+			Granularity: to.Ptr(armcostmanagement.GranularityTypeDaily),
+			Aggregation: map[string]*armcostmanagement.ForecastAggregation{
+				"Cost": {
+					Name:     to.Ptr(armcostmanagement.FunctionNameCost),
+					Function: to.Ptr(armcostmanagement.FunctionTypeSum),
+				},
+			},
+		},
+	}
+
+	url := strings.TrimPrefix(query.Scope, "/") + "/providers/Microsoft.CostManagement/forecast"
+	apiVersion := costManagementQueryAPIVersion
+	for {
+		var result armcostmanagement.ForecastResult
+		err := postResourceManagerRequest(ctx, d, url, apiVersion, definition, &result)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_cost_forecast.listCostForecasts", "api_error", err)
+			return nil, err
+		}
+		if result.Properties == nil {
+			break
+		}
+
+		for _, row := range costForecastRows(query, result.Properties) {
+			d.StreamListItem(ctx, row)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if result.Properties.NextLink == nil || *result.Properties.NextLink == "" {
+			break
+		}
+		// Next links already carry the api-version and the continuation token
+		url = *result.Properties.NextLink
+		apiVersion = ""
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// costForecastTimePeriod returns the time period constrained by the usage_date quals.
+// The start defaults to the first day of the current month, and the end to its last day.
+func costForecastTimePeriod(quals plugin.KeyColumnQualMap) armcostmanagement.ForecastTimePeriod {
+	now := time.Now().UTC()
+	from := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	until := from.AddDate(0, 1, 0).Add(-time.Second)
+
+	if quals["usage_date"] != nil {
+		for _, q := range quals["usage_date"].Quals {
+			value := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case ">", ">=":
+				from = value
+			case "<", "<=":
+				until = value
+			}
+		}
+	}
+
+	return armcostmanagement.ForecastTimePeriod{
+		From: &from,
+		To:   &until,
+	}
+}
+
+// costForecastRows maps the rows of a forecast result by the names of its columns.
+// The bounds of the confidence interval are the columns suffixed by their direction,
+// such as Cost_UpperBound, when the forecast returns them.
+func costForecastRows(query CostForecastInfo, properties *armcostmanagement.ForecastProperties) []CostForecastInfo {
+	rows := []CostForecastInfo{}
+	for _, row := range properties.Rows {
+		forecast := query
+		for i, column := range properties.Columns {
+			if column == nil || column.Name == nil || i >= len(row) {
+				continue
+			}
+			name := strings.ToLower(*column.Name)
+			switch v := row[i].(type) {
+			case float64:
+				switch {
+				case name == "usagedate":
+					forecast.UsageDate = costUsageDate(v)
+				case strings.Contains(name, "lower"):
+					forecast.CostLowerBound = to.Ptr(v)
+				case strings.Contains(name, "upper"):
+					forecast.CostUpperBound = to.Ptr(v)
+				case name == "cost" || name == "pretaxcost":
+					forecast.Cost = to.Ptr(v)
+				}
+			case string:
+				switch name {
+				case "coststatus":
+					forecast.CostStatus = to.Ptr(v)
+				case "currency":
+					forecast.Currency = to.Ptr(v)
+				case "usagedate":
+					forecast.UsageDate = costUsageDate(v)
+				}
+			}
+		}
+		rows = append(rows, forecast)
+	}
+
+	return rows
+}
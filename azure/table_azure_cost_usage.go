@@ -0,0 +1,344 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/costmanagement/armcostmanagement/v2"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// The query client of the SDK does not follow the next links of the results, so
+// the queries are sent over REST with the request and response models of the SDK
+const costManagementQueryAPIVersion = "2022-10-01"
+
+//// TABLE DEFINITION
+
+func tableAzureCostUsage(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_cost_usage",
+		Description: "Azure Cost Usage, the actual and amortized cost of a scope per day or month, grouped by a dimension or a tag.",
+		List: &plugin.ListConfig{
+			Hydrate: listCostUsages,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "granularity",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "timeframe",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "dimension",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "cost_type",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "scope",
+					Require: plugin.Optional,
+				},
+				{
+					Name:      "usage_date",
+					Require:   plugin.Optional,
+					Operators: []string{">", "<", ">=", "<="},
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "usage_date",
+				Description: "The day of the cost, or the first day of its billing month if the granularity is 'Monthly'.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "dimension_value",
+				Description: "The value of the dimension, or of the tag, the cost is grouped by, for example the name of a service or the ID of a resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cost",
+				Description: "The cost in the billing currency.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "cost_usd",
+				Description: "The cost in US dollars.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("CostUSD"),
+			},
+			{
+				Name:        "currency",
+				Description: "The billing currency of the cost.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cost_type",
+				Description: "The type of the cost, 'ActualCost' or 'AmortizedCost'. Both types are returned if none is specified.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "granularity",
+				Description: "The granularity of the rows, 'Daily' or 'Monthly'. Defaults to 'Daily'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "timeframe",
+				Description: "The time frame of the query. Possible values include: 'MonthToDate', 'BillingMonthToDate', 'TheLastMonth', 'TheLastBillingMonth', 'WeekToDate', 'Custom'. Defaults to 'MonthToDate', or to 'Custom' if usage_date is constrained.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "dimension",
+				Description: "The dimension the cost is grouped by, for example 'ServiceName', 'ResourceGroupName', 'ResourceId' or 'ResourceLocation', or 'tag:{key}' to group it by the values of a tag. Defaults to 'ServiceName'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "scope",
+				Description: "The scope of the query, for example '/subscriptions/{subscriptionId}' or '/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}'. Defaults to the subscription of the connection.",
+				Type:        proto.ColumnType_STRING,
+			},
+		}),
+	}
+}
+
+type CostUsageInfo struct {
+	UsageDate      *time.Time
+	DimensionValue *string
+	Cost           *float64
+	CostUSD        *float64
+	Currency       *string
+	CostType       string
+	Granularity    string
+	Timeframe      string
+	Dimension      string
+	Scope          string
+}
+
+//// LIST FUNCTION
+
+func listCostUsages(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_cost_usage.listCostUsages", "session_error", err)
+		return nil, err
+	}
+
+	// The values of the quals are returned as given, so that the rows are not filtered out
+	query := CostUsageInfo{
+		Granularity: "Daily",
+		Timeframe:   string(armcostmanagement.TimeframeTypeMonthToDate),
+		Dimension:   "ServiceName",
+		Scope:       "/subscriptions/" + session.SubscriptionID,
+	}
+	if value := d.EqualsQualString("granularity"); value != "" {
+		query.Granularity = value
+	}
+	if value := d.EqualsQualString("timeframe"); value != "" {
+		query.Timeframe = value
+	}
+	if value := d.EqualsQualString("dimension"); value != "" {
+		query.Dimension = value
+	}
+	if value := d.EqualsQualString("scope"); value != "" {
+		query.Scope = value
+	}
+
+	costTypes := []string{string(armcostmanagement.ExportTypeActualCost), string(armcostmanagement.ExportTypeAmortizedCost)}
+	if value := d.EqualsQualString("cost_type"); value != "" {
+		costTypes = []string{value}
+	}
+
+	timePeriod := costUsageTimePeriod(d.Quals)
+	if timePeriod != nil && d.EqualsQualString("timeframe") == "" {
+		query.Timeframe = string(armcostmanagement.TimeframeTypeCustom)
+	}
+
+	for _, costType := range costTypes {
+		query.CostType = costType
+		definition, err := costUsageQueryDefinition(query, timePeriod)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_cost_usage.listCostUsages", "query_error", err)
+			return nil, err
+		}
+
+		url := strings.TrimPrefix(query.Scope, "/") + "/providers/Microsoft.CostManagement/query"
+		apiVersion := costManagementQueryAPIVersion
+		for {
+			var result armcostmanagement.QueryResult
+			err := postResourceManagerRequest(ctx, d, url, apiVersion, definition, &result)
+			if err != nil {
+				plugin.Logger(ctx).Error("azure_cost_usage.listCostUsages", "api_error", err)
+				return nil, err
+			}
+			if result.Properties == nil {
+				break
+			}
+
+			for _, row := range costUsageRows(query, result.Properties) {
+				d.StreamListItem(ctx, row)
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+
+			if result.Properties.NextLink == nil || *result.Properties.NextLink == "" {
+				break
+			}
+			// Next links already carry the api-version and the continuation token
+			url = *result.Properties.NextLink
+			apiVersion = ""
+		}
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// costUsageTimePeriod returns the time period constrained by the usage_date quals, if any.
+// The start defaults to the first day of the month of the end, which defaults to now.
+func costUsageTimePeriod(quals plugin.KeyColumnQualMap) *armcostmanagement.QueryTimePeriod {
+	if quals["usage_date"] == nil {
+		return nil
+	}
+
+	var from, until *time.Time
+	for _, q := range quals["usage_date"].Quals {
+		value := q.Value.GetTimestampValue().AsTime()
+		switch q.Operator {
+		case ">", ">=":
+			from = &value
+		case "<", "<=":
+			until = &value
+		}
+	}
+
+	if until == nil {
+		until = to.Ptr(time.Now().UTC())
+	}
+	if from == nil {
+		from = to.Ptr(time.Date(until.Year(), until.Month(), 1, 0, 0, 0, 0, time.UTC))
+	}
+
+	return &armcostmanagement.QueryTimePeriod{
+		From: from,
+		To:   until,
+	}
+}
+
+func costUsageQueryDefinition(query CostUsageInfo, timePeriod *armcostmanagement.QueryTimePeriod) (armcostmanagement.QueryDefinition, error) {
+	// The SDK only declares the daily granularity, although the API accepts the monthly one
+	granularity := armcostmanagement.GranularityType(query.Granularity)
+	if !strings.EqualFold(query.Granularity, "Daily") && !strings.EqualFold(query.Granularity, "Monthly") {
+		return armcostmanagement.QueryDefinition{}, fmt.Errorf("unsupported granularity %q, it must be 'Daily' or 'Monthly'", query.Granularity)
+	}
+
+	grouping := &armcostmanagement.QueryGrouping{
+		Name: to.Ptr(query.Dimension),
+		Type: to.Ptr(armcostmanagement.QueryColumnTypeDimension),
+	}
+	if strings.HasPrefix(strings.ToLower(query.Dimension), "tag:") {
+		grouping = &armcostmanagement.QueryGrouping{
+			Name: to.Ptr(query.Dimension[len("tag:"):]),
+			Type: to.Ptr(armcostmanagement.QueryColumnTypeTagKey),
+		}
+	}
+
+	definition := armcostmanagement.QueryDefinition{
+		Type:      to.Ptr(armcostmanagement.ExportType(query.CostType)),
+		Timeframe: to.Ptr(armcostmanagement.TimeframeType(query.Timeframe)),
+		Dataset: &armcostmanagement.QueryDataset{
+			Granularity: &granularity,
+			Aggregation: map[string]*armcostmanagement.QueryAggregation{
+				"Cost": {
+					Name:     to.Ptr("Cost"),
+					Function: to.Ptr(armcostmanagement.FunctionTypeSum),
+				},
+				"CostUSD": {
+					Name:     to.Ptr("CostUSD"),
+					Function: to.Ptr(armcostmanagement.FunctionTypeSum),
+				},
+			},
+			Grouping: []*armcostmanagement.QueryGrouping{grouping},
+		},
+	}
+	if strings.EqualFold(query.Timeframe, string(armcostmanagement.TimeframeTypeCustom)) {
+		if timePeriod == nil {
+			return armcostmanagement.QueryDefinition{}, fmt.Errorf("the 'Custom' timeframe requires usage_date to be constrained")
+		}
+		definition.TimePeriod = timePeriod
+	}
+
+	return definition, nil
+}
+
+// costUsageRows maps the rows of a query result by the names of its columns, which
+// depend on the granularity and on the grouping of the query.
+func costUsageRows(query CostUsageInfo, properties *armcostmanagement.QueryProperties) []CostUsageInfo {
+	columns := map[string]int{}
+	for i, column := range properties.Columns {
+		if column != nil && column.Name != nil {
+			columns[strings.ToLower(*column.Name)] = i
+		}
+	}
+	value := func(row []interface{}, names ...string) interface{} {
+		for _, name := range names {
+			if i, ok := columns[strings.ToLower(name)]; ok && i < len(row) {
+				return row[i]
+			}
+		}
+		return nil
+	}
+
+	dimension := query.Dimension
+	if strings.HasPrefix(strings.ToLower(dimension), "tag:") {
+		dimension = "TagValue"
+	}
+
+	rows := []CostUsageInfo{}
+	for _, row := range properties.Rows {
+		usage := query
+		usage.UsageDate = costUsageDate(value(row, "UsageDate", "BillingMonth"))
+		if cost, ok := value(row, "Cost").(float64); ok {
+			usage.Cost = &cost
+		}
+		if costUSD, ok := value(row, "CostUSD").(float64); ok {
+			usage.CostUSD = &costUSD
+		}
+		if currency, ok := value(row, "Currency").(string); ok {
+			usage.Currency = &currency
+		}
+		if dimensionValue, ok := value(row, dimension).(string); ok {
+			usage.DimensionValue = &dimensionValue
+		}
+		rows = append(rows, usage)
+	}
+
+	return rows
+}
+
+// The daily rows have a UsageDate number such as 20240131, and the monthly rows
+// have a BillingMonth string such as 2024-01-01T00:00:00
+func costUsageDate(value interface{}) *time.Time {
+	switch v := value.(type) {
+	case float64:
+		if date, err := time.Parse("20060102", fmt.Sprintf("%.0f", v)); err == nil {
+			return &date
+		}
+	case string:
+		if date, err := time.Parse("2006-01-02T15:04:05", v); err == nil {
+			return &date
+		}
+	}
+	return nil
+}
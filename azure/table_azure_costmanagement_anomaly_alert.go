@@ -0,0 +1,287 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/costmanagement/armcostmanagement/v2"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// The alert type used by Cost Management for anomaly detection alerts. The
+// value is not yet part of the AlertType enum of the SDK.
+const costManagementAlertTypeAnomaly armcostmanagement.AlertType = "Anomaly"
+
+//// TABLE DEFINITION
+
+func tableAzureCostManagementAnomalyAlert(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_costmanagement_anomaly_alert",
+		Description: "Azure Cost Management Anomaly Alert",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getCostManagementAnomalyAlert,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"NotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCostManagementAnomalyAlerts,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the alert.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the alert.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the anomaly, including the detected change in cost.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Description"),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the alert. Possible values include: 'Active', 'Dismissed', 'None', 'Overridden', 'Resolved'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Status"),
+			},
+			{
+				Name:        "alert_category",
+				Description: "The category of the alert.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Definition.Category"),
+			},
+			{
+				Name:        "alert_criteria",
+				Description: "The criteria that triggered the alert.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Definition.Criteria"),
+			},
+			{
+				Name:        "source",
+				Description: "The source of the alert. Possible values include: 'Preset', 'User'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Source"),
+			},
+			{
+				Name:        "scope",
+				Description: "The scope in which the anomaly was detected.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractCostManagementAlertScope),
+			},
+			{
+				Name:        "detected_at",
+				Description: "The time when the anomaly was detected and the alert was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.CreationTime").NullIfZero(),
+			},
+			{
+				Name:        "closed_at",
+				Description: "The time when the alert was closed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.CloseTime").NullIfZero(),
+			},
+			{
+				Name:        "modified_at",
+				Description: "The time when the alert was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.ModificationTime").NullIfZero(),
+			},
+			{
+				Name:        "status_modified_at",
+				Description: "The time when the status of the alert was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.StatusModificationTime").NullIfZero(),
+			},
+			{
+				Name:        "status_modified_by",
+				Description: "The user who last modified the status of the alert.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.StatusModificationUserName"),
+			},
+			{
+				Name:        "current_spend",
+				Description: "The actual spend when the anomaly was detected.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Properties.Details.CurrentSpend"),
+			},
+			{
+				Name:        "expected_spend",
+				Description: "The expected spend for the period in which the anomaly was detected.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Properties.Details.Amount"),
+			},
+			{
+				Name:        "unit",
+				Description: "The currency of the spend amounts.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Details.Unit"),
+			},
+			{
+				Name:        "time_grain_type",
+				Description: "The time grain at which the anomaly was evaluated.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Details.TimeGrainType"),
+			},
+			{
+				Name:        "resource_group_filter",
+				Description: "The resource groups affected by the anomaly.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Details.ResourceGroupFilter"),
+			},
+			{
+				Name:        "resource_filter",
+				Description: "The resources affected by the anomaly.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Details.ResourceFilter"),
+			},
+			{
+				Name:        "meter_filter",
+				Description: "The meters affected by the anomaly.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Details.MeterFilter"),
+			},
+			{
+				Name:        "tag_filter",
+				Description: "The tags affected by the anomaly.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Details.TagFilter"),
+			},
+			{
+				Name:        "contact_emails",
+				Description: "The email addresses notified of the anomaly.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Details.ContactEmails"),
+			},
+			{
+				Name:        "details",
+				Description: "The details of the alert.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Details"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCostManagementAnomalyAlerts(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_costmanagement_anomaly_alert.listCostManagementAnomalyAlerts", "session_error", err)
+		return nil, err
+	}
+
+	client, err := armcostmanagement.NewAlertsClient(session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_costmanagement_anomaly_alert.listCostManagementAnomalyAlerts", "client_error", err)
+		return nil, err
+	}
+
+	// The API doesn't support pagination
+	result, err := client.List(ctx, "subscriptions/"+session.SubscriptionID, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_costmanagement_anomaly_alert.listCostManagementAnomalyAlerts", "api_error", err)
+		return nil, err
+	}
+
+	for _, alert := range result.Value {
+		// The API returns all cost alerts; only keep the anomaly alerts
+		if !isCostManagementAnomalyAlert(alert) {
+			continue
+		}
+		d.StreamListItem(ctx, *alert)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCostManagementAnomalyAlert(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Return nil, if no input provided
+	if name == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_costmanagement_anomaly_alert.getCostManagementAnomalyAlert", "session_error", err)
+		return nil, err
+	}
+
+	client, err := armcostmanagement.NewAlertsClient(session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_costmanagement_anomaly_alert.getCostManagementAnomalyAlert", "client_error", err)
+		return nil, err
+	}
+
+	op, err := client.Get(ctx, "subscriptions/"+session.SubscriptionID, name, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_costmanagement_anomaly_alert.getCostManagementAnomalyAlert", "api_error", err)
+		return nil, err
+	}
+
+	if op.ID != nil && isCostManagementAnomalyAlert(&op.Alert) {
+		return op.Alert, nil
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+func isCostManagementAnomalyAlert(alert *armcostmanagement.Alert) bool {
+	if alert == nil || alert.Properties == nil || alert.Properties.Definition == nil || alert.Properties.Definition.Type == nil {
+		return false
+	}
+	return strings.EqualFold(string(*alert.Properties.Definition.Type), string(costManagementAlertTypeAnomaly))
+}
+
+//// TRANSFORM FUNCTIONS
+
+// The alert ID has the form {scope}/providers/Microsoft.CostManagement/alerts/{name}
+func extractCostManagementAlertScope(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	id := types.SafeString(d.Value)
+	index := strings.Index(strings.ToLower(id), "/providers/microsoft.costmanagement/alerts/")
+	if index == -1 {
+		return nil, nil
+	}
+	return id[:index], nil
+}
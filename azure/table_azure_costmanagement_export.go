@@ -0,0 +1,267 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/costmanagement/armcostmanagement/v2"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureCostManagementExport(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_costmanagement_export",
+		Description: "Azure Cost Management Export",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getCostManagementExport,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"NotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCostManagementExports,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the export.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the export.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "etag",
+				Description: "An unique read-only string that changes whenever the resource is updated.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ETag"),
+			},
+			{
+				Name:        "format",
+				Description: "The format of the export being delivered. Currently only 'Csv' is supported.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Format"),
+			},
+			{
+				Name:        "definition_type",
+				Description: "The type of the export. Possible values include: 'ActualCost', 'AmortizedCost', 'Usage'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Definition.Type"),
+			},
+			{
+				Name:        "definition_timeframe",
+				Description: "The time frame for pulling data for the export.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Definition.Timeframe"),
+			},
+			{
+				Name:        "schedule_status",
+				Description: "The status of the export's schedule. Possible values include: 'Active', 'Inactive'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Schedule.Status"),
+			},
+			{
+				Name:        "schedule_recurrence",
+				Description: "The schedule recurrence. Possible values include: 'Daily', 'Weekly', 'Monthly', 'Annually'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Schedule.Recurrence"),
+			},
+			{
+				Name:        "schedule_recurrence_period_from",
+				Description: "The start date of the recurrence.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.Schedule.RecurrencePeriod.From"),
+			},
+			{
+				Name:        "schedule_recurrence_period_to",
+				Description: "The end date of the recurrence.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.Schedule.RecurrencePeriod.To"),
+			},
+			{
+				Name:        "next_run_time_estimate",
+				Description: "The next run time of the export.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.NextRunTimeEstimate"),
+			},
+			{
+				Name:        "destination_resource_id",
+				Description: "The resource ID of the storage account where exports will be delivered.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DeliveryInfo.Destination.ResourceID"),
+			},
+			{
+				Name:        "destination_container",
+				Description: "The name of the container where exports will be uploaded.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DeliveryInfo.Destination.Container"),
+			},
+			{
+				Name:        "destination_root_folder_path",
+				Description: "The directory where exports will be uploaded.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DeliveryInfo.Destination.RootFolderPath"),
+			},
+			{
+				Name:        "partition_data",
+				Description: "If true, exported data will be partitioned by size and placed in a blob directory together with a manifest file.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.PartitionData"),
+			},
+			{
+				Name:        "last_run_status",
+				Description: "The status of the most recent execution of the export. Possible values include: 'Completed', 'Failed', 'InProgress', 'NewDataNotAvailable', 'DataNotAvailable', 'Queued', 'Timeout'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(extractCostManagementExportLastRun, "Status"),
+			},
+			{
+				Name:        "last_run_time",
+				Description: "The time when the most recent execution of the export was submitted.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromP(extractCostManagementExportLastRun, "SubmittedTime"),
+			},
+			{
+				Name:        "definition",
+				Description: "The definition of the export, including the dataset configuration and time period.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Definition"),
+			},
+			{
+				Name:        "run_history",
+				Description: "The last 10 executions of the export.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.RunHistory.Value"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCostManagementExports(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_costmanagement_export.listCostManagementExports", "session_error", err)
+		return nil, err
+	}
+
+	client, err := armcostmanagement.NewExportsClient(session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_costmanagement_export.listCostManagementExports", "client_error", err)
+		return nil, err
+	}
+
+	// Expanding the run history returns the last 10 executions of each export
+	expand := "runHistory"
+
+	// The API doesn't support pagination
+	result, err := client.List(ctx, "subscriptions/"+session.SubscriptionID, &armcostmanagement.ExportsClientListOptions{Expand: &expand})
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_costmanagement_export.listCostManagementExports", "api_error", err)
+		return nil, err
+	}
+
+	for _, export := range result.Value {
+		d.StreamListItem(ctx, *export)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCostManagementExport(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Return nil, if no input provided
+	if name == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_costmanagement_export.getCostManagementExport", "session_error", err)
+		return nil, err
+	}
+
+	client, err := armcostmanagement.NewExportsClient(session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_costmanagement_export.getCostManagementExport", "client_error", err)
+		return nil, err
+	}
+
+	expand := "runHistory"
+	op, err := client.Get(ctx, "subscriptions/"+session.SubscriptionID, name, &armcostmanagement.ExportsClientGetOptions{Expand: &expand})
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_costmanagement_export.getCostManagementExport", "api_error", err)
+		return nil, err
+	}
+
+	if op.ID != nil {
+		return op.Export, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// extractCostManagementExportLastRun returns the requested property of the most recently submitted run of the export
+func extractCostManagementExportLastRun(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	export := d.HydrateItem.(armcostmanagement.Export)
+	if export.Properties == nil || export.Properties.RunHistory == nil {
+		return nil, nil
+	}
+
+	var lastRun *armcostmanagement.ExportRunProperties
+	for _, run := range export.Properties.RunHistory.Value {
+		if run == nil || run.Properties == nil || run.Properties.SubmittedTime == nil {
+			continue
+		}
+		if lastRun == nil || run.Properties.SubmittedTime.After(*lastRun.SubmittedTime) {
+			lastRun = run.Properties
+		}
+	}
+	if lastRun == nil {
+		return nil, nil
+	}
+
+	switch d.Param.(string) {
+	case "Status":
+		return lastRun.Status, nil
+	case "SubmittedTime":
+		return lastRun.SubmittedTime, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,229 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/costmanagement/armcostmanagement/v2"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureCostManagementScheduledAction(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_costmanagement_scheduled_action",
+		Description: "Azure Cost Management Scheduled Action",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getCostManagementScheduledAction,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"NotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCostManagementScheduledActions,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the scheduled action.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the scheduled action.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the scheduled action.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DisplayName"),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kind",
+				Description: "The kind of the scheduled action. 'Email' sends cost analysis data by email and 'InsightAlert' sends cost anomaly alerts.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "etag",
+				Description: "An unique read-only string that changes whenever the resource is updated.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ETag"),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the scheduled action. Possible values include: 'Enabled', 'Disabled', 'Expired'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Status"),
+			},
+			{
+				Name:        "scope",
+				Description: "The scope of the scheduled action.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Scope"),
+			},
+			{
+				Name:        "view_id",
+				Description: "The cost analysis view used for the scheduled action.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ViewID"),
+			},
+			{
+				Name:        "schedule_frequency",
+				Description: "The frequency at which the scheduled action runs. Possible values include: 'Daily', 'Weekly', 'Monthly'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Schedule.Frequency"),
+			},
+			{
+				Name:        "schedule_start_date",
+				Description: "The date on which the schedule starts.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.Schedule.StartDate"),
+			},
+			{
+				Name:        "schedule_end_date",
+				Description: "The date on which the schedule ends.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.Schedule.EndDate"),
+			},
+			{
+				Name:        "notification_email",
+				Description: "The email address of the point of contact that should get the unsubscribe requests and notification emails.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.NotificationEmail"),
+			},
+			{
+				Name:        "notification_recipients",
+				Description: "The email addresses the notification is sent to.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Notification.To"),
+			},
+			{
+				Name:        "notification",
+				Description: "The notification settings of the scheduled action.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Notification"),
+			},
+			{
+				Name:        "schedule",
+				Description: "The schedule of the scheduled action.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Schedule"),
+			},
+			{
+				Name:        "file_destination",
+				Description: "The file formats in which the scheduled action data is attached.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.FileDestination"),
+			},
+			{
+				Name:        "system_data",
+				Description: "Metadata pertaining to creation and last modification of the resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(costManagementScheduledActionTitle),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCostManagementScheduledActions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_costmanagement_scheduled_action.listCostManagementScheduledActions", "session_error", err)
+		return nil, err
+	}
+
+	client, err := armcostmanagement.NewScheduledActionsClient(session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_costmanagement_scheduled_action.listCostManagementScheduledActions", "client_error", err)
+		return nil, err
+	}
+
+	pager := client.NewListByScopePager("subscriptions/"+session.SubscriptionID, nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_costmanagement_scheduled_action.listCostManagementScheduledActions", "api_error", err)
+			return nil, err
+		}
+		for _, action := range page.Value {
+			d.StreamListItem(ctx, *action)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCostManagementScheduledAction(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Return nil, if no input provided
+	if name == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_costmanagement_scheduled_action.getCostManagementScheduledAction", "session_error", err)
+		return nil, err
+	}
+
+	client, err := armcostmanagement.NewScheduledActionsClient(session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_costmanagement_scheduled_action.getCostManagementScheduledAction", "client_error", err)
+		return nil, err
+	}
+
+	op, err := client.GetByScope(ctx, "subscriptions/"+session.SubscriptionID, name, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_costmanagement_scheduled_action.getCostManagementScheduledAction", "api_error", err)
+		return nil, err
+	}
+
+	if op.ID != nil {
+		return op.ScheduledAction, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func costManagementScheduledActionTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	action := d.HydrateItem.(armcostmanagement.ScheduledAction)
+	if action.Properties != nil && action.Properties.DisplayName != nil && *action.Properties.DisplayName != "" {
+		return *action.Properties.DisplayName, nil
+	}
+	return action.Name, nil
+}
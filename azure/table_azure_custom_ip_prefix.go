@@ -0,0 +1,268 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/network/mgmt/network"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureCustomIPPrefix(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_custom_ip_prefix",
+		Description: "Azure Custom IP Prefix",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getCustomIPPrefix,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCustomIPPrefixes,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the custom IP prefix.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the custom IP prefix.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the custom IP prefix.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "etag",
+				Description: "A unique read-only string that changes whenever the resource is updated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the custom IP prefix.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CustomIPPrefixPropertiesFormat.ProvisioningState"),
+			},
+			{
+				Name:        "cidr",
+				Description: "The prefix range in CIDR notation. Should include the start address and the prefix length.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CustomIPPrefixPropertiesFormat.Cidr"),
+			},
+			{
+				Name:        "prefix_type",
+				Description: "The type of the custom IP prefix. Possible values include: 'Singular', 'Parent', 'Child'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CustomIPPrefixPropertiesFormat.PrefixType"),
+			},
+			{
+				Name:        "commissioned_state",
+				Description: "The commissioned state of the custom IP prefix, which tracks its validation and advertisement. Possible values include: 'Provisioning', 'Provisioned', 'Commissioning', 'CommissionedNoInternetAdvertise', 'Commissioned', 'Decommissioning', 'Deprovisioning', 'Deprovisioned'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CustomIPPrefixPropertiesFormat.CommissionedState"),
+			},
+			{
+				Name:        "failed_reason",
+				Description: "The reason why the provisioning or commissioning of the custom IP prefix failed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CustomIPPrefixPropertiesFormat.FailedReason"),
+			},
+			{
+				Name:        "asn",
+				Description: "The ASN for CIDR advertising. Should be an integer as string.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CustomIPPrefixPropertiesFormat.Asn"),
+			},
+			{
+				Name:        "geo",
+				Description: "The geography the prefix is advertised in. Possible values include: 'GLOBAL', 'AFRI', 'APAC', 'EURO', 'LATAM', 'NAM', 'ME', 'OCEANIA', 'AQ'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CustomIPPrefixPropertiesFormat.Geo"),
+			},
+			{
+				Name:        "no_internet_advertise",
+				Description: "Indicates whether the prefix is prevented from being advertised to the internet once commissioned.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("CustomIPPrefixPropertiesFormat.NoInternetAdvertise"),
+			},
+			{
+				Name:        "express_route_advertise",
+				Description: "Indicates whether the prefix is advertised to ExpressRoute instead of the internet.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("CustomIPPrefixPropertiesFormat.ExpressRouteAdvertise"),
+			},
+			{
+				Name:        "resource_guid",
+				Description: "The resource GUID property of the custom IP prefix.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CustomIPPrefixPropertiesFormat.ResourceGUID"),
+			},
+			{
+				Name:        "authorization_message",
+				Description: "The authorization message for WAN validation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CustomIPPrefixPropertiesFormat.AuthorizationMessage"),
+			},
+			{
+				Name:        "signed_message",
+				Description: "The signed message for WAN validation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CustomIPPrefixPropertiesFormat.SignedMessage"),
+			},
+			{
+				Name:        "custom_ip_prefix_parent",
+				Description: "The parent custom IP prefix of a child prefix.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CustomIPPrefixPropertiesFormat.CustomIPPrefixParent"),
+			},
+			{
+				Name:        "child_custom_ip_prefixes",
+				Description: "The list of child custom IP prefixes of a parent prefix.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CustomIPPrefixPropertiesFormat.ChildCustomIPPrefixes"),
+			},
+			{
+				Name:        "public_ip_prefixes",
+				Description: "The list of public IP prefixes allocated from the custom IP prefix.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CustomIPPrefixPropertiesFormat.PublicIPPrefixes"),
+			},
+			{
+				Name:        "zones",
+				Description: "The availability zones of the custom IP prefix.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "extended_location",
+				Description: "The extended location of the custom IP prefix.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCustomIPPrefixes(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_custom_ip_prefix.listCustomIPPrefixes", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := network.NewCustomIPPrefixesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListAll(ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_custom_ip_prefix.listCustomIPPrefixes", "api_error", err)
+		return nil, err
+	}
+
+	for _, prefix := range result.Values() {
+		d.StreamListItem(ctx, prefix)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_custom_ip_prefix.listCustomIPPrefixes", "api_paging_error", err)
+			return nil, err
+		}
+		for _, prefix := range result.Values() {
+			d.StreamListItem(ctx, prefix)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCustomIPPrefix(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_custom_ip_prefix.getCustomIPPrefix", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := network.NewCustomIPPrefixesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_custom_ip_prefix.getCustomIPPrefix", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,225 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/datafactory/mgmt/datafactory"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// The SDK package has no client for the credentials of a factory
+const dataFactoryCredentialAPIVersion = "2018-06-01"
+
+//// TABLE DEFINITION
+
+func tableAzureDataFactoryCredential(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_data_factory_credential",
+		Description: "Azure Data Factory Credential, the managed identities and service principals the linked services of the factories authenticate with.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "factory_name", "resource_group"}),
+			Hydrate:    getDataFactoryCredential,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listDataFactories,
+			Hydrate:       listDataFactoryCredentials,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "factory_name",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the credential.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource identifier.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "factory_name",
+				Description: "The name of the factory the credential belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "etag",
+				Description: "An unique read-only string that changes whenever the resource is updated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The resource type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "credential_type",
+				Description: "The type of the credential, which is the authentication type of the linked services that use it. Possible values include: 'ManagedIdentity', 'ServicePrincipal'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Type"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the credential.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Description"),
+			},
+			{
+				Name:        "managed_identity_resource_id",
+				Description: "The resource ID of the user assigned managed identity of a 'ManagedIdentity' credential.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.TypeProperties.ResourceID"),
+			},
+			{
+				Name:        "service_principal_id",
+				Description: "The application ID of the service principal of a 'ServicePrincipal' credential, or the expression it is read from.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.TypeProperties.ServicePrincipalID"),
+			},
+			{
+				Name:        "service_principal_key",
+				Description: "The reference to the Key Vault secret holding the key of the service principal of a 'ServicePrincipal' credential.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.TypeProperties.ServicePrincipalKey"),
+			},
+			{
+				Name:        "tenant",
+				Description: "The ID of the tenant of the service principal of a 'ServicePrincipal' credential, or the expression it is read from.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.TypeProperties.Tenant"),
+			},
+			{
+				Name:        "annotations",
+				Description: "A list of tags that can be used for describing the credential.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Annotations"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type DataFactoryCredential struct {
+	ID          *string                          `json:"id,omitempty"`
+	Name        *string                          `json:"name,omitempty"`
+	Type        *string                          `json:"type,omitempty"`
+	Etag        *string                          `json:"etag,omitempty"`
+	Properties  *DataFactoryCredentialProperties `json:"properties,omitempty"`
+	FactoryName string                           `json:"-"`
+}
+
+type DataFactoryCredentialProperties struct {
+	Type           *string                              `json:"type,omitempty"`
+	Description    *string                              `json:"description,omitempty"`
+	Annotations    []interface{}                        `json:"annotations,omitempty"`
+	TypeProperties *DataFactoryCredentialTypeProperties `json:"typeProperties,omitempty"`
+}
+
+// The type properties of both the 'ManagedIdentity' and the 'ServicePrincipal' credentials
+type DataFactoryCredentialTypeProperties struct {
+	ResourceID          *string                                   `json:"resourceId,omitempty"`
+	ServicePrincipalID  interface{}                               `json:"servicePrincipalId,omitempty"`
+	ServicePrincipalKey *datafactory.AzureKeyVaultSecretReference `json:"servicePrincipalKey,omitempty"`
+	Tenant              interface{}                               `json:"tenant,omitempty"`
+}
+
+//// LIST FUNCTION
+
+func listDataFactoryCredentials(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get factory details
+	factoryInfo := h.Item.(datafactory.Factory)
+
+	factoryName := d.EqualsQualString("factory_name")
+	if factoryName != "" && factoryName != *factoryInfo.Name {
+		return nil, nil
+	}
+
+	err := listResourceManagerResources(ctx, d, *factoryInfo.ID+"/credentials", dataFactoryCredentialAPIVersion, func(items []json.RawMessage) (bool, error) {
+		for _, item := range items {
+			var credential DataFactoryCredential
+			if err := json.Unmarshal(item, &credential); err != nil {
+				return false, err
+			}
+			credential.FactoryName = *factoryInfo.Name
+			d.StreamListItem(ctx, credential)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_data_factory_credential.listDataFactoryCredentials", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDataFactoryCredential(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	factoryName := d.EqualsQualString("factory_name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if name == "" || factoryName == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_data_factory_credential.getDataFactoryCredential", "session_error", err)
+		return nil, err
+	}
+
+	var credential DataFactoryCredential
+	path := "/subscriptions/" + session.SubscriptionID + "/resourceGroups/" + resourceGroup + "/providers/Microsoft.DataFactory/factories/" + factoryName + "/credentials/" + name
+	found, err := getResourceManagerResource(ctx, d, path, dataFactoryCredentialAPIVersion, &credential)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_data_factory_credential.getDataFactoryCredential", "api_error", err)
+		return nil, err
+	}
+
+	if found && credential.ID != nil {
+		credential.FactoryName = factoryName
+		return credential, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,268 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/datafactory/mgmt/datafactory"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureDataFactoryManagedPrivateEndpoint(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_data_factory_managed_private_endpoint",
+		Description: "Azure Data Factory Managed Private Endpoint, the private endpoints of the managed virtual networks of the factories, through which the integration runtimes reach the data stores.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "managed_virtual_network_name", "factory_name", "resource_group"}),
+			Hydrate:    getDataFactoryManagedPrivateEndpoint,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listDataFactories,
+			Hydrate:       listDataFactoryManagedPrivateEndpoints,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "factory_name",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the managed private endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource identifier.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "factory_name",
+				Description: "The name of the factory the managed private endpoint belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "managed_virtual_network_name",
+				Description: "The name of the managed virtual network the managed private endpoint belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "etag",
+				Description: "An unique read-only string that changes whenever the resource is updated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The resource type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the managed private endpoint.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ProvisioningState"),
+			},
+			{
+				Name:        "private_link_resource_id",
+				Description: "The ID of the resource the managed private endpoint connects to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.PrivateLinkResourceID"),
+			},
+			{
+				Name:        "group_id",
+				Description: "The sub-resource of the private link resource the managed private endpoint connects to, for example 'blob' or 'sqlServer'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.GroupID"),
+			},
+			{
+				Name:        "is_reserved",
+				Description: "Indicates whether the managed private endpoint is reserved by the factory.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.IsReserved"),
+			},
+			{
+				Name:        "connection_state_status",
+				Description: "The status of the connection of the managed private endpoint, for example 'Pending', 'Approved' or 'Rejected'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ConnectionState.Status"),
+			},
+			{
+				Name:        "connection_state_description",
+				Description: "The description of the status of the connection, set when it was approved or rejected.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ConnectionState.Description"),
+			},
+			{
+				Name:        "connection_state_actions_required",
+				Description: "The actions required on the connection of the managed private endpoint.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ConnectionState.ActionsRequired"),
+			},
+			{
+				Name:        "fqdns",
+				Description: "The fully qualified domain names of the managed private endpoint.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Fqdns"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type managedPrivateEndpointInfo = struct {
+	datafactory.ManagedPrivateEndpointResource
+	FactoryName               string
+	ManagedVirtualNetworkName string
+}
+
+//// LIST FUNCTION
+
+func listDataFactoryManagedPrivateEndpoints(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get factory details
+	factoryInfo := h.Item.(datafactory.Factory)
+	resourceGroup := strings.Split(*factoryInfo.ID, "/")[4]
+
+	factoryName := d.EqualsQualString("factory_name")
+	if factoryName != "" && factoryName != *factoryInfo.Name {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_data_factory_managed_private_endpoint.listDataFactoryManagedPrivateEndpoints", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	networkClient := datafactory.NewManagedVirtualNetworksClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	networkClient.Authorizer = session.Authorizer
+
+	endpointClient := datafactory.NewManagedPrivateEndpointsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	endpointClient.Authorizer = session.Authorizer
+
+	// The private endpoints are listed per managed virtual network of the factory
+	var networkNames []string
+	networks, err := networkClient.ListByFactory(ctx, resourceGroup, *factoryInfo.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_data_factory_managed_private_endpoint.listDataFactoryManagedPrivateEndpoints", "api_error", err)
+		return nil, err
+	}
+	for {
+		for _, network := range networks.Values() {
+			if network.Name != nil {
+				networkNames = append(networkNames, *network.Name)
+			}
+		}
+		if !networks.NotDone() {
+			break
+		}
+		err = networks.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_data_factory_managed_private_endpoint.listDataFactoryManagedPrivateEndpoints", "api_paging_error", err)
+			return nil, err
+		}
+	}
+
+	for _, networkName := range networkNames {
+		result, err := endpointClient.ListByFactory(ctx, resourceGroup, *factoryInfo.Name, networkName)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_data_factory_managed_private_endpoint.listDataFactoryManagedPrivateEndpoints", "api_error", err)
+			return nil, err
+		}
+
+		for _, endpoint := range result.Values() {
+			d.StreamListItem(ctx, managedPrivateEndpointInfo{endpoint, *factoryInfo.Name, networkName})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		for result.NotDone() {
+			err = result.NextWithContext(ctx)
+			if err != nil {
+				plugin.Logger(ctx).Error("azure_data_factory_managed_private_endpoint.listDataFactoryManagedPrivateEndpoints", "api_paging_error", err)
+				return nil, err
+			}
+			for _, endpoint := range result.Values() {
+				d.StreamListItem(ctx, managedPrivateEndpointInfo{endpoint, *factoryInfo.Name, networkName})
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDataFactoryManagedPrivateEndpoint(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	networkName := d.EqualsQualString("managed_virtual_network_name")
+	factoryName := d.EqualsQualString("factory_name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if name == "" || networkName == "" || factoryName == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_data_factory_managed_private_endpoint.getDataFactoryManagedPrivateEndpoint", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := datafactory.NewManagedPrivateEndpointsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, factoryName, networkName, name, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_data_factory_managed_private_endpoint.getDataFactoryManagedPrivateEndpoint", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return managedPrivateEndpointInfo{op, factoryName, networkName}, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,335 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/dataprotection/armdataprotection"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureDataProtectionBackupInstance(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_data_protection_backup_instance",
+		Description: "Azure Data Protection Backup Instance, the disks, blobs, AKS clusters, PostgreSQL servers and other data sources backed up to a backup vault.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"vault_name", "name", "resource_group"}),
+			Hydrate:    getAzureDataProtectionBackupInstance,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceGroupNotFound", "ResourceNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listAzureDataProtectionBackupVaults,
+			Hydrate:       listAzureDataProtectionBackupInstances,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "404"}),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "vault_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "resource_group",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the backup instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the backup instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the backup instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vault_name",
+				Description: "The name of the backup vault the data source is backed up to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "friendly_name",
+				Description: "The friendly name of the backup instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.FriendlyName"),
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the backup instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ProvisioningState"),
+			},
+			{
+				Name:        "protection_status",
+				Description: "The protection status of the backup instance. Possible values include: 'ConfiguringProtection', 'ConfiguringProtectionFailed', 'ProtectionConfigured', 'ProtectionStopped', 'SoftDeleted', 'SoftDeleting'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ProtectionStatus.Status"),
+			},
+			{
+				Name:        "current_protection_state",
+				Description: "The current protection state of the backup instance, for example 'ProtectionConfigured', 'BackupSchedulesSuspended' or 'ProtectionError'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.CurrentProtectionState"),
+			},
+			{
+				Name:        "datasource_type",
+				Description: "The type of the backed up data source, for example 'Microsoft.Compute/disks', 'Microsoft.Storage/storageAccounts/blobServices' or 'Microsoft.ContainerService/managedClusters'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DataSourceInfo.DatasourceType"),
+			},
+			{
+				Name:        "datasource_resource_id",
+				Description: "The resource ID of the backed up data source.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DataSourceInfo.ResourceID"),
+			},
+			{
+				Name:        "datasource_resource_name",
+				Description: "The name of the backed up data source.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DataSourceInfo.ResourceName"),
+			},
+			{
+				Name:        "datasource_resource_location",
+				Description: "The location of the backed up data source.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DataSourceInfo.ResourceLocation"),
+			},
+			{
+				Name:        "policy_id",
+				Description: "The ID of the backup policy the data source is backed up with.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.PolicyInfo.PolicyID"),
+			},
+			{
+				Name:        "policy_name",
+				Description: "The name of the backup policy the data source is backed up with.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.PolicyInfo.PolicyID").Transform(lastPathElement),
+			},
+			{
+				Name:        "policy_version",
+				Description: "The version of the backup policy the data source is backed up with.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.PolicyInfo.PolicyVersion"),
+			},
+			{
+				Name:        "object_type",
+				Description: "The object type of the backup instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ObjectType"),
+			},
+			{
+				Name:        "validation_type",
+				Description: "The type of validation performed when the backup instance was configured. Possible values include: 'ShallowValidation', 'DeepValidation'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ValidationType"),
+			},
+			{
+				Name:        "protection_error_details",
+				Description: "The details of the protection error of the backup instance, if any.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.ProtectionErrorDetails"),
+			},
+			{
+				Name:        "protection_status_error_details",
+				Description: "The details of the error of the protection status, if any.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.ProtectionStatus.ErrorDetails"),
+			},
+			{
+				Name:        "data_source_info",
+				Description: "The information of the backed up data source.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.DataSourceInfo"),
+			},
+			{
+				Name:        "data_source_set_info",
+				Description: "The information of the set the data source belongs to, for example the storage account of a blob container.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.DataSourceSetInfo"),
+			},
+			{
+				Name:        "policy_parameters",
+				Description: "The parameters of the backup policy specific to the data source, like the snapshot resource group.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.PolicyInfo.PolicyParameters"),
+			},
+			{
+				Name:        "system_data",
+				Description: "The metadata pertaining to creation and last modification of the backup instance.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.FriendlyName", "Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+// Backup instances don't have a location of their own, the location of the vault is used instead
+type DataProtectionBackupInstanceInfo struct {
+	VaultName  *string
+	Location   *string
+	Properties *armdataprotection.BackupInstance
+	SystemData *armdataprotection.SystemData
+	ID         *string
+	Name       *string
+	Type       *string
+}
+
+//// LIST FUNCTION
+
+func listAzureDataProtectionBackupInstances(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	vault := h.Item.(*armdataprotection.BackupVaultResource)
+	resourceGroup := strings.Split(*vault.ID, "/")[4]
+
+	vaultName := d.EqualsQualString("vault_name")
+	rgName := d.EqualsQualString("resource_group")
+
+	if vaultName != "" && vaultName != *vault.Name {
+		return nil, nil
+	}
+	if rgName != "" && !strings.EqualFold(rgName, resourceGroup) {
+		return nil, nil
+	}
+
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_data_protection_backup_instance.listAzureDataProtectionBackupInstances", "session_error", err)
+		return nil, err
+	}
+
+	client, err := armdataprotection.NewBackupInstancesClient(session.SubscriptionID, session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_data_protection_backup_instance.listAzureDataProtectionBackupInstances", "client_error", err)
+		return nil, err
+	}
+
+	pager := client.NewListPager(resourceGroup, *vault.Name, nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_data_protection_backup_instance.listAzureDataProtectionBackupInstances", "api_error", err)
+			return nil, err
+		}
+
+		for _, instance := range page.Value {
+			d.StreamListItem(ctx, DataProtectionBackupInstanceInfo{
+				VaultName:  vault.Name,
+				Location:   vault.Location,
+				Properties: instance.Properties,
+				SystemData: instance.SystemData,
+				ID:         instance.ID,
+				Name:       instance.Name,
+				Type:       instance.Type,
+			})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAzureDataProtectionBackupInstance(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	vaultName := d.EqualsQualString("vault_name")
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if vaultName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_data_protection_backup_instance.getAzureDataProtectionBackupInstance", "session_error", err)
+		return nil, err
+	}
+
+	vaultClient, err := armdataprotection.NewBackupVaultsClient(session.SubscriptionID, session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_data_protection_backup_instance.getAzureDataProtectionBackupInstance", "client_error", err)
+		return nil, err
+	}
+	client, err := armdataprotection.NewBackupInstancesClient(session.SubscriptionID, session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_data_protection_backup_instance.getAzureDataProtectionBackupInstance", "client_error", err)
+		return nil, err
+	}
+
+	// The vault is needed for the location of the backup instance
+	vault, err := vaultClient.Get(ctx, resourceGroup, vaultName, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_data_protection_backup_instance.getAzureDataProtectionBackupInstance", "api_error", err)
+		return nil, err
+	}
+
+	instance, err := client.Get(ctx, resourceGroup, vaultName, name, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_data_protection_backup_instance.getAzureDataProtectionBackupInstance", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if instance.ID != nil {
+		return DataProtectionBackupInstanceInfo{
+			VaultName:  vault.Name,
+			Location:   vault.Location,
+			Properties: instance.Properties,
+			SystemData: instance.SystemData,
+			ID:         instance.ID,
+			Name:       instance.Name,
+			Type:       instance.Type,
+		}, nil
+	}
+
+	return nil, nil
+}
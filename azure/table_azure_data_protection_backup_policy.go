@@ -0,0 +1,322 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/dataprotection/armdataprotection"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureDataProtectionBackupPolicy(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_data_protection_backup_policy",
+		Description: "Azure Data Protection Backup Policy, the backup schedules and retention rules of the data sources of a backup vault.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"vault_name", "name", "resource_group"}),
+			Hydrate:    getAzureDataProtectionBackupPolicy,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceGroupNotFound", "ResourceNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listAzureDataProtectionBackupVaults,
+			Hydrate:       listAzureDataProtectionBackupPolicies,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "404"}),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "vault_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "resource_group",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the backup policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the backup policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the backup policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vault_name",
+				Description: "The name of the backup vault of the backup policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "object_type",
+				Description: "The object type of the backup policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Policy.ObjectType"),
+			},
+			{
+				Name:        "datasource_types",
+				Description: "The types of the data sources the backup policy applies to.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Policy.DatasourceTypes"),
+			},
+			{
+				Name:        "default_retention_duration",
+				Description: "The ISO 8601 duration backups are retained for by the default retention rule of the policy, for example 'P30D'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Policy").Transform(extractDataProtectionDefaultRetentionDuration),
+			},
+			{
+				Name:        "backup_repeating_time_intervals",
+				Description: "The ISO 8601 repeating time intervals of the backup schedule of the policy, for example 'R/2023-01-01T02:00:00+00:00/P1D'.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Policy").TransformP(extractDataProtectionBackupSchedule, "RepeatingTimeIntervals"),
+			},
+			{
+				Name:        "backup_time_zone",
+				Description: "The time zone of the backup schedule of the policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Policy").TransformP(extractDataProtectionBackupSchedule, "TimeZone"),
+			},
+			{
+				Name:        "policy_rules",
+				Description: "The backup and retention rules of the backup policy.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Policy.PolicyRules"),
+			},
+			{
+				Name:        "system_data",
+				Description: "The metadata pertaining to creation and last modification of the backup policy.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+// Backup policies don't have a location of their own, the location of the vault is used instead
+type DataProtectionBackupPolicyInfo struct {
+	VaultName  *string
+	Location   *string
+	Policy     *armdataprotection.BackupPolicy
+	SystemData *armdataprotection.SystemData
+	ID         *string
+	Name       *string
+	Type       *string
+}
+
+//// LIST FUNCTION
+
+func listAzureDataProtectionBackupPolicies(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	vault := h.Item.(*armdataprotection.BackupVaultResource)
+	resourceGroup := strings.Split(*vault.ID, "/")[4]
+
+	vaultName := d.EqualsQualString("vault_name")
+	rgName := d.EqualsQualString("resource_group")
+
+	if vaultName != "" && vaultName != *vault.Name {
+		return nil, nil
+	}
+	if rgName != "" && !strings.EqualFold(rgName, resourceGroup) {
+		return nil, nil
+	}
+
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_data_protection_backup_policy.listAzureDataProtectionBackupPolicies", "session_error", err)
+		return nil, err
+	}
+
+	client, err := armdataprotection.NewBackupPoliciesClient(session.SubscriptionID, session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_data_protection_backup_policy.listAzureDataProtectionBackupPolicies", "client_error", err)
+		return nil, err
+	}
+
+	pager := client.NewListPager(resourceGroup, *vault.Name, nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_data_protection_backup_policy.listAzureDataProtectionBackupPolicies", "api_error", err)
+			return nil, err
+		}
+
+		for _, policy := range page.Value {
+			d.StreamListItem(ctx, DataProtectionBackupPolicyInfo{
+				VaultName:  vault.Name,
+				Location:   vault.Location,
+				Policy:     asDataProtectionBackupPolicy(policy.Properties),
+				SystemData: policy.SystemData,
+				ID:         policy.ID,
+				Name:       policy.Name,
+				Type:       policy.Type,
+			})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAzureDataProtectionBackupPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	vaultName := d.EqualsQualString("vault_name")
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if vaultName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_data_protection_backup_policy.getAzureDataProtectionBackupPolicy", "session_error", err)
+		return nil, err
+	}
+
+	vaultClient, err := armdataprotection.NewBackupVaultsClient(session.SubscriptionID, session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_data_protection_backup_policy.getAzureDataProtectionBackupPolicy", "client_error", err)
+		return nil, err
+	}
+	client, err := armdataprotection.NewBackupPoliciesClient(session.SubscriptionID, session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_data_protection_backup_policy.getAzureDataProtectionBackupPolicy", "client_error", err)
+		return nil, err
+	}
+
+	// The vault is needed for the location of the backup policy
+	vault, err := vaultClient.Get(ctx, resourceGroup, vaultName, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_data_protection_backup_policy.getAzureDataProtectionBackupPolicy", "api_error", err)
+		return nil, err
+	}
+
+	policy, err := client.Get(ctx, resourceGroup, vaultName, name, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_data_protection_backup_policy.getAzureDataProtectionBackupPolicy", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if policy.ID != nil {
+		return DataProtectionBackupPolicyInfo{
+			VaultName:  vault.Name,
+			Location:   vault.Location,
+			Policy:     asDataProtectionBackupPolicy(policy.Properties),
+			SystemData: policy.SystemData,
+			ID:         policy.ID,
+			Name:       policy.Name,
+			Type:       policy.Type,
+		}, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// The default retention rule applies to the backups that aren't tagged by a backup rule
+func extractDataProtectionDefaultRetentionDuration(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	policy, ok := d.Value.(*armdataprotection.BackupPolicy)
+	if !ok || policy == nil {
+		return nil, nil
+	}
+
+	for _, rule := range policy.PolicyRules {
+		retention, ok := rule.(*armdataprotection.AzureRetentionRule)
+		if !ok || retention.IsDefault == nil || !*retention.IsDefault {
+			continue
+		}
+		for _, lifecycle := range retention.Lifecycles {
+			if lifecycle != nil && lifecycle.DeleteAfter != nil {
+				return lifecycle.DeleteAfter.GetDeleteOption().Duration, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+func extractDataProtectionBackupSchedule(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	policy, ok := d.Value.(*armdataprotection.BackupPolicy)
+	if !ok || policy == nil {
+		return nil, nil
+	}
+
+	for _, rule := range policy.PolicyRules {
+		backupRule, ok := rule.(*armdataprotection.AzureBackupRule)
+		if !ok {
+			continue
+		}
+		trigger, ok := backupRule.Trigger.(*armdataprotection.ScheduleBasedTriggerContext)
+		if !ok || trigger.Schedule == nil {
+			continue
+		}
+		switch d.Param.(string) {
+		case "RepeatingTimeIntervals":
+			return trigger.Schedule.RepeatingTimeIntervals, nil
+		case "TimeZone":
+			return trigger.Schedule.TimeZone, nil
+		}
+	}
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// BackupPolicy is the only kind of backup policy
+func asDataProtectionBackupPolicy(properties armdataprotection.BaseBackupPolicyClassification) *armdataprotection.BackupPolicy {
+	policy, ok := properties.(*armdataprotection.BackupPolicy)
+	if !ok {
+		return nil
+	}
+	return policy
+}
@@ -0,0 +1,255 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/compute/mgmt/compute"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureDedicatedHost(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_dedicated_host",
+		Description: "Azure Dedicated Host",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"host_group_name", "name", "resource_group"}),
+			Hydrate:    getDedicatedHost,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listDedicatedHostGroups,
+			Hydrate:       listDedicatedHosts,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the dedicated host.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the dedicated host.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "host_group_name",
+				Description: "The name of the dedicated host group the host belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractDedicatedHostGroupNameFromID),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the dedicated host.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "host_id",
+				Description: "A unique id generated and assigned to the dedicated host by the platform.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DedicatedHostProperties.HostID"),
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the dedicated host.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DedicatedHostProperties.ProvisioningState"),
+			},
+			{
+				Name:        "sku_name",
+				Description: "The SKU name of the dedicated host.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Sku.Name"),
+			},
+			{
+				Name:        "sku_tier",
+				Description: "The SKU tier of the dedicated host.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Sku.Tier"),
+			},
+			{
+				Name:        "platform_fault_domain",
+				Description: "The fault domain of the dedicated host within the dedicated host group.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("DedicatedHostProperties.PlatformFaultDomain"),
+			},
+			{
+				Name:        "auto_replace_on_failure",
+				Description: "Specifies whether the dedicated host should be replaced automatically in case of a failure.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("DedicatedHostProperties.AutoReplaceOnFailure"),
+			},
+			{
+				Name:        "license_type",
+				Description: "The software license type that will be applied to the virtual machines deployed on the dedicated host. Possible values include: 'None', 'Windows_Server_Hybrid', 'Windows_Server_Perpetual'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DedicatedHostProperties.LicenseType").Transform(transform.ToString),
+			},
+			{
+				Name:        "provisioning_time",
+				Description: "The date when the dedicated host was provisioned.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("DedicatedHostProperties.ProvisioningTime").Transform(convertDateToTime),
+			},
+			{
+				Name:        "time_created",
+				Description: "The time at which the dedicated host resource was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("DedicatedHostProperties.TimeCreated").Transform(convertDateToTime),
+			},
+			{
+				Name:        "virtual_machines",
+				Description: "A list of references to all virtual machines in the dedicated host.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DedicatedHostProperties.VirtualMachines"),
+			},
+			{
+				Name:        "available_capacity",
+				Description: "The unutilized capacity of the dedicated host, as the number of virtual machines of each size that can still be allocated.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDedicatedHost,
+				Transform:   transform.FromField("DedicatedHostProperties.InstanceView.AvailableCapacity.AllocatableVMs"),
+			},
+			{
+				Name:        "statuses",
+				Description: "The resource status information of the dedicated host.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDedicatedHost,
+				Transform:   transform.FromField("DedicatedHostProperties.InstanceView.Statuses"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDedicatedHosts(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	group := h.Item.(compute.DedicatedHostGroup)
+	resourceGroup := strings.Split(*group.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_dedicated_host.listDedicatedHosts", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := compute.NewDedicatedHostsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListByHostGroup(ctx, resourceGroup, *group.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_dedicated_host.listDedicatedHosts", "api_error", err)
+		return nil, err
+	}
+
+	for _, host := range result.Values() {
+		d.StreamListItem(ctx, host)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_dedicated_host.listDedicatedHosts", "api_paging_error", err)
+			return nil, err
+		}
+		for _, host := range result.Values() {
+			d.StreamListItem(ctx, host)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDedicatedHost(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var groupName, name, resourceGroup string
+	if h.Item != nil {
+		segments := strings.Split(*h.Item.(compute.DedicatedHost).ID, "/")
+		resourceGroup, groupName, name = segments[4], segments[8], segments[10]
+	} else {
+		groupName = d.EqualsQualString("host_group_name")
+		name = d.EqualsQualString("name")
+		resourceGroup = d.EqualsQualString("resource_group")
+	}
+
+	// Return nil, if no input provided
+	if groupName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_dedicated_host.getDedicatedHost", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := compute.NewDedicatedHostsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, groupName, name, compute.InstanceViewTypesInstanceView)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_dedicated_host.getDedicatedHost", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
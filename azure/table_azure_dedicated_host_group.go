@@ -0,0 +1,220 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/compute/mgmt/compute"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureDedicatedHostGroup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_dedicated_host_group",
+		Description: "Azure Dedicated Host Group",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getDedicatedHostGroup,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listDedicatedHostGroups,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the dedicated host group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the dedicated host group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the dedicated host group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "platform_fault_domain_count",
+				Description: "The number of fault domains that the host group can span.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("DedicatedHostGroupProperties.PlatformFaultDomainCount"),
+			},
+			{
+				Name:        "support_automatic_placement",
+				Description: "Specifies whether virtual machines or virtual machine scale sets can be placed automatically on the dedicated host group.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("DedicatedHostGroupProperties.SupportAutomaticPlacement"),
+			},
+			{
+				Name:        "ultra_ssd_enabled",
+				Description: "Specifies whether the dedicated hosts in the group support attaching managed data disks with the UltraSSD_LRS storage account type.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("DedicatedHostGroupProperties.AdditionalCapabilities.UltraSSDEnabled"),
+			},
+			{
+				Name:        "hosts",
+				Description: "A list of references to all dedicated hosts in the dedicated host group.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DedicatedHostGroupProperties.Hosts"),
+			},
+			{
+				Name:        "instance_view",
+				Description: "The instance view of the dedicated hosts in the group.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDedicatedHostGroup,
+				Transform:   transform.FromField("DedicatedHostGroupProperties.InstanceView.Hosts"),
+			},
+			{
+				Name:        "zones",
+				Description: "The availability zone of the dedicated host group.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDedicatedHostGroups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_dedicated_host_group.listDedicatedHostGroups", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := compute.NewDedicatedHostGroupsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListBySubscription(ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_dedicated_host_group.listDedicatedHostGroups", "api_error", err)
+		return nil, err
+	}
+
+	for _, group := range result.Values() {
+		d.StreamListItem(ctx, group)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_dedicated_host_group.listDedicatedHostGroups", "api_paging_error", err)
+			return nil, err
+		}
+		for _, group := range result.Values() {
+			d.StreamListItem(ctx, group)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDedicatedHostGroup(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var name, resourceGroup string
+	if h.Item != nil {
+		group := h.Item.(compute.DedicatedHostGroup)
+		name = *group.Name
+		resourceGroup = strings.Split(*group.ID, "/")[4]
+	} else {
+		name = d.EqualsQualString("name")
+		resourceGroup = d.EqualsQualString("resource_group")
+	}
+
+	// Return nil, if no input provided
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_dedicated_host_group.getDedicatedHostGroup", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := compute.NewDedicatedHostGroupsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, name, compute.InstanceViewTypesInstanceView)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_dedicated_host_group.getDedicatedHostGroup", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// Dedicated host IDs have the form
+// /subscriptions/{id}/resourceGroups/{rg}/providers/Microsoft.Compute/hostGroups/{group}/hosts/{host}
+func extractDedicatedHostGroupNameFromID(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	segments := strings.Split(types.SafeString(d.Value), "/")
+	if len(segments) < 9 {
+		return nil, nil
+	}
+	return segments[8], nil
+}
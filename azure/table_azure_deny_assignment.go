@@ -0,0 +1,182 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/authorization/armauthorization/v2"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureDenyAssignment(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_deny_assignment",
+		Description: "Azure Deny Assignment",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getDenyAssignment,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"DenyAssignmentNotFound", "ResourceNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listDenyAssignments,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the deny assignment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the deny assignment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "deny_assignment_name",
+				Description: "The display name of the deny assignment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DenyAssignmentName"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the deny assignment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Description"),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource (Microsoft.Authorization/denyAssignments).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "scope",
+				Description: "The scope of the deny assignment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Scope"),
+			},
+			{
+				Name:        "do_not_apply_to_child_scopes",
+				Description: "Indicates whether the deny assignment does not apply to the child scopes of its scope.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.DoNotApplyToChildScopes"),
+			},
+			{
+				Name:        "is_system_protected",
+				Description: "Indicates whether the deny assignment was created by Azure, for example by a blueprint or a managed application, and cannot be edited or deleted.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.IsSystemProtected"),
+			},
+			{
+				Name:        "permissions",
+				Description: "The permissions denied by the deny assignment, with the actions and the data actions they deny and exclude.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Permissions"),
+			},
+			{
+				Name:        "principals",
+				Description: "The principals the deny assignment applies to. The principal with an empty ID and the type 'Everyone' represents all users, groups and service principals.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Principals"),
+			},
+			{
+				Name:        "exclude_principals",
+				Description: "The principals the deny assignment does not apply to.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.ExcludePrincipals"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DenyAssignmentName", "Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDenyAssignments(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_deny_assignment.listDenyAssignments", "session_error", err)
+		return nil, err
+	}
+
+	client, err := armauthorization.NewDenyAssignmentsClient(session.SubscriptionID, session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_deny_assignment.listDenyAssignments", "client_error", err)
+		return nil, err
+	}
+
+	// The deny assignments of the subscription include the ones of its resource groups and resources
+	pager := client.NewListPager(nil)
+	for pager.More() {
+		result, err := pager.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_deny_assignment.listDenyAssignments", "api_error", err)
+			return nil, err
+		}
+		for _, denyAssignment := range result.Value {
+			d.StreamListItem(ctx, *denyAssignment)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDenyAssignment(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQualString("id")
+
+	// Return nil, if no input provided
+	if id == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_deny_assignment.getDenyAssignment", "session_error", err)
+		return nil, err
+	}
+
+	client, err := armauthorization.NewDenyAssignmentsClient(session.SubscriptionID, session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_deny_assignment.getDenyAssignment", "client_error", err)
+		return nil, err
+	}
+
+	op, err := client.GetByID(ctx, id, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_deny_assignment.getDenyAssignment", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op.DenyAssignment, nil
+	}
+
+	return nil, nil
+}
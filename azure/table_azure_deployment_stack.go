@@ -0,0 +1,424 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/resources/mgmt/resources"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureDeploymentStack(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_deployment_stack",
+		Description: "Azure Deployment Stack, the resources deployed together from a template and managed, and optionally locked, as a single unit.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "name",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "resource_group",
+					Require: plugin.Optional,
+				},
+			},
+			Hydrate: getDeploymentStack,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "DeploymentStackNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listDeploymentStacks,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the deployment stack.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the deployment stack.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the deployment stack.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "scope",
+				Description: "The scope the deployment stack is created at, either 'subscription' or 'resourceGroup'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractDeploymentStackScope),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the deployment stack.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Description"),
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the deployment stack. Possible values include: 'Creating', 'Validating', 'Waiting', 'Deploying', 'Canceling', 'UpdatingDenyAssignments', 'DeletingResources', 'Succeeded', 'Failed', 'Canceled', 'Deleting'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ProvisioningState"),
+			},
+			{
+				Name:        "deployment_scope",
+				Description: "The scope the resources of the deployment stack are deployed to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DeploymentScope"),
+			},
+			{
+				Name:        "deployment_id",
+				Description: "The resource ID of the deployment that last updated the deployment stack.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DeploymentID"),
+			},
+			{
+				Name:        "correlation_id",
+				Description: "The correlation ID of the deployment that last updated the deployment stack.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.CorrelationID"),
+			},
+			{
+				Name:        "duration",
+				Description: "The duration of the last deployment of the deployment stack.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Duration"),
+			},
+			{
+				Name:        "deny_settings_mode",
+				Description: "The operations denied on the managed resources of the deployment stack. Possible values include: 'denyDelete', 'denyWriteAndDelete', 'none'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DenySettings.Mode"),
+			},
+			{
+				Name:        "deny_settings_apply_to_child_scopes",
+				Description: "Indicates whether the deny settings also apply to the child resources of the managed resources.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.DenySettings.ApplyToChildScopes"),
+			},
+			{
+				Name:        "deny_settings_excluded_principals",
+				Description: "The IDs of the principals that are excluded from the deny settings.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.DenySettings.ExcludedPrincipals"),
+			},
+			{
+				Name:        "deny_settings_excluded_actions",
+				Description: "The management operations that are excluded from the deny settings.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.DenySettings.ExcludedActions"),
+			},
+			{
+				Name:        "action_on_unmanage_resources",
+				Description: "What happens to the resources that are no longer managed by the deployment stack. Possible values include: 'delete', 'detach'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ActionOnUnmanage.Resources"),
+			},
+			{
+				Name:        "action_on_unmanage_resource_groups",
+				Description: "What happens to the resource groups that are no longer managed by the deployment stack. Possible values include: 'delete', 'detach'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ActionOnUnmanage.ResourceGroups"),
+			},
+			{
+				Name:        "action_on_unmanage_management_groups",
+				Description: "What happens to the management groups that are no longer managed by the deployment stack. Possible values include: 'delete', 'detach'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ActionOnUnmanage.ManagementGroups"),
+			},
+			{
+				Name:        "bypass_stack_out_of_sync_error",
+				Description: "Indicates whether the check that the deployment stack is in sync with its resources is bypassed on update.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.BypassStackOutOfSyncError"),
+			},
+			{
+				Name:        "resources",
+				Description: "The resources currently managed by the deployment stack, with their management and deny status.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Resources"),
+			},
+			{
+				Name:        "deleted_resources",
+				Description: "The resources deleted by the last update of the deployment stack.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.DeletedResources"),
+			},
+			{
+				Name:        "detached_resources",
+				Description: "The resources detached from the deployment stack by its last update.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.DetachedResources"),
+			},
+			{
+				Name:        "failed_resources",
+				Description: "The resources that failed to be deleted or detached by the last update of the deployment stack.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.FailedResources"),
+			},
+			{
+				Name:        "outputs",
+				Description: "The outputs of the template of the deployment stack.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Outputs"),
+			},
+			{
+				Name:        "parameters",
+				Description: "The parameters of the template of the deployment stack.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Parameters"),
+			},
+			{
+				Name:        "template_link",
+				Description: "The URI of the template of the deployment stack.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.TemplateLink"),
+			},
+			{
+				Name:        "error",
+				Description: "The error of the last update of the deployment stack, if any.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Error"),
+			},
+			{
+				Name:        "system_data",
+				Description: "Metadata pertaining to creation and last modification of the deployment stack.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractDeploymentStackResourceGroup),
+			},
+		}),
+	}
+}
+
+// There is no SDK package for the deployment stacks of the Microsoft.Resources resource provider
+const deploymentStackAPIVersion = "2024-03-01"
+
+type DeploymentStack struct {
+	ID         *string                    `json:"id,omitempty"`
+	Name       *string                    `json:"name,omitempty"`
+	Type       *string                    `json:"type,omitempty"`
+	Location   *string                    `json:"location,omitempty"`
+	Tags       map[string]*string         `json:"tags,omitempty"`
+	SystemData map[string]interface{}     `json:"systemData,omitempty"`
+	Properties *DeploymentStackProperties `json:"properties,omitempty"`
+}
+
+type DeploymentStackProperties struct {
+	Description               *string                          `json:"description,omitempty"`
+	ProvisioningState         *string                          `json:"provisioningState,omitempty"`
+	DeploymentScope           *string                          `json:"deploymentScope,omitempty"`
+	DeploymentID              *string                          `json:"deploymentId,omitempty"`
+	CorrelationID             *string                          `json:"correlationId,omitempty"`
+	Duration                  *string                          `json:"duration,omitempty"`
+	DenySettings              *DeploymentStackDenySettings     `json:"denySettings,omitempty"`
+	ActionOnUnmanage          *DeploymentStackActionOnUnmanage `json:"actionOnUnmanage,omitempty"`
+	BypassStackOutOfSyncError *bool                            `json:"bypassStackOutOfSyncError,omitempty"`
+	Resources                 []interface{}                    `json:"resources,omitempty"`
+	DeletedResources          []interface{}                    `json:"deletedResources,omitempty"`
+	DetachedResources         []interface{}                    `json:"detachedResources,omitempty"`
+	FailedResources           []interface{}                    `json:"failedResources,omitempty"`
+	Outputs                   map[string]interface{}           `json:"outputs,omitempty"`
+	Parameters                map[string]interface{}           `json:"parameters,omitempty"`
+	TemplateLink              map[string]interface{}           `json:"templateLink,omitempty"`
+	Error                     map[string]interface{}           `json:"error,omitempty"`
+}
+
+type DeploymentStackDenySettings struct {
+	Mode               *string  `json:"mode,omitempty"`
+	ApplyToChildScopes *bool    `json:"applyToChildScopes,omitempty"`
+	ExcludedPrincipals []string `json:"excludedPrincipals,omitempty"`
+	ExcludedActions    []string `json:"excludedActions,omitempty"`
+}
+
+type DeploymentStackActionOnUnmanage struct {
+	Resources        *string `json:"resources,omitempty"`
+	ResourceGroups   *string `json:"resourceGroups,omitempty"`
+	ManagementGroups *string `json:"managementGroups,omitempty"`
+}
+
+//// LIST FUNCTION
+
+func listDeploymentStacks(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_deployment_stack.listDeploymentStacks", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	// Deployment stacks created at the subscription scope
+	more, err := listDeploymentStacksAtScope(ctx, d, "/subscriptions/"+subscriptionID)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_deployment_stack.listDeploymentStacks", "api_error", err)
+		return nil, err
+	}
+	if !more {
+		return nil, nil
+	}
+
+	// Deployment stacks created at the resource group scope can only be listed per resource group
+	groupsClient := resources.NewGroupsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	groupsClient.Authorizer = session.Authorizer
+
+	result, err := groupsClient.List(ctx, "", nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_deployment_stack.listDeploymentStacks", "api_error", err)
+		return nil, err
+	}
+
+	for {
+		for _, resourceGroup := range result.Values() {
+			more, err := listDeploymentStacksAtScope(ctx, d, *resourceGroup.ID)
+			if err != nil {
+				plugin.Logger(ctx).Error("azure_deployment_stack.listDeploymentStacks", "api_error", err)
+				return nil, err
+			}
+			if !more {
+				return nil, nil
+			}
+		}
+
+		if !result.NotDone() {
+			break
+		}
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_deployment_stack.listDeploymentStacks", "api_paging_error", err)
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDeploymentStack(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if name == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_deployment_stack.getDeploymentStack", "session_error", err)
+		return nil, err
+	}
+
+	// Without a resource group, the deployment stack is looked up at the subscription scope
+	path := "/subscriptions/" + session.SubscriptionID
+	if resourceGroup != "" {
+		path += "/resourceGroups/" + resourceGroup
+	}
+	path += "/providers/Microsoft.Resources/deploymentStacks/" + name
+
+	var stack DeploymentStack
+	found, err := getResourceManagerResource(ctx, d, path, deploymentStackAPIVersion, &stack)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_deployment_stack.getDeploymentStack", "api_error", err)
+		return nil, err
+	}
+
+	if found && stack.ID != nil {
+		return stack, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func extractDeploymentStackScope(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if deploymentStackResourceGroup(types.SafeString(d.Value)) == "" {
+		return "subscription", nil
+	}
+	return "resourceGroup", nil
+}
+
+// Deployment stacks created at the subscription scope do not belong to any resource group
+func extractDeploymentStackResourceGroup(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if resourceGroup := deploymentStackResourceGroup(types.SafeString(d.Value)); resourceGroup != "" {
+		return strings.ToLower(resourceGroup), nil
+	}
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// listDeploymentStacksAtScope streams the deployment stacks created at scope, and reports false
+// once the limit of the query has been hit
+func listDeploymentStacksAtScope(ctx context.Context, d *plugin.QueryData, scope string) (bool, error) {
+	more := true
+	err := listResourceManagerResources(ctx, d, scope+"/providers/Microsoft.Resources/deploymentStacks", deploymentStackAPIVersion, func(items []json.RawMessage) (bool, error) {
+		for _, item := range items {
+			var stack DeploymentStack
+			if err := json.Unmarshal(item, &stack); err != nil {
+				return false, err
+			}
+			d.StreamListItem(ctx, stack)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				more = false
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	return more, err
+}
+
+func deploymentStackResourceGroup(id string) string {
+	segments := strings.Split(id, "/")
+	if len(segments) > 4 && strings.EqualFold(segments[3], "resourceGroups") {
+		return segments[4]
+	}
+	return ""
+}
@@ -0,0 +1,198 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureDevCenter(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_dev_center",
+		Description: "Azure Dev Center, the Microsoft Dev Box dev centers of the subscription.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getDevCenter,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listDevCenters,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the dev center.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the dev center.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the dev center.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the dev center.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ProvisioningState"),
+			},
+			{
+				Name:        "dev_center_uri",
+				Description: "The URI of the dev center, used by the developer portal and the Dev Box clients.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DevCenterURI"),
+			},
+			{
+				Name:        "identity",
+				Description: "The managed identity of the dev center.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "identity_type",
+				Description: "The type of managed identity used by the resource. Possible values include: 'SystemAssigned', 'UserAssigned', 'SystemAssigned, UserAssigned', 'None'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityType),
+			},
+			{
+				Name:        "system_assigned_principal_id",
+				Description: "The principal ID of the system-assigned managed identity of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityPrincipalID),
+			},
+			{
+				Name:        "user_assigned_identity_ids",
+				Description: "The resource IDs of the user-assigned managed identities associated with the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityUserAssignedIDs),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+// There is no SDK package for the Microsoft.DevCenter resource provider
+const devCenterAPIVersion = "2023-04-01"
+
+type DevCenter struct {
+	ID         *string                  `json:"id,omitempty"`
+	Name       *string                  `json:"name,omitempty"`
+	Type       *string                  `json:"type,omitempty"`
+	Location   *string                  `json:"location,omitempty"`
+	Tags       map[string]*string       `json:"tags,omitempty"`
+	Identity   *ResourceManagerIdentity `json:"identity,omitempty"`
+	Properties *DevCenterProperties     `json:"properties,omitempty"`
+}
+
+type DevCenterProperties struct {
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+	DevCenterURI      *string `json:"devCenterUri,omitempty"`
+}
+
+//// LIST FUNCTION
+
+func listDevCenters(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_dev_center.listDevCenters", "session_error", err)
+		return nil, err
+	}
+
+	path := "/subscriptions/" + session.SubscriptionID + "/providers/Microsoft.DevCenter/devcenters"
+	err = listResourceManagerResources(ctx, d, path, devCenterAPIVersion, func(items []json.RawMessage) (bool, error) {
+		for _, item := range items {
+			var devCenter DevCenter
+			if err := json.Unmarshal(item, &devCenter); err != nil {
+				return false, err
+			}
+			d.StreamListItem(ctx, devCenter)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_dev_center.listDevCenters", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDevCenter(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_dev_center.getDevCenter", "session_error", err)
+		return nil, err
+	}
+
+	var devCenter DevCenter
+	path := "/subscriptions/" + session.SubscriptionID + "/resourceGroups/" + resourceGroup + "/providers/Microsoft.DevCenter/devcenters/" + name
+	found, err := getResourceManagerResource(ctx, d, path, devCenterAPIVersion, &devCenter)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_dev_center.getDevCenter", "api_error", err)
+		return nil, err
+	}
+
+	if found && devCenter.ID != nil {
+		return devCenter, nil
+	}
+
+	return nil, nil
+}
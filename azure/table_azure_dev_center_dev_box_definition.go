@@ -0,0 +1,252 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureDevCenterDevBoxDefinition(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_dev_center_dev_box_definition",
+		Description: "Azure Dev Center Dev Box Definition, the image and SKU used to create dev boxes.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"dev_center_name", "name", "resource_group"}),
+			Hydrate:    getDevCenterDevBoxDefinition,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listDevCenters,
+			Hydrate:       listDevCenterDevBoxDefinitions,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the dev box definition.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the dev box definition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the dev box definition.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "dev_center_name",
+				Description: "The name of the dev center the dev box definition belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractDevCenterParentNameFromID),
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the dev box definition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ProvisioningState"),
+			},
+			{
+				Name:        "sku_name",
+				Description: "The name of the SKU of the dev boxes, for example 'general_i_8c32gb256ssd_v2'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Sku.Name"),
+			},
+			{
+				Name:        "sku",
+				Description: "The SKU of the dev boxes.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Sku"),
+			},
+			{
+				Name:        "image_id",
+				Description: "The resource ID of the image the dev boxes are created from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ImageReference.ID"),
+			},
+			{
+				Name:        "active_image_version",
+				Description: "The exact version of the image used by the dev box definition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ActiveImageReference.ExactVersion"),
+			},
+			{
+				Name:        "image_validation_status",
+				Description: "The validation status of the image. Possible values include: 'Unknown', 'Pending', 'Succeeded', 'Failed', 'TimedOut'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ImageValidationStatus"),
+			},
+			{
+				Name:        "image_validation_error_details",
+				Description: "The details of the image validation error, if any.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.ImageValidationErrorDetails"),
+			},
+			{
+				Name:        "os_storage_type",
+				Description: "The storage type of the OS disk of the dev boxes, for example 'ssd_256gb'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.OsStorageType"),
+			},
+			{
+				Name:        "hibernate_support",
+				Description: "Indicates whether the dev boxes support hibernation. Possible values include: 'Enabled', 'Disabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.HibernateSupport"),
+			},
+			{
+				Name:        "image_reference",
+				Description: "The image the dev boxes are created from.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.ImageReference"),
+			},
+			{
+				Name:        "active_image_reference",
+				Description: "The image version currently used by the dev box definition.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.ActiveImageReference"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type DevCenterDevBoxDefinition struct {
+	ID         *string                              `json:"id,omitempty"`
+	Name       *string                              `json:"name,omitempty"`
+	Type       *string                              `json:"type,omitempty"`
+	Location   *string                              `json:"location,omitempty"`
+	Tags       map[string]*string                   `json:"tags,omitempty"`
+	Properties *DevCenterDevBoxDefinitionProperties `json:"properties,omitempty"`
+}
+
+type DevCenterDevBoxDefinitionProperties struct {
+	ProvisioningState           *string                  `json:"provisioningState,omitempty"`
+	ImageReference              *DevCenterImageReference `json:"imageReference,omitempty"`
+	ActiveImageReference        *DevCenterImageReference `json:"activeImageReference,omitempty"`
+	Sku                         map[string]interface{}   `json:"sku,omitempty"`
+	OsStorageType               *string                  `json:"osStorageType,omitempty"`
+	HibernateSupport            *string                  `json:"hibernateSupport,omitempty"`
+	ImageValidationStatus       *string                  `json:"imageValidationStatus,omitempty"`
+	ImageValidationErrorDetails map[string]interface{}   `json:"imageValidationErrorDetails,omitempty"`
+}
+
+type DevCenterImageReference struct {
+	ID           *string `json:"id,omitempty"`
+	ExactVersion *string `json:"exactVersion,omitempty"`
+}
+
+//// LIST FUNCTION
+
+func listDevCenterDevBoxDefinitions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	devCenter := h.Item.(DevCenter)
+
+	err := listResourceManagerResources(ctx, d, *devCenter.ID+"/devboxdefinitions", devCenterAPIVersion, func(items []json.RawMessage) (bool, error) {
+		for _, item := range items {
+			var definition DevCenterDevBoxDefinition
+			if err := json.Unmarshal(item, &definition); err != nil {
+				return false, err
+			}
+			d.StreamListItem(ctx, definition)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_dev_center_dev_box_definition.listDevCenterDevBoxDefinitions", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDevCenterDevBoxDefinition(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	devCenterName := d.EqualsQualString("dev_center_name")
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if devCenterName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_dev_center_dev_box_definition.getDevCenterDevBoxDefinition", "session_error", err)
+		return nil, err
+	}
+
+	var definition DevCenterDevBoxDefinition
+	path := "/subscriptions/" + session.SubscriptionID + "/resourceGroups/" + resourceGroup + "/providers/Microsoft.DevCenter/devcenters/" + devCenterName + "/devboxdefinitions/" + name
+	found, err := getResourceManagerResource(ctx, d, path, devCenterAPIVersion, &definition)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_dev_center_dev_box_definition.getDevCenterDevBoxDefinition", "api_error", err)
+		return nil, err
+	}
+
+	if found && definition.ID != nil {
+		return definition, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// Dev box definitions and pools are child resources of dev centers and projects respectively
+func extractDevCenterParentNameFromID(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	segments := strings.Split(types.SafeString(d.Value), "/")
+	if len(segments) < 9 {
+		return nil, nil
+	}
+	return segments[8], nil
+}
@@ -0,0 +1,227 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureDevCenterPool(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_dev_center_pool",
+		Description: "Azure Dev Center Pool, the dev box pools of the Dev Box projects.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"project_name", "name", "resource_group"}),
+			Hydrate:    getDevCenterPool,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listDevCenterProjects,
+			Hydrate:       listDevCenterPools,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the pool.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the pool.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the pool.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "project_name",
+				Description: "The name of the project the pool belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractDevCenterParentNameFromID),
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the pool.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ProvisioningState"),
+			},
+			{
+				Name:        "dev_box_pool_name",
+				Description: "The name of the dev box pool used to create the dev boxes of the pool.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DevBoxDefinitionName"),
+			},
+			{
+				Name:        "network_connection_name",
+				Description: "The name of the network connection the dev boxes of the pool are attached to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.NetworkConnectionName"),
+			},
+			{
+				Name:        "license_type",
+				Description: "The license type of the dev boxes of the pool, for example 'Windows_Client'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.LicenseType"),
+			},
+			{
+				Name:        "local_administrator",
+				Description: "Indicates whether the owners of the dev boxes are local administrators. Possible values include: 'Enabled', 'Disabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.LocalAdministrator"),
+			},
+			{
+				Name:        "stop_on_disconnect_status",
+				Description: "Indicates whether the dev boxes are stopped when their owner disconnects. Possible values include: 'Enabled', 'Disabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.StopOnDisconnect.Status"),
+			},
+			{
+				Name:        "stop_on_disconnect_grace_period_minutes",
+				Description: "The time in minutes after disconnection before the dev boxes are stopped.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Properties.StopOnDisconnect.GracePeriodMinutes"),
+			},
+			{
+				Name:        "health_status",
+				Description: "The health status of the pool. Possible values include: 'Unknown', 'Pending', 'Healthy', 'Warning', 'Unhealthy'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.HealthStatus"),
+			},
+			{
+				Name:        "health_status_details",
+				Description: "The details of the health status of the pool.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.HealthStatusDetails"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type DevCenterPool struct {
+	ID         *string                  `json:"id,omitempty"`
+	Name       *string                  `json:"name,omitempty"`
+	Type       *string                  `json:"type,omitempty"`
+	Location   *string                  `json:"location,omitempty"`
+	Tags       map[string]*string       `json:"tags,omitempty"`
+	Properties *DevCenterPoolProperties `json:"properties,omitempty"`
+}
+
+type DevCenterPoolProperties struct {
+	ProvisioningState     *string                    `json:"provisioningState,omitempty"`
+	DevBoxDefinitionName  *string                    `json:"devBoxDefinitionName,omitempty"`
+	NetworkConnectionName *string                    `json:"networkConnectionName,omitempty"`
+	LicenseType           *string                    `json:"licenseType,omitempty"`
+	LocalAdministrator    *string                    `json:"localAdministrator,omitempty"`
+	StopOnDisconnect      *DevCenterStopOnDisconnect `json:"stopOnDisconnect,omitempty"`
+	HealthStatus          *string                    `json:"healthStatus,omitempty"`
+	HealthStatusDetails   []map[string]interface{}   `json:"healthStatusDetails,omitempty"`
+}
+
+type DevCenterStopOnDisconnect struct {
+	Status             *string `json:"status,omitempty"`
+	GracePeriodMinutes *int32  `json:"gracePeriodMinutes,omitempty"`
+}
+
+//// LIST FUNCTION
+
+func listDevCenterPools(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	project := h.Item.(DevCenterProject)
+
+	err := listResourceManagerResources(ctx, d, *project.ID+"/pools", devCenterAPIVersion, func(items []json.RawMessage) (bool, error) {
+		for _, item := range items {
+			var pool DevCenterPool
+			if err := json.Unmarshal(item, &pool); err != nil {
+				return false, err
+			}
+			d.StreamListItem(ctx, pool)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_dev_center_pool.listDevCenterPools", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDevCenterPool(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	projectName := d.EqualsQualString("project_name")
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if projectName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_dev_center_pool.getDevCenterPool", "session_error", err)
+		return nil, err
+	}
+
+	var pool DevCenterPool
+	path := "/subscriptions/" + session.SubscriptionID + "/resourceGroups/" + resourceGroup + "/providers/Microsoft.DevCenter/projects/" + projectName + "/pools/" + name
+	found, err := getResourceManagerResource(ctx, d, path, devCenterAPIVersion, &pool)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_dev_center_pool.getDevCenterPool", "api_error", err)
+		return nil, err
+	}
+
+	if found && pool.ID != nil {
+		return pool, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,198 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureDevCenterProject(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_dev_center_project",
+		Description: "Azure Dev Center Project",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getDevCenterProject,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listDevCenterProjects,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the project.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the project.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Description"),
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the project.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ProvisioningState"),
+			},
+			{
+				Name:        "dev_center_id",
+				Description: "The resource ID of the dev center the project belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DevCenterID"),
+			},
+			{
+				Name:        "dev_center_name",
+				Description: "The name of the dev center the project belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DevCenterID").Transform(lastPathElement),
+			},
+			{
+				Name:        "dev_center_uri",
+				Description: "The URI of the dev center the project belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DevCenterURI"),
+			},
+			{
+				Name:        "max_dev_boxes_per_user",
+				Description: "The maximum number of dev boxes a user can create in the project, or null if there is no limit.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Properties.MaxDevBoxesPerUser"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type DevCenterProject struct {
+	ID         *string                     `json:"id,omitempty"`
+	Name       *string                     `json:"name,omitempty"`
+	Type       *string                     `json:"type,omitempty"`
+	Location   *string                     `json:"location,omitempty"`
+	Tags       map[string]*string          `json:"tags,omitempty"`
+	Properties *DevCenterProjectProperties `json:"properties,omitempty"`
+}
+
+type DevCenterProjectProperties struct {
+	ProvisioningState  *string `json:"provisioningState,omitempty"`
+	Description        *string `json:"description,omitempty"`
+	DevCenterID        *string `json:"devCenterId,omitempty"`
+	DevCenterURI       *string `json:"devCenterUri,omitempty"`
+	MaxDevBoxesPerUser *int32  `json:"maxDevBoxesPerUser,omitempty"`
+}
+
+//// LIST FUNCTION
+
+func listDevCenterProjects(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_dev_center_project.listDevCenterProjects", "session_error", err)
+		return nil, err
+	}
+
+	path := "/subscriptions/" + session.SubscriptionID + "/providers/Microsoft.DevCenter/projects"
+	err = listResourceManagerResources(ctx, d, path, devCenterAPIVersion, func(items []json.RawMessage) (bool, error) {
+		for _, item := range items {
+			var project DevCenterProject
+			if err := json.Unmarshal(item, &project); err != nil {
+				return false, err
+			}
+			d.StreamListItem(ctx, project)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_dev_center_project.listDevCenterProjects", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDevCenterProject(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_dev_center_project.getDevCenterProject", "session_error", err)
+		return nil, err
+	}
+
+	var project DevCenterProject
+	path := "/subscriptions/" + session.SubscriptionID + "/resourceGroups/" + resourceGroup + "/providers/Microsoft.DevCenter/projects/" + name
+	found, err := getResourceManagerResource(ctx, d, path, devCenterAPIVersion, &project)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_dev_center_project.getDevCenterProject", "api_error", err)
+		return nil, err
+	}
+
+	if found && project.ID != nil {
+		return project, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,229 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/devtestlabs/mgmt/dtl"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureDevTestGlobalSchedule(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_devtest_global_schedule",
+		Description: "Azure DevTest Global Schedule",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getDevTestGlobalSchedule,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listDevTestGlobalSchedules,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the schedule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the schedule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the schedule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the schedule resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ScheduleProperties.ProvisioningState"),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the schedule. Possible values include: 'Enabled', 'Disabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ScheduleProperties.Status").Transform(transform.ToString),
+			},
+			{
+				Name:        "task_type",
+				Description: "The task type of the schedule, for example 'ComputeVmShutdownTask'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ScheduleProperties.TaskType"),
+			},
+			{
+				Name:        "target_resource_id",
+				Description: "The resource ID of the virtual machine the schedule applies to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ScheduleProperties.TargetResourceID"),
+			},
+			{
+				Name:        "time_zone_id",
+				Description: "The time zone ID of the schedule, for example 'Pacific Standard Time'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ScheduleProperties.TimeZoneID"),
+			},
+			{
+				Name:        "daily_recurrence_time",
+				Description: "The time of day the schedule runs, in the format 'HHmm'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ScheduleProperties.DailyRecurrence.Time"),
+			},
+			{
+				Name:        "weekly_recurrence",
+				Description: "The days of the week and time the schedule runs.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ScheduleProperties.WeeklyRecurrence"),
+			},
+			{
+				Name:        "hourly_recurrence",
+				Description: "The minute of the hour the schedule runs.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ScheduleProperties.HourlyRecurrence"),
+			},
+			{
+				Name:        "notification_settings",
+				Description: "The notification settings of the schedule, such as the webhook URL or email recipient notified before shutdown.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ScheduleProperties.NotificationSettings"),
+			},
+			{
+				Name:        "created_date",
+				Description: "The creation date of the schedule.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ScheduleProperties.CreatedDate").Transform(convertDateToTime),
+			},
+			{
+				Name:        "unique_identifier",
+				Description: "The unique immutable identifier of the schedule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ScheduleProperties.UniqueIdentifier"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDevTestGlobalSchedules(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_devtest_global_schedule.listDevTestGlobalSchedules", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := dtl.NewGlobalSchedulesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListBySubscription(ctx, "", "", nil, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_devtest_global_schedule.listDevTestGlobalSchedules", "api_error", err)
+		return nil, err
+	}
+
+	for _, schedule := range result.Values() {
+		d.StreamListItem(ctx, schedule)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_devtest_global_schedule.listDevTestGlobalSchedules", "api_paging_error", err)
+			return nil, err
+		}
+		for _, schedule := range result.Values() {
+			d.StreamListItem(ctx, schedule)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDevTestGlobalSchedule(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_devtest_global_schedule.getDevTestGlobalSchedule", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := dtl.NewGlobalSchedulesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_devtest_global_schedule.getDevTestGlobalSchedule", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,392 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/devtestlabs/mgmt/dtl"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureDevTestLab(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_devtest_lab",
+		Description: "Azure DevTest Lab",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getDevTestLab,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listDevTestLabs,
+		},
+		// The shutdown schedule and the cost target are only present if they have been configured for the lab
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getDevTestLabShutdownSchedule,
+				IgnoreConfig: &plugin.IgnoreConfig{
+					ShouldIgnoreErrorFunc: isNotFoundError([]string{"NotFound", "404"}),
+				},
+			},
+			{
+				Func: getDevTestLabTargetCost,
+				IgnoreConfig: &plugin.IgnoreConfig{
+					ShouldIgnoreErrorFunc: isNotFoundError([]string{"NotFound", "404"}),
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the lab.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the lab.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the lab.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the lab.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabProperties.ProvisioningState"),
+			},
+			{
+				Name:        "created_date",
+				Description: "The creation date of the lab.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LabProperties.CreatedDate").Transform(convertDateToTime),
+			},
+			{
+				Name:        "lab_storage_type",
+				Description: "The type of storage used by the lab. Possible values include: 'Standard', 'Premium', 'StandardSSD'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabProperties.LabStorageType"),
+			},
+			{
+				Name:        "premium_data_disks",
+				Description: "Indicates whether the users of the lab can create premium data disks. Possible values include: 'Disabled', 'Enabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabProperties.PremiumDataDisks"),
+			},
+			{
+				Name:        "environment_permission",
+				Description: "The access rights of the lab users on the resource groups of their environments. Possible values include: 'Reader', 'Contributor'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabProperties.EnvironmentPermission"),
+			},
+			{
+				Name:        "vm_creation_resource_group",
+				Description: "The resource group in which the virtual machines of the lab are created.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabProperties.VMCreationResourceGroup"),
+			},
+			{
+				Name:        "vault_name",
+				Description: "The name of the key vault of the lab.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabProperties.VaultName"),
+			},
+			{
+				Name:        "default_storage_account",
+				Description: "The resource ID of the default storage account of the lab.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabProperties.DefaultStorageAccount"),
+			},
+			{
+				Name:        "artifacts_storage_account",
+				Description: "The resource ID of the storage account holding the artifacts of the lab.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabProperties.ArtifactsStorageAccount"),
+			},
+			{
+				Name:        "public_ip_id",
+				Description: "The resource ID of the public IP address shared by the virtual machines of the lab.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabProperties.PublicIPID"),
+			},
+			{
+				Name:        "load_balancer_id",
+				Description: "The resource ID of the load balancer shared by the virtual machines of the lab.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabProperties.LoadBalancerID"),
+			},
+			{
+				Name:        "network_security_group_id",
+				Description: "The resource ID of the network security group of the lab.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabProperties.NetworkSecurityGroupID"),
+			},
+			{
+				Name:        "unique_identifier",
+				Description: "The unique immutable identifier of the lab.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabProperties.UniqueIdentifier"),
+			},
+			{
+				Name:        "shutdown_schedule_status",
+				Description: "The status of the auto-shutdown schedule of the lab virtual machines. Possible values include: 'Enabled', 'Disabled'.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDevTestLabShutdownSchedule,
+				Transform:   transform.FromField("ScheduleProperties.Status").Transform(transform.ToString),
+			},
+			{
+				Name:        "shutdown_time",
+				Description: "The time of day the lab virtual machines are shut down, in the format 'HHmm'.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDevTestLabShutdownSchedule,
+				Transform:   transform.FromField("ScheduleProperties.DailyRecurrence.Time"),
+			},
+			{
+				Name:        "shutdown_time_zone_id",
+				Description: "The time zone ID of the auto-shutdown schedule, for example 'Pacific Standard Time'.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDevTestLabShutdownSchedule,
+				Transform:   transform.FromField("ScheduleProperties.TimeZoneID"),
+			},
+			{
+				Name:        "shutdown_notification_settings",
+				Description: "The notification settings of the auto-shutdown schedule.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDevTestLabShutdownSchedule,
+				Transform:   transform.FromField("ScheduleProperties.NotificationSettings"),
+			},
+			{
+				Name:        "target_cost_status",
+				Description: "The status of the monthly cost target of the lab. Possible values include: 'Enabled', 'Disabled'.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDevTestLabTargetCost,
+				Transform:   transform.FromField("LabCostProperties.TargetCost.Status"),
+			},
+			{
+				Name:        "target_cost",
+				Description: "The cost target of the lab for the reporting cycle.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getDevTestLabTargetCost,
+				Transform:   transform.FromField("LabCostProperties.TargetCost.Target"),
+			},
+			{
+				Name:        "target_cost_cycle_type",
+				Description: "The reporting cycle of the cost target. Possible values include: 'CalendarMonth', 'Custom'.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDevTestLabTargetCost,
+				Transform:   transform.FromField("LabCostProperties.TargetCost.CycleType"),
+			},
+			{
+				Name:        "cost_thresholds",
+				Description: "The thresholds of the cost target, with their notification settings.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDevTestLabTargetCost,
+				Transform:   transform.FromField("LabCostProperties.TargetCost.CostThresholds"),
+			},
+			{
+				Name:        "currency_code",
+				Description: "The currency of the cost target.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDevTestLabTargetCost,
+				Transform:   transform.FromField("LabCostProperties.CurrencyCode"),
+			},
+			{
+				Name:        "announcement",
+				Description: "The announcement shown to the users of the lab.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LabProperties.Announcement"),
+			},
+			{
+				Name:        "support",
+				Description: "The support message shown to the users of the lab.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LabProperties.Support"),
+			},
+			{
+				Name:        "mandatory_artifacts_resource_ids_linux",
+				Description: "The artifacts installed on every Linux virtual machine of the lab.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LabProperties.MandatoryArtifactsResourceIdsLinux"),
+			},
+			{
+				Name:        "mandatory_artifacts_resource_ids_windows",
+				Description: "The artifacts installed on every Windows virtual machine of the lab.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LabProperties.MandatoryArtifactsResourceIdsWindows"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDevTestLabs(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_devtest_lab.listDevTestLabs", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := dtl.NewLabsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListBySubscription(ctx, "", "", nil, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_devtest_lab.listDevTestLabs", "api_error", err)
+		return nil, err
+	}
+
+	for _, lab := range result.Values() {
+		d.StreamListItem(ctx, lab)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_devtest_lab.listDevTestLabs", "api_paging_error", err)
+			return nil, err
+		}
+		for _, lab := range result.Values() {
+			d.StreamListItem(ctx, lab)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDevTestLab(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_devtest_lab.getDevTestLab", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := dtl.NewLabsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_devtest_lab.getDevTestLab", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+func getDevTestLabShutdownSchedule(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	lab := h.Item.(dtl.Lab)
+	resourceGroup := strings.Split(*lab.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_devtest_lab.getDevTestLabShutdownSchedule", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := dtl.NewSchedulesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	// The auto-shutdown policy of a lab is the schedule with the reserved name LabVmsShutdown
+	op, err := client.Get(ctx, resourceGroup, *lab.Name, "LabVmsShutdown", "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_devtest_lab.getDevTestLabShutdownSchedule", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func getDevTestLabTargetCost(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	lab := h.Item.(dtl.Lab)
+	resourceGroup := strings.Split(*lab.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_devtest_lab.getDevTestLabTargetCost", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := dtl.NewCostsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	// The cost settings of a lab are stored in the cost resource with the reserved name targetCost
+	op, err := client.Get(ctx, resourceGroup, *lab.Name, "targetCost", "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_devtest_lab.getDevTestLabTargetCost", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
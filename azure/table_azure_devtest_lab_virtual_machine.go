@@ -0,0 +1,321 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/devtestlabs/mgmt/dtl"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureDevTestLabVirtualMachine(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_devtest_lab_virtual_machine",
+		Description: "Azure DevTest Lab Virtual Machine",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"lab_name", "name", "resource_group"}),
+			Hydrate:    getDevTestLabVirtualMachine,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listDevTestLabs,
+			Hydrate:       listDevTestLabVirtualMachines,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the virtual machine.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the virtual machine.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the virtual machine.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lab_name",
+				Description: "The name of the lab the virtual machine belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractDevTestLabNameFromID),
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the virtual machine.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabVirtualMachineProperties.ProvisioningState"),
+			},
+			{
+				Name:        "last_known_power_state",
+				Description: "The last known power state of the virtual machine.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabVirtualMachineProperties.LastKnownPowerState"),
+			},
+			{
+				Name:        "size",
+				Description: "The size of the virtual machine.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabVirtualMachineProperties.Size"),
+			},
+			{
+				Name:        "os_type",
+				Description: "The operating system type of the virtual machine.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabVirtualMachineProperties.OsType"),
+			},
+			{
+				Name:        "owner_object_id",
+				Description: "The object ID of the owner of the virtual machine.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabVirtualMachineProperties.OwnerObjectID"),
+			},
+			{
+				Name:        "owner_user_principal_name",
+				Description: "The user principal name of the owner of the virtual machine.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabVirtualMachineProperties.OwnerUserPrincipalName"),
+			},
+			{
+				Name:        "created_by_user",
+				Description: "The user who created the virtual machine.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabVirtualMachineProperties.CreatedByUser"),
+			},
+			{
+				Name:        "created_date",
+				Description: "The creation date of the virtual machine.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LabVirtualMachineProperties.CreatedDate").Transform(convertDateToTime),
+			},
+			{
+				Name:        "expiration_date",
+				Description: "The date the virtual machine is deleted automatically.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LabVirtualMachineProperties.ExpirationDate").Transform(convertDateToTime),
+			},
+			{
+				Name:        "allow_claim",
+				Description: "Indicates whether another user can take ownership of the virtual machine.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("LabVirtualMachineProperties.AllowClaim"),
+			},
+			{
+				Name:        "fqdn",
+				Description: "The fully-qualified domain name of the virtual machine.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabVirtualMachineProperties.Fqdn"),
+			},
+			{
+				Name:        "disallow_public_ip_address",
+				Description: "Indicates whether the virtual machine is created without a public IP address.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("LabVirtualMachineProperties.DisallowPublicIPAddress"),
+			},
+			{
+				Name:        "user_name",
+				Description: "The user name of the virtual machine.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabVirtualMachineProperties.UserName"),
+			},
+			{
+				Name:        "is_authentication_with_ssh_key",
+				Description: "Indicates whether the virtual machine uses an SSH key for authentication.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("LabVirtualMachineProperties.IsAuthenticationWithSSHKey"),
+			},
+			{
+				Name:        "compute_id",
+				Description: "The resource ID of the compute virtual machine of the lab virtual machine.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabVirtualMachineProperties.ComputeID"),
+			},
+			{
+				Name:        "lab_virtual_network_id",
+				Description: "The resource ID of the lab virtual network the virtual machine is connected to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabVirtualMachineProperties.LabVirtualNetworkID"),
+			},
+			{
+				Name:        "lab_subnet_name",
+				Description: "The name of the lab subnet the virtual machine is connected to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabVirtualMachineProperties.LabSubnetName"),
+			},
+			{
+				Name:        "custom_image_id",
+				Description: "The resource ID of the custom image the virtual machine was created from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabVirtualMachineProperties.CustomImageID"),
+			},
+			{
+				Name:        "gallery_image_reference",
+				Description: "The Marketplace image the virtual machine was created from.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LabVirtualMachineProperties.GalleryImageReference"),
+			},
+			{
+				Name:        "shutdown_schedule",
+				Description: "The auto-shutdown schedule that applies to the virtual machine, either its own or the lab schedule.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LabVirtualMachineProperties.ApplicableSchedule.ApplicableScheduleProperties.LabVmsShutdown"),
+			},
+			{
+				Name:        "startup_schedule",
+				Description: "The auto-start schedule that applies to the virtual machine.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LabVirtualMachineProperties.ApplicableSchedule.ApplicableScheduleProperties.LabVmsStartup"),
+			},
+			{
+				Name:        "artifacts",
+				Description: "The artifacts installed on the virtual machine.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LabVirtualMachineProperties.Artifacts"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+// The schedules that apply to a virtual machine are only returned when expanded
+const devTestLabVirtualMachineExpand = "properties($expand=applicableSchedule)"
+
+//// LIST FUNCTION
+
+func listDevTestLabVirtualMachines(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	lab := h.Item.(dtl.Lab)
+	resourceGroup := strings.Split(*lab.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_devtest_lab_virtual_machine.listDevTestLabVirtualMachines", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := dtl.NewVirtualMachinesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx, resourceGroup, *lab.Name, devTestLabVirtualMachineExpand, "", nil, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_devtest_lab_virtual_machine.listDevTestLabVirtualMachines", "api_error", err)
+		return nil, err
+	}
+
+	for _, vm := range result.Values() {
+		d.StreamListItem(ctx, vm)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_devtest_lab_virtual_machine.listDevTestLabVirtualMachines", "api_paging_error", err)
+			return nil, err
+		}
+		for _, vm := range result.Values() {
+			d.StreamListItem(ctx, vm)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDevTestLabVirtualMachine(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	labName := d.EqualsQualString("lab_name")
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if labName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_devtest_lab_virtual_machine.getDevTestLabVirtualMachine", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := dtl.NewVirtualMachinesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, labName, name, devTestLabVirtualMachineExpand)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_devtest_lab_virtual_machine.getDevTestLabVirtualMachine", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func extractDevTestLabNameFromID(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	segments := strings.Split(types.SafeString(d.Value), "/")
+	if len(segments) < 9 {
+		return nil, nil
+	}
+	return segments[8], nil
+}
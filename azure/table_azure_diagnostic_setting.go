@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/monitor/mgmt/insights"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 
@@ -92,6 +93,19 @@ func tableAzureDiagnosticSetting(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("DiagnosticSettings.Logs"),
 			},
+			{
+				Name:        "enabled_log_categories",
+				Description: "The categories of the logs that are enabled.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DiagnosticSettings.Logs").Transform(extractDiagnosticSettingEnabledLogCategories),
+			},
+			{
+				Name:        "missing_required_categories",
+				Description: "The categories of the activity log that the CIS benchmark requires to be exported, i.e. Administrative, Alert, Policy and Security, that are not enabled. An enabled allLogs or audit category group covers all of them.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDiagnosticSettingLogs,
+				Transform:   transform.FromField("Properties.Logs").Transform(extractDiagnosticSettingMissingRequiredCategories),
+			},
 
 			// Steampipe standard columns
 			{
@@ -173,8 +187,47 @@ func getDiagnosticSetting(ctx context.Context, d *plugin.QueryData, h *plugin.Hy
 	return op, nil
 }
 
+// getDiagnosticSettingLogs gets the setting with armmonitor, whose API version also returns the
+// category groups of the logs.
+func getDiagnosticSettingLogs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	setting := h.Item.(insights.DiagnosticSettingsResource)
+
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_diagnostic_setting.getDiagnosticSettingLogs", "session_error", err)
+		return nil, err
+	}
+
+	client, err := armmonitor.NewDiagnosticSettingsClient(session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_diagnostic_setting.getDiagnosticSettingLogs", "client_error", err)
+		return nil, err
+	}
+
+	op, err := client.Get(ctx, "/subscriptions/"+session.SubscriptionID, *setting.Name, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_diagnostic_setting.getDiagnosticSettingLogs", "api_error", err)
+		return nil, err
+	}
+
+	return op.DiagnosticSettingsResource, nil
+}
+
 //// TRANSFORM FUNCTION
 
+func extractDiagnosticSettingEnabledLogCategories(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	logs, ok := d.Value.(*[]insights.LogSettings)
+	if !ok {
+		return nil, nil
+	}
+	return enabledDiagnosticLogCategories(logs), nil
+}
+
+func extractDiagnosticSettingMissingRequiredCategories(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	logs, _ := d.Value.([]*armmonitor.LogSettings)
+	return missingActivityLogCategories(logs), nil
+}
+
 func diagnosticSettingResourceGroup(ctx context.Context, d *transform.TransformData) (interface{}, error) {
 	item := d.HydrateItem.(insights.DiagnosticSettingsResource)
 	if item.StorageAccountID != nil {
@@ -185,3 +238,47 @@ func diagnosticSettingResourceGroup(ctx context.Context, d *transform.TransformD
 		return strings.Split(*item.WorkspaceID, "/")[4], nil
 	}
 }
+
+//// UTILITY FUNCTIONS
+
+// The categories of the activity log that the CIS Microsoft Azure Foundations Benchmark requires
+// the diagnostic settings of the subscription to export
+var activityLogRequiredCategories = []string{"Administrative", "Alert", "Policy", "Security"}
+
+func enabledDiagnosticLogCategories(logs *[]insights.LogSettings) []string {
+	categories := []string{}
+	if logs == nil {
+		return categories
+	}
+	for _, log := range *logs {
+		if log.Category != nil && log.Enabled != nil && *log.Enabled {
+			categories = append(categories, *log.Category)
+		}
+	}
+	return categories
+}
+
+// missingActivityLogCategories returns the required categories of the activity log that are not
+// enabled by logs. An enabled allLogs or audit category group covers all the required categories.
+func missingActivityLogCategories(logs []*armmonitor.LogSettings) []string {
+	enabled := map[string]bool{}
+	for _, log := range logs {
+		if log == nil || log.Enabled == nil || !*log.Enabled {
+			continue
+		}
+		if log.CategoryGroup != nil && (strings.EqualFold(*log.CategoryGroup, "allLogs") || strings.EqualFold(*log.CategoryGroup, "audit")) {
+			return []string{}
+		}
+		if log.Category != nil {
+			enabled[strings.ToLower(*log.Category)] = true
+		}
+	}
+
+	missing := []string{}
+	for _, required := range activityLogRequiredCategories {
+		if !enabled[strings.ToLower(required)] {
+			missing = append(missing, required)
+		}
+	}
+	return missing
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/eventhub/mgmt/eventhub"
 	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/monitor/mgmt/insights"
+	"github.com/turbot/go-kit/types"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
@@ -135,11 +136,41 @@ func tableAzureEventHubNamespace(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("EHNamespaceProperties.Encryption"),
 			},
+			{
+				Name:        "customer_managed_key_enabled",
+				Description: "Indicates whether the namespace is encrypted with a customer-managed key stored in Azure Key Vault.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromP(extractEventHubNamespaceCustomerManagedKey, "enabled"),
+			},
+			{
+				Name:        "customer_managed_key_uri",
+				Description: "The URI of the Key Vault key used to encrypt the namespace. If several keys are configured, the first key is returned.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(extractEventHubNamespaceCustomerManagedKey, "uri"),
+			},
 			{
 				Name:        "identity",
-				Description: "Describes the properties of BYOK encryption description.",
+				Description: "Describes the managed identity of the namespace.",
 				Type:        proto.ColumnType_JSON,
-				Transform:   transform.FromField("EHNamespaceProperties.Encryption"),
+				Transform:   transform.FromField("Identity"),
+			},
+			{
+				Name:        "identity_type",
+				Description: "The type of managed identity used by the resource. Possible values include: 'SystemAssigned', 'UserAssigned', 'SystemAssigned, UserAssigned', 'None'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityType),
+			},
+			{
+				Name:        "system_assigned_principal_id",
+				Description: "The principal ID of the system-assigned managed identity of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityPrincipalID),
+			},
+			{
+				Name:        "user_assigned_identity_ids",
+				Description: "The resource IDs of the user-assigned managed identities associated with the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityUserAssignedIDs),
 			},
 			{
 				Name:        "network_rule_set",
@@ -393,3 +424,27 @@ func extractEventHubNamespacePrivateEndpointConnections(i eventhub.PrivateEndpoi
 	}
 	return eventHubNamespacePrivateEndpointConnection
 }
+
+// A namespace can be encrypted with several keys; the URI of the first key is returned
+func extractEventHubNamespaceCustomerManagedKey(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	namespace := d.HydrateItem.(eventhub.EHNamespace)
+
+	var enabled bool
+	var keyURI string
+	if namespace.EHNamespaceProperties != nil && namespace.Encryption != nil {
+		encryption := namespace.Encryption
+		enabled = encryption.KeySource == eventhub.MicrosoftKeyVault
+		if enabled && encryption.KeyVaultProperties != nil && len(*encryption.KeyVaultProperties) > 0 {
+			properties := (*encryption.KeyVaultProperties)[0]
+			keyURI = buildKeyVaultKeyURI(types.SafeString(properties.KeyVaultURI), types.SafeString(properties.KeyName), types.SafeString(properties.KeyVersion))
+		}
+	}
+
+	if d.Param.(string) == "enabled" {
+		return enabled, nil
+	}
+	if keyURI == "" {
+		return nil, nil
+	}
+	return keyURI, nil
+}
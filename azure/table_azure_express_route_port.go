@@ -0,0 +1,263 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/network/mgmt/network"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureExpressRoutePort(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_express_route_port",
+		Description: "Azure ExpressRoute Port",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getExpressRoutePort,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listExpressRoutePorts,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the ExpressRoute port.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the ExpressRoute port.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the ExpressRoute port.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "etag",
+				Description: "A unique read-only string that changes whenever the resource is updated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the ExpressRoute port.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExpressRoutePortPropertiesFormat.ProvisioningState"),
+			},
+			{
+				Name:        "peering_location",
+				Description: "The name of the peering location the ExpressRoute port is mapped to physically.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExpressRoutePortPropertiesFormat.PeeringLocation"),
+			},
+			{
+				Name:        "bandwidth_in_gbps",
+				Description: "The bandwidth of the procured ports in Gbps.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ExpressRoutePortPropertiesFormat.BandwidthInGbps"),
+			},
+			{
+				Name:        "provisioned_bandwidth_in_gbps",
+				Description: "The aggregate Gbps of the circuits provisioned on the ExpressRoute port.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("ExpressRoutePortPropertiesFormat.ProvisionedBandwidthInGbps"),
+			},
+			{
+				Name:        "mtu",
+				Description: "The maximum transmission unit of the physical port pair.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExpressRoutePortPropertiesFormat.Mtu"),
+			},
+			{
+				Name:        "encapsulation",
+				Description: "The encapsulation method on the physical ports. Possible values include: 'Dot1Q', 'QinQ'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExpressRoutePortPropertiesFormat.Encapsulation"),
+			},
+			{
+				Name:        "ether_type",
+				Description: "The ether type of the physical port.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExpressRoutePortPropertiesFormat.EtherType"),
+			},
+			{
+				Name:        "allocation_date",
+				Description: "The date of the physical port allocation to be used in letter of authorization.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExpressRoutePortPropertiesFormat.AllocationDate"),
+			},
+			{
+				Name:        "billing_type",
+				Description: "The billing type of the ExpressRoute port. Possible values include: 'MeteredData', 'UnlimitedData'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExpressRoutePortPropertiesFormat.BillingType"),
+			},
+			{
+				Name:        "resource_guid",
+				Description: "The resource GUID property of the ExpressRoute port.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExpressRoutePortPropertiesFormat.ResourceGUID"),
+			},
+			{
+				Name:        "circuits",
+				Description: "The references to the ExpressRoute circuits provisioned on the port.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ExpressRoutePortPropertiesFormat.Circuits"),
+			},
+			{
+				Name:        "identity",
+				Description: "The identity of the ExpressRoute port, used to read the MACsec secrets from key vault.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "identity_type",
+				Description: "The type of managed identity used by the resource. Possible values include: 'SystemAssigned', 'UserAssigned', 'SystemAssigned, UserAssigned', 'None'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityType),
+			},
+			{
+				Name:        "system_assigned_principal_id",
+				Description: "The principal ID of the system-assigned managed identity of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityPrincipalID),
+			},
+			{
+				Name:        "user_assigned_identity_ids",
+				Description: "The resource IDs of the user-assigned managed identities associated with the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityUserAssignedIDs),
+			},
+			{
+				Name:        "links",
+				Description: "The set of physical links of the ExpressRoute port, with their admin state and MACsec configuration.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ExpressRoutePortPropertiesFormat.Links"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listExpressRoutePorts(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_express_route_port.listExpressRoutePorts", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := network.NewExpressRoutePortsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_express_route_port.listExpressRoutePorts", "api_error", err)
+		return nil, err
+	}
+
+	for _, port := range result.Values() {
+		d.StreamListItem(ctx, port)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_express_route_port.listExpressRoutePorts", "api_paging_error", err)
+			return nil, err
+		}
+		for _, port := range result.Values() {
+			d.StreamListItem(ctx, port)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getExpressRoutePort(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_express_route_port.getExpressRoutePort", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := network.NewExpressRoutePortsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_express_route_port.getExpressRoutePort", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,238 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/network/mgmt/network"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureExpressRoutePortLink(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_express_route_port_link",
+		Description: "Azure ExpressRoute Port Link, the physical links of an ExpressRoute Direct port and their MACsec configuration.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"port_name", "name", "resource_group"}),
+			Hydrate:    getExpressRoutePortLink,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listExpressRoutePorts,
+			Hydrate:       listExpressRoutePortLinks,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the link, either 'link1' or 'link2'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the link.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "port_name",
+				Description: "The name of the ExpressRoute port the link belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "etag",
+				Description: "A unique read-only string that changes whenever the resource is updated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the link.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExpressRouteLinkPropertiesFormat.ProvisioningState"),
+			},
+			{
+				Name:        "admin_state",
+				Description: "The administrative state of the physical port. Possible values include: 'Enabled', 'Disabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExpressRouteLinkPropertiesFormat.AdminState"),
+			},
+			{
+				Name:        "connector_type",
+				Description: "The physical fiber port type. Possible values include: 'LC', 'SC'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExpressRouteLinkPropertiesFormat.ConnectorType"),
+			},
+			{
+				Name:        "router_name",
+				Description: "The name of the Azure router associated with the physical port.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExpressRouteLinkPropertiesFormat.RouterName"),
+			},
+			{
+				Name:        "interface_name",
+				Description: "The name of the Azure router interface.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExpressRouteLinkPropertiesFormat.InterfaceName"),
+			},
+			{
+				Name:        "patch_panel_id",
+				Description: "The mapping between the physical port and the patch panel port.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExpressRouteLinkPropertiesFormat.PatchPanelID"),
+			},
+			{
+				Name:        "rack_id",
+				Description: "The mapping of the physical patch panel to the rack.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExpressRouteLinkPropertiesFormat.RackID"),
+			},
+			{
+				Name:        "colo_location",
+				Description: "The cololocation of the link.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExpressRouteLinkPropertiesFormat.ColoLocation"),
+			},
+			{
+				Name:        "macsec_enabled",
+				Description: "Indicates whether MACsec is configured on the link, that is both the CKN and CAK secrets are set.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("ExpressRouteLinkPropertiesFormat.MacSecConfig").Transform(isExpressRouteLinkMacSecEnabled),
+			},
+			{
+				Name:        "macsec_cipher",
+				Description: "The MACsec cipher used for the link. Possible values include: 'GcmAes256', 'GcmAes128', 'GcmAesXpn128', 'GcmAesXpn256'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExpressRouteLinkPropertiesFormat.MacSecConfig.Cipher"),
+			},
+			{
+				Name:        "macsec_sci_state",
+				Description: "The sci mode of the MACsec configuration. Possible values include: 'Disabled', 'Enabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExpressRouteLinkPropertiesFormat.MacSecConfig.SciState"),
+			},
+			{
+				Name:        "macsec_ckn_secret_identifier",
+				Description: "The key vault secret identifier of the MACsec CKN key.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExpressRouteLinkPropertiesFormat.MacSecConfig.CknSecretIdentifier"),
+			},
+			{
+				Name:        "macsec_cak_secret_identifier",
+				Description: "The key vault secret identifier of the MACsec CAK key.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExpressRouteLinkPropertiesFormat.MacSecConfig.CakSecretIdentifier"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+// Links are returned as part of the port, which carries the location of both links
+type ExpressRoutePortLinkInfo struct {
+	PortName string
+	Location *string
+	network.ExpressRouteLink
+}
+
+//// LIST FUNCTION
+
+func listExpressRoutePortLinks(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	port := h.Item.(network.ExpressRoutePort)
+	if port.ExpressRoutePortPropertiesFormat == nil || port.Links == nil {
+		return nil, nil
+	}
+
+	for _, link := range *port.Links {
+		d.StreamListItem(ctx, ExpressRoutePortLinkInfo{types.SafeString(port.Name), port.Location, link})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getExpressRoutePortLink(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	portName := d.EqualsQualString("port_name")
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if portName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_express_route_port_link.getExpressRoutePortLink", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := network.NewExpressRoutePortsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	port, err := client.Get(ctx, resourceGroup, portName)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_express_route_port_link.getExpressRoutePortLink", "api_error", err)
+		return nil, err
+	}
+
+	if port.ExpressRoutePortPropertiesFormat == nil || port.Links == nil {
+		return nil, nil
+	}
+	for _, link := range *port.Links {
+		if link.Name != nil && strings.EqualFold(*link.Name, name) {
+			return ExpressRoutePortLinkInfo{types.SafeString(port.Name), port.Location, link}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func isExpressRouteLinkMacSecEnabled(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	config, ok := d.Value.(*network.ExpressRouteLinkMacSecConfig)
+	if !ok || config == nil {
+		return false, nil
+	}
+	return config.CknSecretIdentifier != nil && config.CakSecretIdentifier != nil, nil
+}
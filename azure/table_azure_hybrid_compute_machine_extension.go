@@ -0,0 +1,236 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/hybridcompute/mgmt/hybridcompute"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureHybridComputeMachineExtension(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_hybrid_compute_machine_extension",
+		Description: "Azure Hybrid Compute Machine Extension",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"machine_name", "name", "resource_group"}),
+			Hydrate:    getHybridComputeMachineExtension,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listHybridComputeMachines,
+			Hydrate:       listHybridComputeMachineExtensionItems,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the extension.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the extension.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "machine_name",
+				Description: "The name of the Arc-enabled server the extension is installed on.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractHybridComputeMachineNameFromID),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the extension.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "extension_type",
+				Description: "The type of the extension, for example 'MDE.Windows' or 'AzureMonitorLinuxAgent'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MachineExtensionProperties.Type"),
+			},
+			{
+				Name:        "publisher",
+				Description: "The name of the extension handler publisher.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MachineExtensionProperties.Publisher"),
+			},
+			{
+				Name:        "type_handler_version",
+				Description: "The version of the extension handler.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MachineExtensionProperties.TypeHandlerVersion"),
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the extension.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MachineExtensionProperties.ProvisioningState"),
+			},
+			{
+				Name:        "auto_upgrade_minor_version",
+				Description: "Indicates whether the extension should use a newer minor version if one is available at deployment time.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("MachineExtensionProperties.AutoUpgradeMinorVersion"),
+			},
+			{
+				Name:        "force_update_tag",
+				Description: "How the extension handler should be forced to update even if the extension configuration has not changed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MachineExtensionProperties.ForceUpdateTag"),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the extension reported by the machine.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("MachineExtensionProperties.InstanceView.Status"),
+			},
+			{
+				Name:        "settings",
+				Description: "The public settings of the extension.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("MachineExtensionProperties.Settings"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listHybridComputeMachineExtensionItems(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	machine := h.Item.(hybridcompute.Machine)
+	resourceGroup := strings.Split(*machine.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_hybrid_compute_machine_extension.listHybridComputeMachineExtensionItems", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := hybridcompute.NewMachineExtensionsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx, resourceGroup, *machine.Name, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_hybrid_compute_machine_extension.listHybridComputeMachineExtensionItems", "api_error", err)
+		return nil, err
+	}
+
+	for _, extension := range result.Values() {
+		d.StreamListItem(ctx, extension)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_hybrid_compute_machine_extension.listHybridComputeMachineExtensionItems", "api_paging_error", err)
+			return nil, err
+		}
+		for _, extension := range result.Values() {
+			d.StreamListItem(ctx, extension)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getHybridComputeMachineExtension(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	machineName := d.EqualsQualString("machine_name")
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if machineName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_hybrid_compute_machine_extension.getHybridComputeMachineExtension", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := hybridcompute.NewMachineExtensionsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, machineName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_hybrid_compute_machine_extension.getHybridComputeMachineExtension", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// Extension IDs have the form
+// /subscriptions/{id}/resourceGroups/{rg}/providers/Microsoft.HybridCompute/machines/{machine}/extensions/{extension}
+func extractHybridComputeMachineNameFromID(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	segments := strings.Split(types.SafeString(d.Value), "/")
+	if len(segments) < 9 {
+		return nil, nil
+	}
+	return segments[8], nil
+}
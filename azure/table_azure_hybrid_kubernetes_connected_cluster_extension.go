@@ -0,0 +1,285 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/hybridkubernetes/mgmt/hybridkubernetes"
+	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/kubernetesconfiguration/mgmt/kubernetesconfiguration"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureHybridKubernetesConnectedClusterExtension(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_hybrid_kubernetes_connected_cluster_extension",
+		Description: "Azure Hybrid Kubernetes Connected Cluster Extension",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"cluster_name", "name", "resource_group"}),
+			Hydrate:    getHybridKubernetesConnectedClusterExtension,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listHybridKubernetesConnectedClusters,
+			Hydrate:       listHybridKubernetesConnectedClusterExtensionItems,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the extension.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the extension.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "cluster_name",
+				Description: "The name of the Arc-enabled Kubernetes cluster the extension is installed on.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractHybridKubernetesConnectedClusterNameFromID),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the extension.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "extension_type",
+				Description: "The type of the extension, for example 'microsoft.azuremonitor.containers' or 'microsoft.flux'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExtensionProperties.ExtensionType"),
+			},
+			{
+				Name:        "version",
+				Description: "The version of the extension pinned by the user, if auto upgrade is disabled.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExtensionProperties.Version"),
+			},
+			{
+				Name:        "installed_version",
+				Description: "The version of the extension installed on the cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExtensionProperties.InstalledVersion"),
+			},
+			{
+				Name:        "release_train",
+				Description: "The release train the extension is auto upgraded from, for example 'Stable' or 'Preview'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExtensionProperties.ReleaseTrain"),
+			},
+			{
+				Name:        "auto_upgrade_minor_version",
+				Description: "Indicates whether the extension is upgraded automatically to new minor versions.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("ExtensionProperties.AutoUpgradeMinorVersion"),
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the extension.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExtensionProperties.ProvisioningState"),
+			},
+			{
+				Name:        "package_uri",
+				Description: "The URI of the Helm package of the extension.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExtensionProperties.PackageURI"),
+			},
+			{
+				Name:        "created_at",
+				Description: "The timestamp of resource creation (UTC).",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("SystemData.CreatedAt").Transform(convertDateToTime),
+			},
+			{
+				Name:        "last_modified_at",
+				Description: "The timestamp of resource last modification (UTC).",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("SystemData.LastModifiedAt").Transform(convertDateToTime),
+			},
+			{
+				Name:        "scope",
+				Description: "The scope the extension is installed at, either the whole cluster or a single namespace.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ExtensionProperties.Scope"),
+			},
+			{
+				Name:        "configuration_settings",
+				Description: "The configuration settings of the extension, as name-value pairs.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ExtensionProperties.ConfigurationSettings"),
+			},
+			{
+				Name:        "statuses",
+				Description: "The status information reported by the extension.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ExtensionProperties.Statuses"),
+			},
+			{
+				Name:        "error_info",
+				Description: "The error detail of the extension, if any.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ExtensionProperties.ErrorInfo"),
+			},
+			{
+				Name:        "identity",
+				Description: "The identity of the extension.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "identity_type",
+				Description: "The type of managed identity used by the resource. Possible values include: 'SystemAssigned', 'UserAssigned', 'SystemAssigned, UserAssigned', 'None'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityType),
+			},
+			{
+				Name:        "system_assigned_principal_id",
+				Description: "The principal ID of the system-assigned managed identity of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityPrincipalID),
+			},
+			{
+				Name:        "user_assigned_identity_ids",
+				Description: "The resource IDs of the user-assigned managed identities associated with the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityUserAssignedIDs),
+			},
+			{
+				Name:        "aks_assigned_identity",
+				Description: "The identity assigned to the extension by the cluster.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ExtensionProperties.AksAssignedIdentity"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listHybridKubernetesConnectedClusterExtensionItems(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	cluster := h.Item.(hybridkubernetes.ConnectedCluster)
+	resourceGroup := strings.Split(*cluster.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_hybrid_kubernetes_connected_cluster_extension.listHybridKubernetesConnectedClusterExtensionItems", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := kubernetesconfiguration.NewExtensionsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx, resourceGroup, "Microsoft.Kubernetes", "connectedClusters", *cluster.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_hybrid_kubernetes_connected_cluster_extension.listHybridKubernetesConnectedClusterExtensionItems", "api_error", err)
+		return nil, err
+	}
+
+	for _, extension := range result.Values() {
+		d.StreamListItem(ctx, extension)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_hybrid_kubernetes_connected_cluster_extension.listHybridKubernetesConnectedClusterExtensionItems", "api_paging_error", err)
+			return nil, err
+		}
+		for _, extension := range result.Values() {
+			d.StreamListItem(ctx, extension)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getHybridKubernetesConnectedClusterExtension(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	clusterName := d.EqualsQualString("cluster_name")
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if clusterName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_hybrid_kubernetes_connected_cluster_extension.getHybridKubernetesConnectedClusterExtension", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := kubernetesconfiguration.NewExtensionsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, "Microsoft.Kubernetes", "connectedClusters", clusterName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_hybrid_kubernetes_connected_cluster_extension.getHybridKubernetesConnectedClusterExtension", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// Extension IDs have the form
+// /subscriptions/{id}/resourceGroups/{rg}/providers/Microsoft.Kubernetes/connectedClusters/{cluster}/providers/Microsoft.KubernetesConfiguration/extensions/{extension}
+func extractHybridKubernetesConnectedClusterNameFromID(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	segments := strings.Split(types.SafeString(d.Value), "/")
+	if len(segments) < 9 {
+		return nil, nil
+	}
+	return segments[8], nil
+}
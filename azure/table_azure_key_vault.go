@@ -47,6 +47,13 @@ func tableAzureKeyVault(_ context.Context) *plugin.Table {
 				Hydrate:     getKeyVault,
 				Transform:   transform.FromField("Properties.VaultURI"),
 			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the vault. Possible values include: 'Succeeded', 'RegisteringDNS'.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getKeyVault,
+				Transform:   transform.FromField("Properties.ProvisioningState"),
+			},
 			{
 				Name:        "type",
 				Description: "Type of the resource.",
@@ -155,6 +162,55 @@ func tableAzureKeyVault(_ context.Context) *plugin.Table {
 				Hydrate:     getKeyVault,
 				Transform:   transform.FromField("Properties.NetworkAcls"),
 			},
+			{
+				Name:        "public_network_access",
+				Description: "Specifies whether the vault accepts traffic from the public internet.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getKeyVault,
+				Transform:   transform.FromField("Properties.PublicNetworkAccess"),
+			},
+			{
+				Name:        "network_rule_default_action",
+				Description: "The default action when no rule from ip_rules and from virtual_network_rules match. Possible values include: 'Allow', 'Deny'.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getKeyVault,
+				Transform:   transform.FromField("Properties.NetworkAcls.DefaultAction").Transform(transform.ToString),
+			},
+			{
+				Name:        "network_rule_bypass",
+				Description: "Specifies what traffic can bypass the network rules. Possible values include: 'AzureServices', 'None'.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getKeyVault,
+				Transform:   transform.FromField("Properties.NetworkAcls.Bypass").Transform(transform.ToString),
+			},
+			{
+				Name:        "network_ip_rules",
+				Description: "A list of IPv4 addresses or CIDR ranges allowed to access the vault.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getKeyVault,
+				Transform:   transform.From(extractKeyVaultNetworkIPRules),
+			},
+			{
+				Name:        "virtual_network_rules",
+				Description: "A list of virtual network subnet rules allowed to access the vault.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getKeyVault,
+				Transform:   transform.FromField("Properties.NetworkAcls.VirtualNetworkRules"),
+			},
+			{
+				Name:        "publicly_accessible",
+				Description: "Indicates whether the vault is reachable from any public IP address, i.e. public network access is not disabled and the firewall allows all networks.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getKeyVault,
+				Transform:   transform.From(isKeyVaultPubliclyAccessible),
+			},
+			{
+				Name:        "public_network_exposed",
+				Description: "Indicates whether the vault is reachable from any address on the internet, derived from the public network access setting and the firewall rules. It has the same value as publicly_accessible.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getKeyVault,
+				Transform:   transform.From(isKeyVaultPubliclyAccessible),
+			},
 			{
 				Name:        "private_endpoint_connections",
 				Description: "List of private endpoint connections associated with the key vault.",
@@ -408,6 +464,49 @@ func extractKeyVaultAccessPolicies(ctx context.Context, d *transform.TransformDa
 	return policies, nil
 }
 
+func extractKeyVaultNetworkIPRules(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	vault := d.HydrateItem.(keyvault.Vault)
+	var ipRules []string
+
+	if vault.Properties != nil && vault.Properties.NetworkAcls != nil && vault.Properties.NetworkAcls.IPRules != nil {
+		for _, rule := range *vault.Properties.NetworkAcls.IPRules {
+			if rule.Value != nil {
+				ipRules = append(ipRules, *rule.Value)
+			}
+		}
+	}
+
+	return ipRules, nil
+}
+
+// A vault is publicly accessible unless public network access is disabled or
+// the firewall denies traffic by default without allowing every IPv4 address.
+// Both publicly_accessible and public_network_exposed are derived from it.
+func isKeyVaultPubliclyAccessible(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	vault := d.HydrateItem.(keyvault.Vault)
+	if vault.Properties == nil {
+		return false, nil
+	}
+
+	acls := vault.Properties.NetworkAcls
+	allowsAllNetworks := acls == nil || acls.DefaultAction != keyvault.Deny
+	if !allowsAllNetworks && acls.IPRules != nil {
+		for _, rule := range *acls.IPRules {
+			if rule.Value != nil && (*rule.Value == "0.0.0.0/0" || *rule.Value == "0.0.0.0") {
+				allowsAllNetworks = true
+				break
+			}
+		}
+	}
+
+	publicNetworkAccess := ""
+	if vault.Properties.PublicNetworkAccess != nil {
+		publicNetworkAccess = *vault.Properties.PublicNetworkAccess
+	}
+
+	return isPublicNetworkExposed(publicNetworkAccess, allowsAllNetworks), nil
+}
+
 func getKeyVaultID(item interface{}) string {
 	switch item := item.(type) {
 	case keyvault.Vault:
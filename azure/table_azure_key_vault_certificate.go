@@ -0,0 +1,350 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/keyvault/mgmt/keyvault"
+	certificate "github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureKeyVaultCertificate(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_key_vault_certificate",
+		Description: "Azure Key Vault Certificate",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"vault_name", "name"}),
+			Hydrate:    getKeyVaultCertificate,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "CertificateNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate:       listKeyVaultCertificates,
+			ParentHydrate: listKeyVaults,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The friendly name that identifies the certificate.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(extractVaultNameFromSecretID, "Name"),
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the certificate, with its current version.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getKeyVaultCertificate,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "vault_name",
+				Description: "The friendly name that identifies the vault.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(extractVaultNameFromSecretID, "VaultName"),
+			},
+			{
+				Name:        "enabled",
+				Description: "Indicates whether the certificate is enabled, or not.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Attributes.Enabled"),
+			},
+			{
+				Name:        "thumbprint",
+				Description: "The base64url encoded SHA-1 thumbprint of the X509 certificate.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("X509Thumbprint"),
+			},
+			{
+				Name:        "created_at",
+				Description: "Specifies the time when the certificate is created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Attributes.Created").Transform(convertDateUnixToTime),
+			},
+			{
+				Name:        "updated_at",
+				Description: "Specifies the time when the certificate was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Attributes.Updated").Transform(convertDateUnixToTime),
+			},
+			{
+				Name:        "not_before",
+				Description: "Specifies the time before which the certificate is not valid.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Attributes.NotBefore").Transform(convertDateUnixToTime),
+			},
+			{
+				Name:        "expires_at",
+				Description: "Specifies the time when the certificate expires.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Attributes.Expires").Transform(convertDateUnixToTime).Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "recoverable_days",
+				Description: "Specifies the soft delete data retention days. Value should be >=7 and <=90 when softDelete enabled, otherwise 0.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Attributes.RecoverableDays"),
+			},
+			{
+				Name:        "recovery_level",
+				Description: "The deletion recovery level currently in effect for the certificate. If it contains 'Purgeable', then the certificate can be permanently deleted by a privileged user; otherwise, only the system can purge the certificate at the end of the retention interval.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Attributes.RecoveryLevel").Transform(transform.ToString),
+			},
+			{
+				Name:        "kid",
+				Description: "The ID of the key backing the certificate.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getKeyVaultCertificate,
+			},
+			{
+				Name:        "sid",
+				Description: "The ID of the secret backing the certificate.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getKeyVaultCertificate,
+			},
+			{
+				Name:        "issuer_name",
+				Description: "The name of the issuer of the certificate, for example 'Self', 'Unknown', or the name of a certificate authority issuer of the vault.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getKeyVaultCertificate,
+				Transform:   transform.FromField("Policy.IssuerParameters.Name"),
+			},
+			{
+				Name:        "certificate_type",
+				Description: "The type of certificate to be requested from the issuer.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getKeyVaultCertificate,
+				Transform:   transform.FromField("Policy.IssuerParameters.CertificateType"),
+			},
+			{
+				Name:        "certificate_transparency",
+				Description: "Indicates whether the certificates are published to certificate transparency logs.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getKeyVaultCertificate,
+				Transform:   transform.FromField("Policy.IssuerParameters.CertificateTransparency"),
+			},
+			{
+				Name:        "subject",
+				Description: "The subject name of the certificate.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getKeyVaultCertificate,
+				Transform:   transform.FromField("Policy.X509CertificateProperties.Subject"),
+			},
+			{
+				Name:        "validity_in_months",
+				Description: "The duration that the certificate is valid in months.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getKeyVaultCertificate,
+				Transform:   transform.FromField("Policy.X509CertificateProperties.ValidityInMonths"),
+			},
+			{
+				Name:        "key_type",
+				Description: "The type of the key pair of the certificate. Possible values include: 'EC', 'EC-HSM', 'RSA', 'RSA-HSM'.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getKeyVaultCertificate,
+				Transform:   transform.FromField("Policy.KeyProperties.KeyType").Transform(transform.ToString),
+			},
+			{
+				Name:        "key_size",
+				Description: "The size of the key of the certificate in bits, for example 2048 for an RSA key.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getKeyVaultCertificate,
+				Transform:   transform.FromField("Policy.KeyProperties.KeySize"),
+			},
+			{
+				Name:        "key_curve_name",
+				Description: "The elliptic curve name of the key of the certificate. Possible values include: 'P-256', 'P-384', 'P-521', 'P-256K'.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getKeyVaultCertificate,
+				Transform:   transform.FromField("Policy.KeyProperties.Curve").Transform(transform.ToString),
+			},
+			{
+				Name:        "exportable",
+				Description: "Indicates whether the private key of the certificate can be exported.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getKeyVaultCertificate,
+				Transform:   transform.FromField("Policy.KeyProperties.Exportable"),
+			},
+			{
+				Name:        "reuse_key",
+				Description: "Indicates whether the same key pair is used on certificate renewal.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getKeyVaultCertificate,
+				Transform:   transform.FromField("Policy.KeyProperties.ReuseKey"),
+			},
+			{
+				Name:        "content_type",
+				Description: "The media type of the secret backing the certificate, 'application/x-pkcs12' or 'application/x-pem-file'.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getKeyVaultCertificate,
+				Transform:   transform.FromField("Policy.SecretProperties.ContentType"),
+			},
+			{
+				Name:        "lifetime_actions",
+				Description: "The actions performed by the vault over the lifetime of the certificate, with the trigger of each, for example the automatic renewal of the certificate a number of days before it expires.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getKeyVaultCertificate,
+				Transform:   transform.FromField("Policy.LifetimeActions"),
+			},
+			{
+				Name:        "subject_alternative_names",
+				Description: "The subject alternative names of the certificate, with the DNS names, the emails and the user principal names.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getKeyVaultCertificate,
+				Transform:   transform.FromField("Policy.X509CertificateProperties.SubjectAlternativeNames"),
+			},
+			{
+				Name:        "key_usage",
+				Description: "The allowed usages of the key of the certificate.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getKeyVaultCertificate,
+				Transform:   transform.FromField("Policy.X509CertificateProperties.KeyUsage"),
+			},
+			{
+				Name:        "extended_key_usage",
+				Description: "The enhanced key usages of the certificate.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getKeyVaultCertificate,
+				Transform:   transform.FromField("Policy.X509CertificateProperties.Ekus"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(extractVaultNameFromSecretID, "Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getTurbotData,
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getTurbotData,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getTurbotData,
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listKeyVaultCertificates(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get the details of key vault
+	vault := h.Item.(keyvault.Resource)
+
+	session, err := GetNewSession(ctx, d, "VAULT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_key_vault_certificate.listKeyVaultCertificates", "session_error", err)
+		return nil, err
+	}
+
+	vaultURI := "https://" + *vault.Name + ".vault.azure.net/"
+	maxResults := int32(25)
+
+	client := certificate.New()
+	client.Authorizer = session.Authorizer
+
+	result, err := client.GetCertificates(ctx, vaultURI, &maxResults, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_key_vault_certificate.listKeyVaultCertificates", "api_error", err)
+		return nil, err
+	}
+
+	for _, item := range result.Values() {
+		d.StreamLeafListItem(ctx, item)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_key_vault_certificate.listKeyVaultCertificates", "api_paging_error", err)
+			return nil, err
+		}
+
+		for _, item := range result.Values() {
+			d.StreamLeafListItem(ctx, item)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getKeyVaultCertificate(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var vaultName, name string
+	if h.Item != nil {
+		splitID := strings.Split(keyVaultSecretData(h.Item), "/")
+		vaultName = strings.Split(splitID[2], ".")[0]
+		name = splitID[4]
+	} else {
+		vaultName = d.EqualsQualString("vault_name")
+		name = d.EqualsQualString("name")
+	}
+
+	// Return nil, if no input provided
+	if vaultName == "" || name == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "VAULT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_key_vault_certificate.getKeyVaultCertificate", "session_error", err)
+		return nil, err
+	}
+
+	client := certificate.New()
+	client.Authorizer = session.Authorizer
+
+	vaultURI := "https://" + vaultName + ".vault.azure.net/"
+
+	// The current version of the certificate is returned with its policy
+	op, err := client.GetCertificate(ctx, vaultURI, name, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_key_vault_certificate.getKeyVaultCertificate", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,245 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/keyvault/mgmt/keyvault"
+	keyvaultdata "github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureKeyVaultDeletedSecret(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_key_vault_deleted_secret",
+		Description: "Azure Key Vault Deleted Secret",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"vault_name", "name"}),
+			Hydrate:    getKeyVaultDeletedSecret,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "SecretNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate:       listKeyVaultDeletedSecrets,
+			ParentHydrate: listKeyVaults,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The friendly name that identifies the deleted secret.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(extractVaultNameFromSecretID, "Name"),
+			},
+			{
+				Name:        "recovery_id",
+				Description: "The URL of the deleted secret, used to recover it.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RecoveryID"),
+			},
+			{
+				Name:        "secret_id",
+				Description: "The ID the secret had before it was deleted.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID"),
+			},
+			{
+				Name:        "vault_name",
+				Description: "The friendly name that identifies the vault.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(extractVaultNameFromSecretID, "VaultName"),
+			},
+			{
+				Name:        "deletion_date",
+				Description: "The time when the secret was deleted.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("DeletedDate").Transform(convertDateUnixToTime),
+			},
+			{
+				Name:        "scheduled_purge_date",
+				Description: "The time when the secret is scheduled to be purged. The secret can be recovered until then.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ScheduledPurgeDate").Transform(convertDateUnixToTime),
+			},
+			{
+				Name:        "enabled",
+				Description: "Indicates whether the secret was enabled when it was deleted.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Attributes.Enabled"),
+			},
+			{
+				Name:        "managed",
+				Description: "Indicates whether the lifetime of the secret was managed by key vault, which is the case of the secret backing a certificate.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "content_type",
+				Description: "Specifies the type of the secret value such as a password.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_at",
+				Description: "Specifies the time when the secret was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Attributes.Created").Transform(convertDateUnixToTime),
+			},
+			{
+				Name:        "updated_at",
+				Description: "Specifies the time when the secret was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Attributes.Updated").Transform(convertDateUnixToTime),
+			},
+			{
+				Name:        "expires_at",
+				Description: "Specifies the time when the secret expires.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Attributes.Expires").Transform(convertDateUnixToTime).Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "not_before",
+				Description: "Specifies the time before which the secret is not usable.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Attributes.NotBefore").Transform(convertDateUnixToTime),
+			},
+			{
+				Name:        "recoverable_days",
+				Description: "Specifies the soft delete data retention days. Value should be >=7 and <=90 when softDelete enabled, otherwise 0.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Attributes.RecoverableDays"),
+			},
+			{
+				Name:        "recovery_level",
+				Description: "The deletion recovery level in effect for the secret. If it contains 'Purgeable', then the secret can be permanently deleted by a privileged user; otherwise, only the system can purge the secret at the end of the retention interval.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Attributes.RecoveryLevel").Transform(transform.ToString),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(extractVaultNameFromSecretID, "Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getTurbotData,
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getTurbotData,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getTurbotData,
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listKeyVaultDeletedSecrets(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get the details of key vault
+	vault := h.Item.(keyvault.Resource)
+
+	session, err := GetNewSession(ctx, d, "VAULT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_key_vault_deleted_secret.listKeyVaultDeletedSecrets", "session_error", err)
+		return nil, err
+	}
+
+	vaultURI := "https://" + *vault.Name + ".vault.azure.net/"
+	maxResults := int32(25)
+
+	client := keyvaultdata.New()
+	client.Authorizer = session.Authorizer
+
+	result, err := client.GetDeletedSecrets(ctx, vaultURI, &maxResults)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_key_vault_deleted_secret.listKeyVaultDeletedSecrets", "api_error", err)
+		return nil, err
+	}
+
+	for _, item := range result.Values() {
+		d.StreamLeafListItem(ctx, item)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_key_vault_deleted_secret.listKeyVaultDeletedSecrets", "api_paging_error", err)
+			return nil, err
+		}
+
+		for _, item := range result.Values() {
+			d.StreamLeafListItem(ctx, item)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getKeyVaultDeletedSecret(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	vaultName := d.EqualsQualString("vault_name")
+	name := d.EqualsQualString("name")
+
+	// Return nil, if no input provided
+	if vaultName == "" || name == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "VAULT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_key_vault_deleted_secret.getKeyVaultDeletedSecret", "session_error", err)
+		return nil, err
+	}
+
+	client := keyvaultdata.New()
+	client.Authorizer = session.Authorizer
+
+	vaultURI := "https://" + vaultName + ".vault.azure.net/"
+
+	op, err := client.GetDeletedSecret(ctx, vaultURI, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_key_vault_deleted_secret.getKeyVaultDeletedSecret", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.RecoveryID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
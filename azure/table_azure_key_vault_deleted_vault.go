@@ -62,6 +62,12 @@ func tableAzureKeyVaultDeletedVault(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_TIMESTAMP,
 				Transform:   transform.FromField("Properties.ScheduledPurgeDate").Transform(convertDateToTime),
 			},
+			{
+				Name:        "purge_protection_enabled",
+				Description: "Indicates whether purge protection was enabled on the vault, in which case it cannot be purged before its scheduled purge date.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.PurgeProtectionEnabled"),
+			},
 
 			// Steampipe standard columns
 			{
@@ -133,6 +133,41 @@ func tableAzureKeyVaultKey(_ context.Context) *plugin.Table {
 				Hydrate:     getKeyVaultKey,
 				Transform:   transform.FromField("KeyProperties.KeyOps"),
 			},
+			{
+				Name:        "rotation_enabled",
+				Description: "Indicates whether the rotation policy of the key rotates it automatically.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getKeyVaultKey,
+				Transform:   transform.FromField("KeyProperties.RotationPolicy").Transform(keyVaultKeyRotationEnabled),
+			},
+			{
+				Name:        "rotation_time_after_create",
+				Description: "The time after the creation of a key version when the key is rotated, as an ISO 8601 duration, for example 'P90D'.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getKeyVaultKey,
+				Transform:   transform.FromField("KeyProperties.RotationPolicy").TransformP(keyVaultKeyRotationTrigger, "TimeAfterCreate"),
+			},
+			{
+				Name:        "rotation_time_before_expiry",
+				Description: "The time before the expiry of a key version when the key is rotated, as an ISO 8601 duration, for example 'P30D'.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getKeyVaultKey,
+				Transform:   transform.FromField("KeyProperties.RotationPolicy").TransformP(keyVaultKeyRotationTrigger, "TimeBeforeExpiry"),
+			},
+			{
+				Name:        "rotation_expiry_time",
+				Description: "The expiry time of the key versions created by the rotation policy, as an ISO 8601 duration, for example 'P2Y'.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getKeyVaultKey,
+				Transform:   transform.FromField("KeyProperties.RotationPolicy.Attributes.ExpiryTime"),
+			},
+			{
+				Name:        "rotation_policy",
+				Description: "The rotation policy of the key, with its lifetime actions.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getKeyVaultKey,
+				Transform:   transform.FromField("KeyProperties.RotationPolicy"),
+			},
 
 			// Steampipe standard columns
 			{
@@ -267,3 +302,38 @@ func extractVaultNameFromID(ctx context.Context, d *transform.TransformData) (in
 	vaultName := strings.Split(*data.ID, "/")[8]
 	return vaultName, nil
 }
+
+// The rotation policy of a key rotates it when one of its lifetime actions is a "rotate" action
+func keyVaultKeyRotationEnabled(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	return keyVaultKeyRotateAction(d.Value) != nil, nil
+}
+
+func keyVaultKeyRotationTrigger(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	action := keyVaultKeyRotateAction(d.Value)
+	if action == nil || action.Trigger == nil {
+		return nil, nil
+	}
+
+	switch d.Param.(string) {
+	case "TimeAfterCreate":
+		return action.Trigger.TimeAfterCreate, nil
+	case "TimeBeforeExpiry":
+		return action.Trigger.TimeBeforeExpiry, nil
+	}
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+func keyVaultKeyRotateAction(value interface{}) *keyvault.LifetimeAction {
+	policy, ok := value.(*keyvault.RotationPolicy)
+	if !ok || policy == nil || policy.LifetimeActions == nil {
+		return nil
+	}
+	for _, action := range *policy.LifetimeActions {
+		if action.Action != nil && strings.EqualFold(string(action.Action.Type), string(keyvault.Rotate)) {
+			return &action
+		}
+	}
+	return nil
+}
@@ -0,0 +1,279 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/keyvault/mgmt/keyvault"
+	hsm "github.com/Azure/azure-sdk-for-go/services/preview/keyvault/v7.2-preview/keyvault"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureKeyVaultManagedHsmKey(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_key_vault_managed_hsm_key",
+		Description: "Azure Key Vault Managed HSM Key",
+		List: &plugin.ListConfig{
+			Hydrate:       listKeyVaultManagedHsmKeys,
+			ParentHydrate: listKeyVaultManagedHardwareSecurityModules,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "hsm_name",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The friendly name that identifies the key.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the key, without its version.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Kid"),
+			},
+			{
+				Name:        "hsm_name",
+				Description: "The name of the managed HSM pool of the key.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "hsm_uri",
+				Description: "The URI of the managed HSM pool of the key.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HsmURI"),
+			},
+			{
+				Name:        "enabled",
+				Description: "Indicates whether the key is enabled, or not.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Attributes.Enabled"),
+			},
+			{
+				Name:        "managed",
+				Description: "Indicates whether the lifetime of the key is managed by the managed HSM pool, or not.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "exportable",
+				Description: "Indicates whether the private key can be exported from the managed HSM pool.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Attributes.Exportable"),
+			},
+			{
+				Name:        "key_type",
+				Description: "The type of the key. Possible values include: 'EC-HSM', 'RSA-HSM', 'oct-HSM'.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getKeyVaultManagedHsmKey,
+				Transform:   transform.FromField("Key.Kty").Transform(transform.ToString),
+			},
+			{
+				Name:        "curve_name",
+				Description: "The elliptic curve name of the key, if it is an elliptic curve key. Possible values include: 'P-256', 'P-384', 'P-521', 'P-256K'.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getKeyVaultManagedHsmKey,
+				Transform:   transform.FromField("Key.Crv").Transform(transform.ToString),
+			},
+			{
+				Name:        "created_at",
+				Description: "Specifies the time when the key is created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Attributes.Created").Transform(convertDateUnixToTime),
+			},
+			{
+				Name:        "updated_at",
+				Description: "Specifies the time when the key was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Attributes.Updated").Transform(convertDateUnixToTime),
+			},
+			{
+				Name:        "expires_at",
+				Description: "Specifies the time when the key expires.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Attributes.Expires").Transform(convertDateUnixToTime).Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "not_before",
+				Description: "Specifies the time before which the key is not usable.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Attributes.NotBefore").Transform(convertDateUnixToTime),
+			},
+			{
+				Name:        "recoverable_days",
+				Description: "Specifies the soft delete data retention days. Value should be >=7 and <=90 when softDelete enabled, otherwise 0.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Attributes.RecoverableDays"),
+			},
+			{
+				Name:        "recovery_level",
+				Description: "The deletion recovery level currently in effect for the key. If it contains 'Purgeable', then the key can be permanently deleted by a privileged user; otherwise, only the system can purge the key at the end of the retention interval.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Attributes.RecoveryLevel").Transform(transform.ToString),
+			},
+			{
+				Name:        "key_ops",
+				Description: "The operations allowed with the key, for example 'encrypt', 'sign' or 'wrapKey'.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getKeyVaultManagedHsmKey,
+				Transform:   transform.FromField("Key.KeyOps"),
+			},
+			{
+				Name:        "release_policy",
+				Description: "The policy rules under which the key can be exported.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getKeyVaultManagedHsmKey,
+				Transform:   transform.FromField("ReleasePolicy"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ResourceID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type ManagedHsmKeyInfo struct {
+	Name       string
+	HsmName    string
+	HsmURI     string
+	ResourceID string
+	Location   *string
+	hsm.KeyItem
+}
+
+//// LIST FUNCTION
+
+func listKeyVaultManagedHsmKeys(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	pool := h.Item.(keyvault.ManagedHsm)
+
+	if hsmName := d.EqualsQualString("hsm_name"); hsmName != "" && !strings.EqualFold(hsmName, *pool.Name) {
+		return nil, nil
+	}
+
+	// The data plane of a pool is only reachable once it has been activated
+	if pool.Properties == nil || pool.Properties.HsmURI == nil {
+		return nil, nil
+	}
+	hsmURI := *pool.Properties.HsmURI
+
+	session, err := GetNewSession(ctx, d, "MANAGEDHSM")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_key_vault_managed_hsm_key.listKeyVaultManagedHsmKeys", "session_error", err)
+		return nil, err
+	}
+
+	client := hsm.New()
+	client.Authorizer = session.Authorizer
+	maxResults := int32(25)
+
+	result, err := client.GetKeys(ctx, hsmURI, &maxResults)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_key_vault_managed_hsm_key.listKeyVaultManagedHsmKeys", "api_error", err)
+		return nil, err
+	}
+
+	for _, key := range result.Values() {
+		d.StreamLeafListItem(ctx, managedHsmKeyInfo(pool, key))
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_key_vault_managed_hsm_key.listKeyVaultManagedHsmKeys", "api_paging_error", err)
+			return nil, err
+		}
+		for _, key := range result.Values() {
+			d.StreamLeafListItem(ctx, managedHsmKeyInfo(pool, key))
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getKeyVaultManagedHsmKey(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	key := h.Item.(ManagedHsmKeyInfo)
+
+	session, err := GetNewSession(ctx, d, "MANAGEDHSM")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_key_vault_managed_hsm_key.getKeyVaultManagedHsmKey", "session_error", err)
+		return nil, err
+	}
+
+	client := hsm.New()
+	client.Authorizer = session.Authorizer
+
+	// The current version of the key is returned
+	op, err := client.GetKey(ctx, key.HsmURI, key.Name, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_key_vault_managed_hsm_key.getKeyVaultManagedHsmKey", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// The ID of a key is https://{pool}.managedhsm.azure.net/keys/{name}
+func managedHsmKeyInfo(pool keyvault.ManagedHsm, key hsm.KeyItem) ManagedHsmKeyInfo {
+	name := ""
+	if key.Kid != nil {
+		name = getLastPathElement(*key.Kid)
+	}
+	return ManagedHsmKeyInfo{
+		Name:       name,
+		HsmName:    *pool.Name,
+		HsmURI:     *pool.Properties.HsmURI,
+		ResourceID: *pool.ID + "/keys/" + name,
+		Location:   pool.Location,
+		KeyItem:    key,
+	}
+}
@@ -0,0 +1,265 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/keyvault/mgmt/keyvault"
+	hsm "github.com/Azure/azure-sdk-for-go/services/preview/keyvault/v7.2-preview/keyvault"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/memoize"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureKeyVaultManagedHsmRoleAssignment(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_key_vault_managed_hsm_role_assignment",
+		Description: "Azure Key Vault Managed HSM Role Assignment, the local role assignments of the data plane of the managed HSM pools.",
+		List: &plugin.ListConfig{
+			Hydrate:       listKeyVaultManagedHsmRoleAssignments,
+			ParentHydrate: listKeyVaultManagedHardwareSecurityModules,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "hsm_name",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the role assignment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the role assignment in the managed HSM pool.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the role assignment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "hsm_name",
+				Description: "The name of the managed HSM pool of the role assignment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "scope",
+				Description: "The scope of the role assignment, '/' for all the keys of the pool, '/keys' for the keys, or '/keys/{name}' for a single key.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Scope"),
+			},
+			{
+				Name:        "principal_id",
+				Description: "The ID of the principal the role is assigned to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.PrincipalID"),
+			},
+			{
+				Name:        "role_definition_id",
+				Description: "The ID of the role definition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.RoleDefinitionID"),
+			},
+			{
+				Name:        "role_name",
+				Description: "The name of the role definition, for example 'Managed HSM Administrator' or 'Managed HSM Crypto User'.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getKeyVaultManagedHsmRoleDefinition,
+				Transform:   transform.FromField("RoleDefinitionProperties.RoleName"),
+			},
+			{
+				Name:        "role_type",
+				Description: "The type of the role definition, 'AKVBuiltInRole' for the built-in roles or 'CustomRole'.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getKeyVaultManagedHsmRoleDefinition,
+				Transform:   transform.FromField("RoleDefinitionProperties.RoleType"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ResourceID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type ManagedHsmRoleAssignmentInfo struct {
+	HsmName    string
+	HsmURI     string
+	ResourceID string
+	Location   *string
+	hsm.RoleAssignment
+}
+
+//// LIST FUNCTION
+
+func listKeyVaultManagedHsmRoleAssignments(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	pool := h.Item.(keyvault.ManagedHsm)
+
+	if hsmName := d.EqualsQualString("hsm_name"); hsmName != "" && !strings.EqualFold(hsmName, *pool.Name) {
+		return nil, nil
+	}
+
+	// The data plane of a pool is only reachable once it has been activated
+	if pool.Properties == nil || pool.Properties.HsmURI == nil {
+		return nil, nil
+	}
+	hsmURI := *pool.Properties.HsmURI
+
+	session, err := GetNewSession(ctx, d, "MANAGEDHSM")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_key_vault_managed_hsm_role_assignment.listKeyVaultManagedHsmRoleAssignments", "session_error", err)
+		return nil, err
+	}
+
+	client := hsm.NewRoleAssignmentsClient()
+	client.Authorizer = session.Authorizer
+
+	// The role assignments at the scope of the pool include the ones of its keys
+	result, err := client.ListForScope(ctx, hsmURI, "/", "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_key_vault_managed_hsm_role_assignment.listKeyVaultManagedHsmRoleAssignments", "api_error", err)
+		return nil, err
+	}
+
+	for _, assignment := range result.Values() {
+		d.StreamLeafListItem(ctx, managedHsmRoleAssignmentInfo(pool, assignment))
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_key_vault_managed_hsm_role_assignment.listKeyVaultManagedHsmRoleAssignments", "api_paging_error", err)
+			return nil, err
+		}
+		for _, assignment := range result.Values() {
+			d.StreamLeafListItem(ctx, managedHsmRoleAssignmentInfo(pool, assignment))
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getKeyVaultManagedHsmRoleDefinition(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	assignment := h.Item.(ManagedHsmRoleAssignmentInfo)
+	if assignment.Properties == nil || assignment.Properties.RoleDefinitionID == nil {
+		return nil, nil
+	}
+
+	definitions, err := listKeyVaultManagedHsmRoleDefinitions(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, definition := range definitions.([]hsm.RoleDefinition) {
+		if definition.ID != nil && strings.EqualFold(*definition.ID, *assignment.Properties.RoleDefinitionID) {
+			return definition, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// if the caching is required other than per connection, build a cache key for the call and use it in Memoize.
+var listKeyVaultManagedHsmRoleDefinitionsMemoized = plugin.HydrateFunc(listKeyVaultManagedHsmRoleDefinitionsUncached).Memoize(memoize.WithCacheKeyFunction(listKeyVaultManagedHsmRoleDefinitionsCacheKey))
+
+// The role definitions are listed once per pool, and shared by its role assignments
+func listKeyVaultManagedHsmRoleDefinitions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return listKeyVaultManagedHsmRoleDefinitionsMemoized(ctx, d, h)
+}
+
+func listKeyVaultManagedHsmRoleDefinitionsCacheKey(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	key := "listKeyVaultManagedHsmRoleDefinitions/" + h.Item.(ManagedHsmRoleAssignmentInfo).HsmURI
+	return key, nil
+}
+
+func listKeyVaultManagedHsmRoleDefinitionsUncached(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	hsmURI := h.Item.(ManagedHsmRoleAssignmentInfo).HsmURI
+
+	session, err := GetNewSession(ctx, d, "MANAGEDHSM")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_key_vault_managed_hsm_role_assignment.listKeyVaultManagedHsmRoleDefinitions", "session_error", err)
+		return nil, err
+	}
+
+	client := hsm.NewRoleDefinitionsClient()
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListComplete(ctx, hsmURI, "/", "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_key_vault_managed_hsm_role_assignment.listKeyVaultManagedHsmRoleDefinitions", "api_error", err)
+		return nil, err
+	}
+
+	definitions := []hsm.RoleDefinition{}
+	for result.NotDone() {
+		definitions = append(definitions, result.Value())
+		if err := result.NextWithContext(ctx); err != nil {
+			plugin.Logger(ctx).Error("azure_key_vault_managed_hsm_role_assignment.listKeyVaultManagedHsmRoleDefinitions", "api_paging_error", err)
+			return nil, err
+		}
+	}
+
+	return definitions, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// The ID of a role assignment is relative to the pool, for example
+// /providers/Microsoft.Authorization/roleAssignments/{name}
+func managedHsmRoleAssignmentInfo(pool keyvault.ManagedHsm, assignment hsm.RoleAssignment) ManagedHsmRoleAssignmentInfo {
+	resourceID := *pool.ID
+	if assignment.ID != nil {
+		resourceID += *assignment.ID
+	}
+	return ManagedHsmRoleAssignmentInfo{
+		HsmName:        *pool.Name,
+		HsmURI:         *pool.Properties.HsmURI,
+		ResourceID:     resourceID,
+		Location:       pool.Location,
+		RoleAssignment: assignment,
+	}
+}
@@ -281,7 +281,8 @@ func getTurbotData(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 		}
 	}
 	splitVaultID := strings.Split(vaultID, "/")
-	akas := []string{"azure:///subscriptions/" + subscriptionID + "/resourceGroups/" + splitVaultID[4] + "/providers/Microsoft.KeyVault/vaults/" + vaultName + "/secrets/" + splitID[4], "azure:///subscriptions/" + subscriptionID + "/resourcegroups/" + splitVaultID[4] + "/providers/microsoft.keyvault/vaults/" + vaultName + "/secrets/" + splitID[4]}
+	// The collection of the object, for example "secrets" or "deletedkeys", is the segment before its name
+	akas := []string{"azure:///subscriptions/" + subscriptionID + "/resourceGroups/" + splitVaultID[4] + "/providers/Microsoft.KeyVault/vaults/" + vaultName + "/" + splitID[3] + "/" + splitID[4], "azure:///subscriptions/" + subscriptionID + "/resourcegroups/" + splitVaultID[4] + "/providers/microsoft.keyvault/vaults/" + vaultName + "/" + splitID[3] + "/" + splitID[4]}
 
 	turbotData := map[string]interface{}{
 		"SubscriptionId": subscriptionID,
@@ -315,6 +316,24 @@ func keyVaultSecretData(item interface{}) string {
 		return *item.ID
 	case secret.SecretBundle:
 		return *item.ID
+	case secret.CertificateItem:
+		return *item.ID
+	case secret.CertificateBundle:
+		return *item.ID
+	// The deleted objects are identified by their recovery ID, as their ID is the one of the object
+	// before it was deleted
+	case secret.DeletedKeyItem:
+		return *item.RecoveryID
+	case secret.DeletedKeyBundle:
+		return *item.RecoveryID
+	case secret.DeletedSecretItem:
+		return *item.RecoveryID
+	case secret.DeletedSecretBundle:
+		return *item.RecoveryID
+	case secret.DeletedCertificateItem:
+		return *item.RecoveryID
+	case secret.DeletedCertificateBundle:
+		return *item.RecoveryID
 	}
 	return ""
 }
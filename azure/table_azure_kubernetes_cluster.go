@@ -2,8 +2,10 @@ package azure
 
 import (
 	"context"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/containerservice/mgmt/containerservice"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 
@@ -158,6 +160,24 @@ func tableAzureKubernetesCluster(_ context.Context) *plugin.Table {
 				Description: "The identity of the managed cluster, if configured.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "identity_type",
+				Description: "The type of managed identity used by the resource. Possible values include: 'SystemAssigned', 'UserAssigned', 'SystemAssigned, UserAssigned', 'None'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityType),
+			},
+			{
+				Name:        "system_assigned_principal_id",
+				Description: "The principal ID of the system-assigned managed identity of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityPrincipalID),
+			},
+			{
+				Name:        "user_assigned_identity_ids",
+				Description: "The resource IDs of the user-assigned managed identities associated with the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityUserAssignedIDs),
+			},
 			{
 				Name:        "identity_profile",
 				Description: "Identities associated with the cluster.",
@@ -205,6 +225,34 @@ func tableAzureKubernetesCluster(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("ManagedClusterProperties.WindowsProfile"),
 			},
+			{
+				Name:        "kube_audit_log_enabled",
+				Description: "Indicates whether the kube-audit log category is enabled by any diagnostic setting of the cluster.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     listKubernetesClusterDiagnosticSettings,
+				Transform:   transform.FromValue().Transform(isKubernetesClusterLogCategoryEnabled),
+			},
+			{
+				Name:        "kube_audit_admin_log_enabled",
+				Description: "Indicates whether the kube-audit-admin log category is enabled by any diagnostic setting of the cluster.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     listKubernetesClusterDiagnosticSettings,
+				Transform:   transform.FromValue().Transform(isKubernetesClusterLogCategoryEnabled),
+			},
+			{
+				Name:        "guard_log_enabled",
+				Description: "Indicates whether the guard log category is enabled by any diagnostic setting of the cluster.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     listKubernetesClusterDiagnosticSettings,
+				Transform:   transform.FromValue().Transform(isKubernetesClusterLogCategoryEnabled),
+			},
+			{
+				Name:        "diagnostic_settings",
+				Description: "A list of active diagnostic settings for the cluster.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listKubernetesClusterDiagnosticSettings,
+				Transform:   transform.FromValue(),
+			},
 
 			// Steampipe standard columns
 			{
@@ -316,3 +364,64 @@ func getKubernetesCluster(ctx context.Context, d *plugin.QueryData, _ *plugin.Hy
 
 	return nil, nil
 }
+
+func listKubernetesClusterDiagnosticSettings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	cluster := h.Item.(containerservice.ManagedCluster)
+
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_kubernetes_cluster.listKubernetesClusterDiagnosticSettings", "session_error", err)
+		return nil, err
+	}
+
+	client, err := armmonitor.NewDiagnosticSettingsClient(session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_kubernetes_cluster.listKubernetesClusterDiagnosticSettings", "client_error", err)
+		return nil, err
+	}
+
+	var settings []*armmonitor.DiagnosticSettingsResource
+	pager := client.NewListPager(*cluster.ID, nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_kubernetes_cluster.listKubernetesClusterDiagnosticSettings", "api_paging_error", err)
+			return nil, err
+		}
+		settings = append(settings, page.Value...)
+	}
+
+	return settings, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// The log category is derived from the column name. A category is also enabled
+// when a setting enables the "audit" or "allLogs" category group, both of which
+// include kube-audit, kube-audit-admin and guard.
+func isKubernetesClusterLogCategoryEnabled(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	settings, ok := d.Value.([]*armmonitor.DiagnosticSettingsResource)
+	if !ok {
+		return false, nil
+	}
+
+	category := strings.ReplaceAll(strings.TrimSuffix(d.ColumnName, "_log_enabled"), "_", "-")
+	for _, setting := range settings {
+		if setting.Properties == nil {
+			continue
+		}
+		for _, log := range setting.Properties.Logs {
+			if log.Enabled == nil || !*log.Enabled {
+				continue
+			}
+			if log.Category != nil && strings.EqualFold(*log.Category, category) {
+				return true, nil
+			}
+			if log.CategoryGroup != nil && (strings.EqualFold(*log.CategoryGroup, "audit") || strings.EqualFold(*log.CategoryGroup, "allLogs")) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,360 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/labservices/mgmt/2021-11-15-preview/labservices"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureLabServicesLab(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_lab_services_lab",
+		Description: "Azure Lab Services Lab",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getLabServicesLab,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listLabServicesLabs,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the lab.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the lab.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the lab.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lab_title",
+				Description: "The title of the lab.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabProperties.Title"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the lab.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabProperties.Description"),
+			},
+			{
+				Name:        "state",
+				Description: "The state of the lab. Possible values include: 'Draft', 'Publishing', 'Scaling', 'Syncing', 'Published'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabProperties.State"),
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the lab.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabProperties.ProvisioningState"),
+			},
+			{
+				Name:        "lab_plan_id",
+				Description: "The resource ID of the lab plan the lab was created from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabProperties.LabPlanID"),
+			},
+			{
+				Name:        "shutdown_on_disconnect",
+				Description: "Indicates whether the virtual machines are shut down when the user disconnects. Possible values include: 'Enabled', 'Disabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabProperties.AutoShutdownProfile.ShutdownOnDisconnect"),
+			},
+			{
+				Name:        "disconnect_delay",
+				Description: "The time after disconnection before the virtual machines are shut down, as an ISO 8601 duration.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabProperties.AutoShutdownProfile.DisconnectDelay"),
+			},
+			{
+				Name:        "shutdown_when_not_connected",
+				Description: "Indicates whether the virtual machines are shut down when nobody connects to them after they started. Possible values include: 'Enabled', 'Disabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabProperties.AutoShutdownProfile.ShutdownWhenNotConnected"),
+			},
+			{
+				Name:        "no_connect_delay",
+				Description: "The time after start before the virtual machines are shut down if nobody connects, as an ISO 8601 duration.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabProperties.AutoShutdownProfile.NoConnectDelay"),
+			},
+			{
+				Name:        "shutdown_on_idle",
+				Description: "The idle detection mode used to shut down the virtual machines. Possible values include: 'None', 'UserAbsence', 'LowUsage'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabProperties.AutoShutdownProfile.ShutdownOnIdle"),
+			},
+			{
+				Name:        "idle_delay",
+				Description: "The idle time before the virtual machines are shut down, as an ISO 8601 duration.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabProperties.AutoShutdownProfile.IdleDelay"),
+			},
+			{
+				Name:        "os_type",
+				Description: "The operating system type of the lab virtual machines. Possible values include: 'Windows', 'Linux'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabProperties.VirtualMachineProfile.OsType"),
+			},
+			{
+				Name:        "sku_name",
+				Description: "The SKU of the lab virtual machines.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabProperties.VirtualMachineProfile.Sku.Name"),
+			},
+			{
+				Name:        "capacity",
+				Description: "The number of virtual machines of the lab.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("LabProperties.VirtualMachineProfile.Sku.Capacity"),
+			},
+			{
+				Name:        "virtual_machine_count",
+				Description: "The number of virtual machines of the lab.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     countLabServicesLabVirtualMachines,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "usage_quota",
+				Description: "The initial quota of hours allocated to each lab user, as an ISO 8601 duration.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabProperties.VirtualMachineProfile.UsageQuota"),
+			},
+			{
+				Name:        "create_option",
+				Description: "Indicates whether the lab uses a template virtual machine. Possible values include: 'Image', 'TemplateVM'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabProperties.VirtualMachineProfile.CreateOption"),
+			},
+			{
+				Name:        "image_reference",
+				Description: "The image the lab virtual machines are created from.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LabProperties.VirtualMachineProfile.ImageReference"),
+			},
+			{
+				Name:        "use_shared_password",
+				Description: "Indicates whether all lab users share the same password. Possible values include: 'Enabled', 'Disabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabProperties.VirtualMachineProfile.UseSharedPassword"),
+			},
+			{
+				Name:        "admin_username",
+				Description: "The user name of the administrator account of the lab virtual machines.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabProperties.VirtualMachineProfile.AdminUser.Username"),
+			},
+			{
+				Name:        "install_gpu_drivers",
+				Description: "Indicates whether GPU drivers are installed on the lab virtual machines. Possible values include: 'Enabled', 'Disabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabProperties.VirtualMachineProfile.AdditionalCapabilities.InstallGpuDrivers"),
+			},
+			{
+				Name:        "open_access",
+				Description: "Indicates whether any user can register to the lab with the registration code. Possible values include: 'Enabled', 'Disabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabProperties.SecurityProfile.OpenAccess"),
+			},
+			{
+				Name:        "connection_profile",
+				Description: "The ways lab users can connect to the lab virtual machines, such as client or browser RDP and SSH.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LabProperties.ConnectionProfile"),
+			},
+			{
+				Name:        "network_profile",
+				Description: "The subnet, load balancer and public IP address used by the lab.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LabProperties.NetworkProfile"),
+			},
+			{
+				Name:        "roster_profile",
+				Description: "The source of the users of the lab, either an Azure AD group or a learning management system.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LabProperties.RosterProfile"),
+			},
+			{
+				Name:        "created_at",
+				Description: "The timestamp of resource creation (UTC).",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("SystemData.CreatedAt").Transform(convertDateToTime),
+			},
+			{
+				Name:        "created_by",
+				Description: "The identity that created the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SystemData.CreatedBy"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listLabServicesLabs(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_lab_services_lab.listLabServicesLabs", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := labservices.NewLabsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListBySubscription(ctx, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_lab_services_lab.listLabServicesLabs", "api_error", err)
+		return nil, err
+	}
+
+	for _, lab := range result.Values() {
+		d.StreamListItem(ctx, lab)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_lab_services_lab.listLabServicesLabs", "api_paging_error", err)
+			return nil, err
+		}
+		for _, lab := range result.Values() {
+			d.StreamListItem(ctx, lab)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getLabServicesLab(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_lab_services_lab.getLabServicesLab", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := labservices.NewLabsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_lab_services_lab.getLabServicesLab", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+func countLabServicesLabVirtualMachines(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	lab := h.Item.(labservices.Lab)
+	resourceGroup := strings.Split(*lab.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_lab_services_lab.countLabServicesLabVirtualMachines", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := labservices.NewVirtualMachinesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListByLab(ctx, resourceGroup, *lab.Name, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_lab_services_lab.countLabServicesLabVirtualMachines", "api_error", err)
+		return nil, err
+	}
+
+	count := len(result.Values())
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_lab_services_lab.countLabServicesLabVirtualMachines", "api_paging_error", err)
+			return nil, err
+		}
+		count += len(result.Values())
+	}
+
+	return count, nil
+}
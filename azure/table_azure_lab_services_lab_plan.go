@@ -0,0 +1,241 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/labservices/mgmt/2021-11-15-preview/labservices"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureLabServicesLabPlan(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_lab_services_lab_plan",
+		Description: "Azure Lab Services Lab Plan",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getLabServicesLabPlan,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listLabServicesLabPlans,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the lab plan.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the lab plan.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the lab plan.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The current provisioning state of the lab plan. Possible values include: 'Creating', 'Updating', 'Deleting', 'Succeeded', 'Failed', 'Locked'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabPlanProperties.ProvisioningState"),
+			},
+			{
+				Name:        "allowed_regions",
+				Description: "The regions the labs of the lab plan can be created in.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LabPlanProperties.AllowedRegions"),
+			},
+			{
+				Name:        "shared_gallery_id",
+				Description: "The resource ID of the Shared Image Gallery attached to the lab plan, whose images can be used to create the labs.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabPlanProperties.SharedGalleryID"),
+			},
+			{
+				Name:        "linked_lms_instance",
+				Description: "The base URL of the learning management system instance the lab plan is linked to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabPlanProperties.LinkedLmsInstance"),
+			},
+			{
+				Name:        "subnet_id",
+				Description: "The resource ID of the subnet the virtual machines of the labs are injected into.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabPlanProperties.DefaultNetworkProfile.SubnetID"),
+			},
+			{
+				Name:        "shutdown_on_disconnect",
+				Description: "Indicates whether the virtual machines of the labs are shut down by default when the user disconnects. Possible values include: 'Enabled', 'Disabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabPlanProperties.DefaultAutoShutdownProfile.ShutdownOnDisconnect"),
+			},
+			{
+				Name:        "shutdown_when_not_connected",
+				Description: "Indicates whether the virtual machines of the labs are shut down by default when the user never connects. Possible values include: 'Enabled', 'Disabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabPlanProperties.DefaultAutoShutdownProfile.ShutdownWhenNotConnected"),
+			},
+			{
+				Name:        "shutdown_on_idle",
+				Description: "The default idle detection mode of the virtual machines of the labs. Possible values include: 'None', 'UserAbsence', 'LowUsage'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LabPlanProperties.DefaultAutoShutdownProfile.ShutdownOnIdle"),
+			},
+			{
+				Name:        "default_auto_shutdown_profile",
+				Description: "The default auto-shutdown settings of the labs created from the lab plan.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LabPlanProperties.DefaultAutoShutdownProfile"),
+			},
+			{
+				Name:        "default_connection_profile",
+				Description: "The default connection settings of the labs created from the lab plan, for RDP and SSH access from the browser and the client.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LabPlanProperties.DefaultConnectionProfile"),
+			},
+			{
+				Name:        "support_info",
+				Description: "The support contact information shown to the users of the labs.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LabPlanProperties.SupportInfo"),
+			},
+			{
+				Name:        "created_at",
+				Description: "The timestamp of resource creation (UTC).",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("SystemData.CreatedAt").Transform(convertDateToTime),
+			},
+			{
+				Name:        "created_by",
+				Description: "The identity that created the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SystemData.CreatedBy"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listLabServicesLabPlans(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_lab_services_lab_plan.listLabServicesLabPlans", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := labservices.NewLabPlansClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListBySubscription(ctx, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_lab_services_lab_plan.listLabServicesLabPlans", "api_error", err)
+		return nil, err
+	}
+
+	for _, labPlan := range result.Values() {
+		d.StreamListItem(ctx, labPlan)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_lab_services_lab_plan.listLabServicesLabPlans", "api_paging_error", err)
+			return nil, err
+		}
+		for _, labPlan := range result.Values() {
+			d.StreamListItem(ctx, labPlan)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getLabServicesLabPlan(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_lab_services_lab_plan.getLabServicesLabPlan", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := labservices.NewLabPlansClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_lab_services_lab_plan.getLabServicesLabPlan", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,235 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/labservices/mgmt/2021-11-15-preview/labservices"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureLabServicesVirtualMachine(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_lab_services_virtual_machine",
+		Description: "Azure Lab Services Virtual Machine",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"lab_name", "name", "resource_group"}),
+			Hydrate:    getLabServicesVirtualMachine,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listLabServicesLabs,
+			Hydrate:       listLabServicesVirtualMachines,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the virtual machine.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the virtual machine.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the virtual machine.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lab_name",
+				Description: "The name of the lab the virtual machine belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractLabServicesLabNameFromID),
+			},
+			{
+				Name:        "state",
+				Description: "The state of the virtual machine. Possible values include: 'Stopped', 'Starting', 'Running', 'Stopping', 'ResettingPassword', 'Reimaging', 'Redeploying'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualMachineProperties.State"),
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the virtual machine.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualMachineProperties.ProvisioningState"),
+			},
+			{
+				Name:        "vm_type",
+				Description: "The type of the virtual machine. Possible values include: 'User', 'Template'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualMachineProperties.VMType"),
+			},
+			{
+				Name:        "claimed_by_user_id",
+				Description: "The ID of the lab user who claimed the virtual machine.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualMachineProperties.ClaimedByUserID"),
+			},
+			{
+				Name:        "private_ip_address",
+				Description: "The private IP address of the virtual machine.",
+				Type:        proto.ColumnType_IPADDR,
+				Transform:   transform.FromField("VirtualMachineProperties.ConnectionProfile.PrivateIPAddress"),
+			},
+			{
+				Name:        "admin_username",
+				Description: "The user name of the administrator account of the virtual machine.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualMachineProperties.ConnectionProfile.AdminUsername"),
+			},
+			{
+				Name:        "non_admin_username",
+				Description: "The user name of the non-administrator account of the virtual machine.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualMachineProperties.ConnectionProfile.NonAdminUsername"),
+			},
+			{
+				Name:        "rdp_authority",
+				Description: "The host and port to connect to the virtual machine with an RDP client.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualMachineProperties.ConnectionProfile.RdpAuthority"),
+			},
+			{
+				Name:        "ssh_authority",
+				Description: "The host and port to connect to the virtual machine with an SSH client.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualMachineProperties.ConnectionProfile.SSHAuthority"),
+			},
+			{
+				Name:        "created_at",
+				Description: "The timestamp of resource creation (UTC).",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("SystemData.CreatedAt").Transform(convertDateToTime),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listLabServicesVirtualMachines(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	lab := h.Item.(labservices.Lab)
+	resourceGroup := strings.Split(*lab.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_lab_services_virtual_machine.listLabServicesVirtualMachines", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := labservices.NewVirtualMachinesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListByLab(ctx, resourceGroup, *lab.Name, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_lab_services_virtual_machine.listLabServicesVirtualMachines", "api_error", err)
+		return nil, err
+	}
+
+	for _, vm := range result.Values() {
+		d.StreamListItem(ctx, vm)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_lab_services_virtual_machine.listLabServicesVirtualMachines", "api_paging_error", err)
+			return nil, err
+		}
+		for _, vm := range result.Values() {
+			d.StreamListItem(ctx, vm)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getLabServicesVirtualMachine(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	labName := d.EqualsQualString("lab_name")
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if labName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_lab_services_virtual_machine.getLabServicesVirtualMachine", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := labservices.NewVirtualMachinesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, labName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_lab_services_virtual_machine.getLabServicesVirtualMachine", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func extractLabServicesLabNameFromID(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	segments := strings.Split(types.SafeString(d.Value), "/")
+	if len(segments) < 9 {
+		return nil, nil
+	}
+	return segments[8], nil
+}
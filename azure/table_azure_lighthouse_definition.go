@@ -81,6 +81,12 @@ func tableAzureLighthouseDefinition(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Properties.RegistrationDefinitionName"),
 			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the registration definition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ProvisioningState"),
+			},
 			{
 				Name:        "managed_by_tenant_id",
 				Description: "ID of the managedBy tenant.",
@@ -6,6 +6,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/machinelearningservices/mgmt/machinelearningservices"
 	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/monitor/mgmt/insights"
 
+	"github.com/turbot/go-kit/types"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 
@@ -147,11 +148,41 @@ func tableAzureMachineLearningWorkspace(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("WorkspaceProperties.Encryption"),
 			},
+			{
+				Name:        "customer_managed_key_enabled",
+				Description: "Indicates whether the workspace is encrypted with a customer-managed key stored in Azure Key Vault.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromP(extractMachineLearningWorkspaceCustomerManagedKey, "enabled"),
+			},
+			{
+				Name:        "customer_managed_key_uri",
+				Description: "The URI of the Key Vault key used to encrypt the workspace.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(extractMachineLearningWorkspaceCustomerManagedKey, "uri"),
+			},
 			{
 				Name:        "identity",
 				Description: "The identity of the resource.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "identity_type",
+				Description: "The type of managed identity used by the resource. Possible values include: 'SystemAssigned', 'UserAssigned', 'SystemAssigned, UserAssigned', 'None'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityType),
+			},
+			{
+				Name:        "system_assigned_principal_id",
+				Description: "The principal ID of the system-assigned managed identity of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityPrincipalID),
+			},
+			{
+				Name:        "user_assigned_identity_ids",
+				Description: "The resource IDs of the user-assigned managed identities associated with the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityUserAssignedIDs),
+			},
 
 			// Steampipe standard columns
 			{
@@ -298,3 +329,26 @@ func listMachineLearningWorkspaceDiagnosticSettings(ctx context.Context, d *plug
 	}
 	return diagnosticSettings, nil
 }
+
+//// TRANSFORM FUNCTIONS
+
+func extractMachineLearningWorkspaceCustomerManagedKey(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	workspace := d.HydrateItem.(machinelearningservices.Workspace)
+
+	var enabled bool
+	var keyURI string
+	if workspace.WorkspaceProperties != nil && workspace.Encryption != nil {
+		enabled = workspace.Encryption.Status == machinelearningservices.EncryptionStatusEnabled
+		if enabled && workspace.Encryption.KeyVaultProperties != nil {
+			keyURI = types.SafeString(workspace.Encryption.KeyVaultProperties.KeyIdentifier)
+		}
+	}
+
+	if d.Param.(string) == "enabled" {
+		return enabled, nil
+	}
+	if keyURI == "" {
+		return nil, nil
+	}
+	return keyURI, nil
+}
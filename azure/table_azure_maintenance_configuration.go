@@ -4,7 +4,7 @@ import (
 	"context"
 	"reflect"
 
-	"github.com/Azure/azure-sdk-for-go/profiles/latest/maintenance/mgmt/maintenance"
+	"github.com/Azure/azure-sdk-for-go/services/preview/maintenance/mgmt/2022-07-01-preview/maintenance"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 
@@ -110,6 +110,18 @@ func tableAzureMaintenanceConfiguration(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("ConfigurationProperties.Window"),
 			},
+			{
+				Name:        "reboot_setting",
+				Description: "The reboot setting of the patches installed by an InGuestPatch maintenance configuration. Possible values include: 'IfRequired', 'Never', 'Always'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConfigurationProperties.InstallPatches.RebootSetting"),
+			},
+			{
+				Name:        "install_patches",
+				Description: "The patches installed by an InGuestPatch maintenance configuration, with the Windows and Linux classifications and the pre and post tasks.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ConfigurationProperties.InstallPatches"),
+			},
 			{
 				Name:        "system_data",
 				Description: "Azure Resource Manager metadata containing createdBy and modifiedBy information.",
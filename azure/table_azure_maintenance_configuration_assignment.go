@@ -0,0 +1,156 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/maintenance/mgmt/2022-07-01-preview/maintenance"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureMaintenanceConfigurationAssignment(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_maintenance_configuration_assignment",
+		Description: "Azure Maintenance Configuration Assignment",
+		List: &plugin.ListConfig{
+			Hydrate: listMaintenanceConfigurationAssignments,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the configuration assignment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the configuration assignment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the configuration assignment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "maintenance_configuration_id",
+				Description: "The ID of the maintenance configuration assigned to the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConfigurationAssignmentProperties.MaintenanceConfigurationID"),
+			},
+			{
+				Name:        "maintenance_configuration_name",
+				Description: "The name of the maintenance configuration assigned to the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConfigurationAssignmentProperties.MaintenanceConfigurationID").Transform(lastPathElement),
+			},
+			{
+				Name:        "resource_id",
+				Description: "The ID of the resource the maintenance configuration is assigned to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConfigurationAssignmentProperties.ResourceID"),
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the resource the maintenance configuration is assigned to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConfigurationAssignmentProperties.ResourceID").Transform(extractMaintenanceAssignmentResourceType),
+			},
+			{
+				Name:        "created_at",
+				Description: "The timestamp of resource creation (UTC).",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("SystemData.CreatedAt").Transform(convertDateToTime),
+			},
+			{
+				Name:        "created_by",
+				Description: "The identity that created the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SystemData.CreatedBy"),
+			},
+			{
+				Name:        "last_modified_at",
+				Description: "The timestamp of resource last modification (UTC).",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("SystemData.LastModifiedAt").Transform(convertDateToTime),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listMaintenanceConfigurationAssignments(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_maintenance_configuration_assignment.listMaintenanceConfigurationAssignments", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := maintenance.NewConfigurationAssignmentsWithinSubscriptionClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	// The API doesn't support pagination
+	result, err := client.List(ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_maintenance_configuration_assignment.listMaintenanceConfigurationAssignments", "api_error", err)
+		return nil, err
+	}
+	if result.Value == nil {
+		return nil, nil
+	}
+
+	for _, assignment := range *result.Value {
+		d.StreamListItem(ctx, assignment)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func extractMaintenanceAssignmentResourceType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	resourceID := types.SafeString(d.Value)
+	if resourceID == "" {
+		return nil, nil
+	}
+	return resourceTypeFromID(resourceID), nil
+}
@@ -0,0 +1,339 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureManagedGrafana(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_managed_grafana",
+		Description: "Azure Managed Grafana",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getManagedGrafana,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listManagedGrafanas,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the Grafana workspace.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the Grafana workspace.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the Grafana workspace.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "sku_name",
+				Description: "The SKU of the Grafana workspace, for example 'Standard' or 'Essential'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Sku.Name"),
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the Grafana workspace.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ProvisioningState"),
+			},
+			{
+				Name:        "grafana_version",
+				Description: "The version of Grafana running in the workspace.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.GrafanaVersion"),
+			},
+			{
+				Name:        "grafana_major_version",
+				Description: "The major version of Grafana the workspace is pinned to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.GrafanaMajorVersion"),
+			},
+			{
+				Name:        "endpoint",
+				Description: "The endpoint of the Grafana workspace.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Endpoint"),
+			},
+			{
+				Name:        "public_network_access",
+				Description: "Indicates whether the workspace can be accessed from the public network. Possible values include: 'Enabled', 'Disabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.PublicNetworkAccess"),
+			},
+			{
+				Name:        "zone_redundancy",
+				Description: "Indicates whether zone redundancy is enabled for the workspace. Possible values include: 'Enabled', 'Disabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ZoneRedundancy"),
+			},
+			{
+				Name:        "api_key",
+				Description: "Indicates whether API keys and service accounts can be created in the workspace. Possible values include: 'Enabled', 'Disabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.APIKey"),
+			},
+			{
+				Name:        "deterministic_outbound_ip",
+				Description: "Indicates whether the workspace uses deterministic outbound IPs to reach its data sources. Possible values include: 'Enabled', 'Disabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DeterministicOutboundIP"),
+			},
+			{
+				Name:        "outbound_ips",
+				Description: "The outbound IPs of the workspace, if deterministic outbound IPs are enabled.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.OutboundIPs"),
+			},
+			{
+				Name:        "auto_generated_domain_name_label_scope",
+				Description: "The scope of the unique hash of the auto generated domain name of the endpoint.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.AutoGeneratedDomainNameLabelScope"),
+			},
+			{
+				Name:        "smtp_enabled",
+				Description: "Indicates whether the workspace sends emails, like alert notifications, through an SMTP server.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.GrafanaConfigurations.SMTP.Enabled"),
+			},
+			{
+				Name:        "smtp_host",
+				Description: "The host and port of the SMTP server.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.GrafanaConfigurations.SMTP.Host"),
+			},
+			{
+				Name:        "smtp_from_address",
+				Description: "The address the emails are sent from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.GrafanaConfigurations.SMTP.FromAddress"),
+			},
+			{
+				Name:        "smtp_start_tls_policy",
+				Description: "The StartTLS policy of the connection to the SMTP server. Possible values include: 'OpportunisticStartTLS', 'MandatoryStartTLS', 'NoStartTLS'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.GrafanaConfigurations.SMTP.StartTLSPolicy"),
+			},
+			{
+				Name:        "smtp_skip_verify",
+				Description: "Indicates whether the TLS certificate of the SMTP server is not verified.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.GrafanaConfigurations.SMTP.SkipVerify"),
+			},
+			{
+				Name:        "identity",
+				Description: "The managed identity of the Grafana workspace.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "identity_type",
+				Description: "The type of managed identity used by the resource. Possible values include: 'SystemAssigned', 'UserAssigned', 'SystemAssigned, UserAssigned', 'None'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityType),
+			},
+			{
+				Name:        "system_assigned_principal_id",
+				Description: "The principal ID of the system-assigned managed identity of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityPrincipalID),
+			},
+			{
+				Name:        "user_assigned_identity_ids",
+				Description: "The resource IDs of the user-assigned managed identities associated with the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityUserAssignedIDs),
+			},
+			{
+				Name:        "grafana_integrations",
+				Description: "The Azure Monitor workspaces integrated with the Grafana workspace.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.GrafanaIntegrations"),
+			},
+			{
+				Name:        "grafana_plugins",
+				Description: "The Grafana plugins installed in the workspace.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.GrafanaPlugins"),
+			},
+			{
+				Name:        "enterprise_configurations",
+				Description: "The Grafana Enterprise configuration of the workspace.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.EnterpriseConfigurations"),
+			},
+			{
+				Name:        "private_endpoint_connections",
+				Description: "The private endpoint connections of the Grafana workspace.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.PrivateEndpointConnections"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+// There is no SDK package for the Microsoft.Dashboard resource provider
+const managedGrafanaAPIVersion = "2023-09-01"
+
+type ManagedGrafana struct {
+	ID         *string                   `json:"id,omitempty"`
+	Name       *string                   `json:"name,omitempty"`
+	Type       *string                   `json:"type,omitempty"`
+	Location   *string                   `json:"location,omitempty"`
+	Tags       map[string]*string        `json:"tags,omitempty"`
+	Sku        *ManagedGrafanaSku        `json:"sku,omitempty"`
+	Identity   *ResourceManagerIdentity  `json:"identity,omitempty"`
+	Properties *ManagedGrafanaProperties `json:"properties,omitempty"`
+}
+
+type ManagedGrafanaSku struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type ManagedGrafanaProperties struct {
+	ProvisioningState                 *string                       `json:"provisioningState,omitempty"`
+	GrafanaVersion                    *string                       `json:"grafanaVersion,omitempty"`
+	GrafanaMajorVersion               *string                       `json:"grafanaMajorVersion,omitempty"`
+	Endpoint                          *string                       `json:"endpoint,omitempty"`
+	PublicNetworkAccess               *string                       `json:"publicNetworkAccess,omitempty"`
+	ZoneRedundancy                    *string                       `json:"zoneRedundancy,omitempty"`
+	APIKey                            *string                       `json:"apiKey,omitempty"`
+	DeterministicOutboundIP           *string                       `json:"deterministicOutboundIP,omitempty"`
+	OutboundIPs                       []string                      `json:"outboundIPs,omitempty"`
+	AutoGeneratedDomainNameLabelScope *string                       `json:"autoGeneratedDomainNameLabelScope,omitempty"`
+	GrafanaConfigurations             *ManagedGrafanaConfigurations `json:"grafanaConfigurations,omitempty"`
+	GrafanaIntegrations               map[string]interface{}        `json:"grafanaIntegrations,omitempty"`
+	GrafanaPlugins                    map[string]interface{}        `json:"grafanaPlugins,omitempty"`
+	EnterpriseConfigurations          map[string]interface{}        `json:"enterpriseConfigurations,omitempty"`
+	PrivateEndpointConnections        []interface{}                 `json:"privateEndpointConnections,omitempty"`
+}
+
+type ManagedGrafanaConfigurations struct {
+	SMTP *ManagedGrafanaSMTP `json:"smtp,omitempty"`
+}
+
+// The password of the SMTP server is write-only, so it is not part of the response
+type ManagedGrafanaSMTP struct {
+	Enabled        *bool   `json:"enabled,omitempty"`
+	Host           *string `json:"host,omitempty"`
+	User           *string `json:"user,omitempty"`
+	FromAddress    *string `json:"fromAddress,omitempty"`
+	FromName       *string `json:"fromName,omitempty"`
+	StartTLSPolicy *string `json:"startTLSPolicy,omitempty"`
+	SkipVerify     *bool   `json:"skipVerify,omitempty"`
+}
+
+//// LIST FUNCTION
+
+func listManagedGrafanas(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_managed_grafana.listManagedGrafanas", "session_error", err)
+		return nil, err
+	}
+
+	path := "/subscriptions/" + session.SubscriptionID + "/providers/Microsoft.Dashboard/grafana"
+	err = listResourceManagerResources(ctx, d, path, managedGrafanaAPIVersion, func(items []json.RawMessage) (bool, error) {
+		for _, item := range items {
+			var grafana ManagedGrafana
+			if err := json.Unmarshal(item, &grafana); err != nil {
+				return false, err
+			}
+			d.StreamListItem(ctx, grafana)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_managed_grafana.listManagedGrafanas", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getManagedGrafana(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_managed_grafana.getManagedGrafana", "session_error", err)
+		return nil, err
+	}
+
+	var grafana ManagedGrafana
+	path := "/subscriptions/" + session.SubscriptionID + "/resourceGroups/" + resourceGroup + "/providers/Microsoft.Dashboard/grafana/" + name
+	found, err := getResourceManagerResource(ctx, d, path, managedGrafanaAPIVersion, &grafana)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_managed_grafana.getManagedGrafana", "api_error", err)
+		return nil, err
+	}
+
+	if found && grafana.ID != nil {
+		return grafana, nil
+	}
+
+	return nil, nil
+}
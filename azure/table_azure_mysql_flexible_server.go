@@ -201,6 +201,34 @@ func tableAzureMySQLFlexibleServer(_ context.Context) *plugin.Table {
 				Description: "The system metadata relating to this server.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "identity",
+				Description: "The managed identity of the server.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getMySQLFlexibleServerIdentity,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "identity_type",
+				Description: "The type of managed identity used by the resource. Possible values include: 'SystemAssigned', 'UserAssigned', 'SystemAssigned, UserAssigned', 'None'.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getMySQLFlexibleServerIdentity,
+				Transform:   transform.FromValue().Transform(extractManagedIdentityType),
+			},
+			{
+				Name:        "system_assigned_principal_id",
+				Description: "The principal ID of the system-assigned managed identity of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getMySQLFlexibleServerIdentity,
+				Transform:   transform.FromValue().Transform(extractManagedIdentityPrincipalID),
+			},
+			{
+				Name:        "user_assigned_identity_ids",
+				Description: "The resource IDs of the user-assigned managed identities associated with the resource.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getMySQLFlexibleServerIdentity,
+				Transform:   transform.FromValue().Transform(extractManagedIdentityUserAssignedIDs),
+			},
 
 			// Steampipe standard columns
 			{
@@ -353,6 +381,22 @@ func listMySQLFlexibleServersConfigurations(ctx context.Context, d *plugin.Query
 	return mySQLFlexibleServersConfigurations, nil
 }
 
+// The SDK package of the Microsoft.DBforMySQL resource provider does not return the managed
+// identity of the flexible server
+const mySQLFlexibleServerIdentityAPIVersion = "2023-06-30"
+
+func getMySQLFlexibleServerIdentity(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	server := h.Item.(mysqlflexibleservers.Server)
+
+	identity, err := getResourceManagerIdentity(ctx, d, *server.ID, mySQLFlexibleServerIdentityAPIVersion)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_mysql_flexible_server.getMySQLFlexibleServerIdentity", "api_error", err)
+		return nil, err
+	}
+
+	return identity, nil
+}
+
 //// TRANSFORM FUNCTION
 
 // If we return the API response directly, the output will not provide the properties of Configurations
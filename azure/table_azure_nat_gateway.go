@@ -2,6 +2,7 @@ package azure
 
 import (
 	"context"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/network/mgmt/network"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
@@ -90,6 +91,26 @@ func tableAzureNatGateway(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromP(extractNatGatewayProperties, "subnets"),
 			},
+			{
+				Name:        "outbound_ip_addresses",
+				Description: "The IP addresses of the public IP address resources associated with the nat gateway, used for outbound connectivity.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getNatGatewayOutboundIPAddresses,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "outbound_ip_prefixes",
+				Description: "The IP address ranges of the public IP prefix resources associated with the nat gateway, used for outbound connectivity.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getNatGatewayOutboundIPPrefixes,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "subnet_count",
+				Description: "The number of subnets associated with the nat gateway.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.From(natGatewaySubnetCount),
+			},
 			{
 				Name:        "zones",
 				Description: "A list of availability zones denoting the zone in which Nat Gateway should be deployed.",
@@ -209,8 +230,84 @@ func getNatGateway(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 	return nil, nil
 }
 
+func getNatGatewayOutboundIPAddresses(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	gateway := h.Item.(network.NatGateway)
+	if gateway.NatGatewayPropertiesFormat == nil || gateway.PublicIPAddresses == nil {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_nat_gateway.getNatGatewayOutboundIPAddresses", "session_error", err)
+		return nil, err
+	}
+
+	var ipAddresses []string
+	for _, publicIP := range *gateway.PublicIPAddresses {
+		if publicIP.ID == nil {
+			continue
+		}
+		op, err := getNicPublicIP(ctx, session, *publicIP.ID)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_nat_gateway.getNatGatewayOutboundIPAddresses", "api_error", err)
+			return nil, err
+		}
+		if op.PublicIPAddressPropertiesFormat != nil && op.IPAddress != nil {
+			ipAddresses = append(ipAddresses, *op.IPAddress)
+		}
+	}
+
+	return ipAddresses, nil
+}
+
+func getNatGatewayOutboundIPPrefixes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	gateway := h.Item.(network.NatGateway)
+	if gateway.NatGatewayPropertiesFormat == nil || gateway.PublicIPPrefixes == nil {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_nat_gateway.getNatGatewayOutboundIPPrefixes", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := network.NewPublicIPPrefixesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	var ipPrefixes []string
+	for _, prefix := range *gateway.PublicIPPrefixes {
+		if prefix.ID == nil {
+			continue
+		}
+		pathParts := strings.Split(*prefix.ID, "/")
+		resourceGroup := pathParts[4]
+		name := pathParts[len(pathParts)-1]
+
+		op, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_nat_gateway.getNatGatewayOutboundIPPrefixes", "api_error", err)
+			return nil, err
+		}
+		if op.PublicIPPrefixPropertiesFormat != nil && op.IPPrefix != nil {
+			ipPrefixes = append(ipPrefixes, *op.IPPrefix)
+		}
+	}
+
+	return ipPrefixes, nil
+}
+
 //// TRANSFORM FUNCTIONS
 
+func natGatewaySubnetCount(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	gateway := d.HydrateItem.(network.NatGateway)
+	if gateway.NatGatewayPropertiesFormat == nil || gateway.Subnets == nil {
+		return 0, nil
+	}
+	return len(*gateway.Subnets), nil
+}
+
 func extractNatGatewayProperties(ctx context.Context, d *transform.TransformData) (interface{}, error) {
 	gateway := d.HydrateItem.(network.NatGateway)
 	param := d.Param.(string)
@@ -220,7 +317,7 @@ func extractNatGatewayProperties(ctx context.Context, d *transform.TransformData
 	if gateway.IdleTimeoutInMinutes != nil {
 		objectMap["idleTimeoutInMinutes"] = *gateway.IdleTimeoutInMinutes
 	}
-	if *gateway.ResourceGUID != "" {
+	if gateway.ResourceGUID != nil {
 		objectMap["resourceGUID"] = gateway.ResourceGUID
 	}
 	if gateway.ProvisioningState != "" {
@@ -2,6 +2,7 @@ package azure
 
 import (
 	"context"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/network/mgmt/network"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
@@ -26,6 +27,20 @@ func tableAzureNetworkInterface(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listNetworkInterfaces,
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getNetworkInterfaceEffectiveRouteTable,
+				IgnoreConfig: &plugin.IgnoreConfig{
+					ShouldIgnoreErrorFunc: isNotFoundError(networkInterfaceEffectiveConfigurationErrors),
+				},
+			},
+			{
+				Func: listNetworkInterfaceEffectiveNetworkSecurityGroups,
+				IgnoreConfig: &plugin.IgnoreConfig{
+					ShouldIgnoreErrorFunc: isNotFoundError(networkInterfaceEffectiveConfigurationErrors),
+				},
+			},
+		},
 		Columns: azureColumns([]*plugin.Column{
 			{
 				Name:        "name",
@@ -198,6 +213,20 @@ func tableAzureNetworkInterface(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("InterfacePropertiesFormat.PrivateEndpoint"),
 			},
+			{
+				Name:        "effective_routes",
+				Description: "The effective routes applied to the network interface. Only available for network interfaces attached to a running virtual machine.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getNetworkInterfaceEffectiveRouteTable,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "effective_network_security_groups",
+				Description: "The effective network security groups, including the effective security rules, applied to the network interface. Only available for network interfaces attached to a running virtual machine.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listNetworkInterfaceEffectiveNetworkSecurityGroups,
+				Transform:   transform.FromValue(),
+			},
 
 			// Steampipe standard columns
 			{
@@ -309,3 +338,88 @@ func getNetworkInterface(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 
 	return nil, nil
 }
+
+// The effective routes and network security groups are only computed while the virtual machine
+// of the network interface is running. The operations fail for a deallocated or stopped virtual
+// machine, or a network interface detached since it was listed, which must not fail the query.
+var networkInterfaceEffectiveConfigurationErrors = []string{"NotRunning", "NotAttached", "NotInUse", "ResourceNotFound", "404"}
+
+func getNetworkInterfaceEffectiveRouteTable(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	networkInterface := h.Item.(network.Interface)
+
+	// Effective routes can only be computed for a network interface attached to a virtual machine
+	if networkInterface.InterfacePropertiesFormat == nil || networkInterface.VirtualMachine == nil {
+		return nil, nil
+	}
+	resourceGroup := strings.Split(*networkInterface.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_network_interface.getNetworkInterfaceEffectiveRouteTable", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	networkClient := network.NewInterfacesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	networkClient.Authorizer = session.Authorizer
+
+	// The effective route table is computed by a long running operation
+	future, err := networkClient.GetEffectiveRouteTable(ctx, resourceGroup, *networkInterface.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_network_interface.getNetworkInterfaceEffectiveRouteTable", "api_error", err)
+		return nil, err
+	}
+	err = future.WaitForCompletionRef(ctx, networkClient.Client)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_network_interface.getNetworkInterfaceEffectiveRouteTable", "wait_error", err)
+		return nil, err
+	}
+
+	result, err := future.Result(networkClient)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_network_interface.getNetworkInterfaceEffectiveRouteTable", "result_error", err)
+		return nil, err
+	}
+
+	return result.Value, nil
+}
+
+func listNetworkInterfaceEffectiveNetworkSecurityGroups(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	networkInterface := h.Item.(network.Interface)
+
+	// Effective network security groups can only be computed for a network interface attached to a virtual machine
+	if networkInterface.InterfacePropertiesFormat == nil || networkInterface.VirtualMachine == nil {
+		return nil, nil
+	}
+	resourceGroup := strings.Split(*networkInterface.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_network_interface.listNetworkInterfaceEffectiveNetworkSecurityGroups", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	networkClient := network.NewInterfacesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	networkClient.Authorizer = session.Authorizer
+
+	// The effective network security groups are computed by a long running operation
+	future, err := networkClient.ListEffectiveNetworkSecurityGroups(ctx, resourceGroup, *networkInterface.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_network_interface.listNetworkInterfaceEffectiveNetworkSecurityGroups", "api_error", err)
+		return nil, err
+	}
+	err = future.WaitForCompletionRef(ctx, networkClient.Client)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_network_interface.listNetworkInterfaceEffectiveNetworkSecurityGroups", "wait_error", err)
+		return nil, err
+	}
+
+	result, err := future.Result(networkClient)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_network_interface.listNetworkInterfaceEffectiveNetworkSecurityGroups", "result_error", err)
+		return nil, err
+	}
+
+	return result.Value, nil
+}
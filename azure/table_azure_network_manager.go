@@ -0,0 +1,224 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/network/mgmt/network"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureNetworkManager(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_network_manager",
+		Description: "Azure Virtual Network Manager",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getNetworkManager,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listNetworkManagers,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the network manager.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the network manager.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "etag",
+				Description: "A unique read-only string that changes whenever the resource is updated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the network manager.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the network manager resource. Possible values include: 'Succeeded', 'Updating', 'Deleting', 'Failed'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ManagerProperties.ProvisioningState").Transform(transform.ToString),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the network manager.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ManagerProperties.Description"),
+			},
+			{
+				Name:        "scope_management_groups",
+				Description: "The management groups managed by the network manager.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ManagerProperties.NetworkManagerScopes.ManagementGroups"),
+			},
+			{
+				Name:        "scope_subscriptions",
+				Description: "The subscriptions managed by the network manager.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ManagerProperties.NetworkManagerScopes.Subscriptions"),
+			},
+			{
+				Name:        "cross_tenant_scopes",
+				Description: "The management groups and subscriptions of other tenants managed by the network manager.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ManagerProperties.NetworkManagerScopes.CrossTenantScopes"),
+			},
+			{
+				Name:        "scope_accesses",
+				Description: "The configuration types the network manager is allowed to deploy. Possible values include: 'SecurityAdmin', 'Connectivity'.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ManagerProperties.NetworkManagerScopeAccesses"),
+			},
+			{
+				Name:        "system_data",
+				Description: "Metadata pertaining to creation and last modification of the network manager.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listNetworkManagers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_network_manager.listNetworkManagers", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := network.NewManagersClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListBySubscription(ctx, nil, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_network_manager.listNetworkManagers", "api_error", err)
+		return nil, err
+	}
+
+	for _, manager := range result.Values() {
+		d.StreamListItem(ctx, manager)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_network_manager.listNetworkManagers", "api_paging_error", err)
+			return nil, err
+		}
+		for _, manager := range result.Values() {
+			d.StreamListItem(ctx, manager)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getNetworkManager(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_network_manager.getNetworkManager", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := network.NewManagersClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_network_manager.getNetworkManager", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// The IDs of the child resources of a network manager have the form
+// /subscriptions/{id}/resourceGroups/{rg}/providers/Microsoft.Network/networkManagers/{name}/...
+func extractNetworkManagerNameFromID(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	id := types.SafeString(d.Value)
+	segments := strings.Split(id, "/")
+	if len(segments) < 9 {
+		return nil, nil
+	}
+	return segments[8], nil
+}
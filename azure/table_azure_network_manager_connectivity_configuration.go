@@ -0,0 +1,216 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/network/mgmt/network"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureNetworkManagerConnectivityConfiguration(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_network_manager_connectivity_configuration",
+		Description: "Azure Virtual Network Manager Connectivity Configuration",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"network_manager_name", "name", "resource_group"}),
+			Hydrate:    getNetworkManagerConnectivityConfiguration,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listNetworkManagers,
+			Hydrate:       listNetworkManagerConnectivityConfigurations,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the connectivity configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the connectivity configuration.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "network_manager_name",
+				Description: "The name of the network manager the connectivity configuration belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractNetworkManagerNameFromID),
+			},
+			{
+				Name:        "etag",
+				Description: "A unique read-only string that changes whenever the resource is updated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the connectivity configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the connectivity configuration resource. Possible values include: 'Succeeded', 'Updating', 'Deleting', 'Failed'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConnectivityConfigurationProperties.ProvisioningState").Transform(transform.ToString),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the connectivity configuration.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConnectivityConfigurationProperties.Description"),
+			},
+			{
+				Name:        "connectivity_topology",
+				Description: "The topology of the connectivity configuration. Possible values include: 'HubAndSpoke', 'Mesh'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConnectivityConfigurationProperties.ConnectivityTopology").Transform(transform.ToString),
+			},
+			{
+				Name:        "is_global",
+				Description: "Indicates whether the virtual networks of the configuration are connected across regions. Possible values include: 'True', 'False'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConnectivityConfigurationProperties.IsGlobal").Transform(transform.ToString),
+			},
+			{
+				Name:        "delete_existing_peering",
+				Description: "Indicates whether existing peerings are removed when the configuration is deployed. Possible values include: 'True', 'False'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConnectivityConfigurationProperties.DeleteExistingPeering").Transform(transform.ToString),
+			},
+			{
+				Name:        "hubs",
+				Description: "The hub virtual networks of a hub and spoke topology.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ConnectivityConfigurationProperties.Hubs"),
+			},
+			{
+				Name:        "applies_to_groups",
+				Description: "The network groups the connectivity configuration applies to.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ConnectivityConfigurationProperties.AppliesToGroups"),
+			},
+			{
+				Name:        "system_data",
+				Description: "Metadata pertaining to creation and last modification of the connectivity configuration.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listNetworkManagerConnectivityConfigurations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	manager := h.Item.(network.Manager)
+	resourceGroup := strings.Split(*manager.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_network_manager_connectivity_configuration.listNetworkManagerConnectivityConfigurations", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := network.NewConnectivityConfigurationsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx, resourceGroup, *manager.Name, nil, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_network_manager_connectivity_configuration.listNetworkManagerConnectivityConfigurations", "api_error", err)
+		return nil, err
+	}
+
+	for _, configuration := range result.Values() {
+		d.StreamListItem(ctx, configuration)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_network_manager_connectivity_configuration.listNetworkManagerConnectivityConfigurations", "api_paging_error", err)
+			return nil, err
+		}
+		for _, configuration := range result.Values() {
+			d.StreamListItem(ctx, configuration)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getNetworkManagerConnectivityConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	managerName := d.EqualsQualString("network_manager_name")
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if managerName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_network_manager_connectivity_configuration.getNetworkManagerConnectivityConfiguration", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := network.NewConnectivityConfigurationsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, managerName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_network_manager_connectivity_configuration.getNetworkManagerConnectivityConfiguration", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
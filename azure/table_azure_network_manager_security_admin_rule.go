@@ -0,0 +1,314 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/network/mgmt/network"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureNetworkManagerSecurityAdminRule(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_network_manager_security_admin_rule",
+		Description: "Azure Virtual Network Manager Security Admin Rule",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"network_manager_name", "security_admin_configuration_name", "rule_collection_name", "name", "resource_group"}),
+			Hydrate:    getNetworkManagerSecurityAdminRule,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listNetworkManagers,
+			Hydrate:       listNetworkManagerSecurityAdminRules,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the security admin rule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the security admin rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "network_manager_name",
+				Description: "The name of the network manager the rule belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractNetworkManagerNameFromID),
+			},
+			{
+				Name:        "security_admin_configuration_name",
+				Description: "The name of the security admin configuration the rule belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractSecurityAdminRuleParentName),
+			},
+			{
+				Name:        "rule_collection_name",
+				Description: "The name of the rule collection the rule belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractSecurityAdminRuleParentName),
+			},
+			{
+				Name:        "etag",
+				Description: "A unique read-only string that changes whenever the resource is updated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the security admin rule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kind",
+				Description: "The kind of the security admin rule. Possible values include: 'Custom', 'Default'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Kind").Transform(transform.ToString),
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the security admin rule resource. Possible values include: 'Succeeded', 'Updating', 'Deleting', 'Failed'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AdminPropertiesFormat.ProvisioningState", "DefaultAdminPropertiesFormat.ProvisioningState").Transform(transform.ToString),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the security admin rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AdminPropertiesFormat.Description", "DefaultAdminPropertiesFormat.Description"),
+			},
+			{
+				Name:        "flag",
+				Description: "The identifier of the default security admin rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DefaultAdminPropertiesFormat.Flag"),
+			},
+			{
+				Name:        "access",
+				Description: "Indicates whether network traffic is allowed or denied. Possible values include: 'Allow', 'Deny', 'AlwaysAllow'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AdminPropertiesFormat.Access", "DefaultAdminPropertiesFormat.Access").Transform(transform.ToString),
+			},
+			{
+				Name:        "direction",
+				Description: "Indicates whether the rule is evaluated on incoming or outgoing traffic. Possible values include: 'Inbound', 'Outbound'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AdminPropertiesFormat.Direction", "DefaultAdminPropertiesFormat.Direction").Transform(transform.ToString),
+			},
+			{
+				Name:        "priority",
+				Description: "The priority of the rule. Rules with a lower value are evaluated first.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("AdminPropertiesFormat.Priority", "DefaultAdminPropertiesFormat.Priority"),
+			},
+			{
+				Name:        "protocol",
+				Description: "The network protocol the rule applies to. Possible values include: 'Tcp', 'Udp', 'Icmp', 'Esp', 'Any', 'Ah'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AdminPropertiesFormat.Protocol", "DefaultAdminPropertiesFormat.Protocol").Transform(transform.ToString),
+			},
+			{
+				Name:        "sources",
+				Description: "The source address prefixes or service tags of the rule.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AdminPropertiesFormat.Sources", "DefaultAdminPropertiesFormat.Sources"),
+			},
+			{
+				Name:        "destinations",
+				Description: "The destination address prefixes or service tags of the rule.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AdminPropertiesFormat.Destinations", "DefaultAdminPropertiesFormat.Destinations"),
+			},
+			{
+				Name:        "source_port_ranges",
+				Description: "The source port ranges of the rule.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AdminPropertiesFormat.SourcePortRanges", "DefaultAdminPropertiesFormat.SourcePortRanges"),
+			},
+			{
+				Name:        "destination_port_ranges",
+				Description: "The destination port ranges of the rule.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AdminPropertiesFormat.DestinationPortRanges", "DefaultAdminPropertiesFormat.DestinationPortRanges"),
+			},
+			{
+				Name:        "system_data",
+				Description: "Metadata pertaining to creation and last modification of the security admin rule.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listNetworkManagerSecurityAdminRules(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	manager := h.Item.(network.Manager)
+	resourceGroup := strings.Split(*manager.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_network_manager_security_admin_rule.listNetworkManagerSecurityAdminRules", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	configurationsClient := network.NewSecurityAdminConfigurationsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	configurationsClient.Authorizer = session.Authorizer
+
+	collectionsClient := network.NewAdminRuleCollectionsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	collectionsClient.Authorizer = session.Authorizer
+
+	rulesClient := network.NewAdminRulesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	rulesClient.Authorizer = session.Authorizer
+
+	// Rules are nested under rule collections, which are nested under security admin configurations
+	configurations, err := configurationsClient.ListComplete(ctx, resourceGroup, *manager.Name, nil, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_network_manager_security_admin_rule.listNetworkManagerSecurityAdminRules", "api_error", err)
+		return nil, err
+	}
+	for configurations.NotDone() {
+		configuration := configurations.Value()
+
+		collections, err := collectionsClient.ListComplete(ctx, resourceGroup, *manager.Name, *configuration.Name, nil, "")
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_network_manager_security_admin_rule.listNetworkManagerSecurityAdminRules", "api_error", err)
+			return nil, err
+		}
+		for collections.NotDone() {
+			collection := collections.Value()
+
+			rules, err := rulesClient.ListComplete(ctx, resourceGroup, *manager.Name, *configuration.Name, *collection.Name, nil, "")
+			if err != nil {
+				plugin.Logger(ctx).Error("azure_network_manager_security_admin_rule.listNetworkManagerSecurityAdminRules", "api_error", err)
+				return nil, err
+			}
+			for rules.NotDone() {
+				if rule := networkManagerAdminRuleValue(rules.Value()); rule != nil {
+					d.StreamListItem(ctx, rule)
+					// Check if context has been cancelled or if the limit has been hit (if specified)
+					// if there is a limit, it will return the number of rows required to reach this limit
+					if d.RowsRemaining(ctx) == 0 {
+						return nil, nil
+					}
+				}
+				if err := rules.NextWithContext(ctx); err != nil {
+					plugin.Logger(ctx).Error("azure_network_manager_security_admin_rule.listNetworkManagerSecurityAdminRules", "api_paging_error", err)
+					return nil, err
+				}
+			}
+
+			if err := collections.NextWithContext(ctx); err != nil {
+				plugin.Logger(ctx).Error("azure_network_manager_security_admin_rule.listNetworkManagerSecurityAdminRules", "api_paging_error", err)
+				return nil, err
+			}
+		}
+
+		if err := configurations.NextWithContext(ctx); err != nil {
+			plugin.Logger(ctx).Error("azure_network_manager_security_admin_rule.listNetworkManagerSecurityAdminRules", "api_paging_error", err)
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getNetworkManagerSecurityAdminRule(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	managerName := d.EqualsQualString("network_manager_name")
+	configurationName := d.EqualsQualString("security_admin_configuration_name")
+	collectionName := d.EqualsQualString("rule_collection_name")
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if managerName == "" || configurationName == "" || collectionName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_network_manager_security_admin_rule.getNetworkManagerSecurityAdminRule", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := network.NewAdminRulesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, managerName, configurationName, collectionName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_network_manager_security_admin_rule.getNetworkManagerSecurityAdminRule", "api_error", err)
+		return nil, err
+	}
+
+	return networkManagerAdminRuleValue(op.Value), nil
+}
+
+//// UTILITY FUNCTIONS
+
+// Security admin rules are polymorphic; return the concrete rule so that the
+// columns can be resolved from either the custom or the default rule properties.
+func networkManagerAdminRuleValue(rule network.BasicBaseAdminRule) interface{} {
+	if rule == nil {
+		return nil
+	}
+	if adminRule, ok := rule.AsAdminRule(); ok && adminRule.ID != nil {
+		return *adminRule
+	}
+	if defaultAdminRule, ok := rule.AsDefaultAdminRule(); ok && defaultAdminRule.ID != nil {
+		return *defaultAdminRule
+	}
+	return nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// The rule ID has the form
+// .../networkManagers/{name}/securityAdminConfigurations/{configuration}/ruleCollections/{collection}/rules/{rule}
+func extractSecurityAdminRuleParentName(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	segments := strings.Split(types.SafeString(d.Value), "/")
+	if len(segments) < 13 {
+		return nil, nil
+	}
+	switch d.ColumnName {
+	case "security_admin_configuration_name":
+		return segments[10], nil
+	case "rule_collection_name":
+		return segments[12], nil
+	}
+	return nil, nil
+}
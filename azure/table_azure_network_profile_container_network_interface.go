@@ -0,0 +1,179 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/network/mgmt/network"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureNetworkProfileContainerNetworkInterface(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_network_profile_container_network_interface",
+		Description: "Azure Network Profile Container Network Interface, the network interfaces of the containers deployed with a network profile.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"network_profile_name", "name", "resource_group"}),
+			Hydrate:    getNetworkProfileContainerNetworkInterface,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listNetworkProfiles,
+			Hydrate:       listNetworkProfileContainerNetworkInterfaces,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the container network interface.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the container network interface.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "network_profile_name",
+				Description: "The name of the network profile the container network interface was created from.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the container network interface.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "etag",
+				Description: "A unique read-only string that changes whenever the resource is updated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the container network interface.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ContainerNetworkInterfacePropertiesFormat.ProvisioningState"),
+			},
+			{
+				Name:        "container_id",
+				Description: "The ID of the container the network interface is attached to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ContainerNetworkInterfacePropertiesFormat.Container.ID"),
+			},
+			{
+				Name:        "configuration_id",
+				Description: "The ID of the container network interface configuration the network interface was created from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ContainerNetworkInterfacePropertiesFormat.ContainerNetworkInterfaceConfiguration.ID"),
+			},
+			{
+				Name:        "ip_configurations",
+				Description: "The IP configurations of the container network interface, with their private IP addresses.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ContainerNetworkInterfacePropertiesFormat.IPConfigurations"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+// Container network interfaces are returned as part of the network profile, which carries their location
+type NetworkProfileContainerNetworkInterfaceInfo struct {
+	NetworkProfileName string
+	Location           *string
+	network.ContainerNetworkInterface
+}
+
+//// LIST FUNCTION
+
+func listNetworkProfileContainerNetworkInterfaces(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	profile := h.Item.(network.Profile)
+	if profile.ProfilePropertiesFormat == nil || profile.ContainerNetworkInterfaces == nil {
+		return nil, nil
+	}
+
+	for _, networkInterface := range *profile.ContainerNetworkInterfaces {
+		d.StreamListItem(ctx, NetworkProfileContainerNetworkInterfaceInfo{types.SafeString(profile.Name), profile.Location, networkInterface})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getNetworkProfileContainerNetworkInterface(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	profileName := d.EqualsQualString("network_profile_name")
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if profileName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_network_profile_container_network_interface.getNetworkProfileContainerNetworkInterface", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := network.NewProfilesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	profile, err := client.Get(ctx, resourceGroup, profileName, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_network_profile_container_network_interface.getNetworkProfileContainerNetworkInterface", "api_error", err)
+		return nil, err
+	}
+
+	if profile.ProfilePropertiesFormat == nil || profile.ContainerNetworkInterfaces == nil {
+		return nil, nil
+	}
+	for _, networkInterface := range *profile.ContainerNetworkInterfaces {
+		if networkInterface.Name != nil && strings.EqualFold(*networkInterface.Name, name) {
+			return NetworkProfileContainerNetworkInterfaceInfo{types.SafeString(profile.Name), profile.Location, networkInterface}, nil
+		}
+	}
+
+	return nil, nil
+}
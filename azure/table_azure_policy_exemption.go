@@ -0,0 +1,260 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/resources/mgmt/policy"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzurePolicyExemption(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_policy_exemption",
+		Description: "Azure Policy Exemption",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getPolicyExemption,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"PolicyExemptionNotFound", "ResourceNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listPolicyExemptions,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "policy_assignment_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the policy exemption.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the policy exemption.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the policy exemption.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExemptionProperties.DisplayName"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the policy exemption.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExemptionProperties.Description"),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the policy exemption.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "policy_assignment_id",
+				Description: "The ID of the policy assignment that is being exempted.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExemptionProperties.PolicyAssignmentID"),
+			},
+			{
+				Name:        "exemption_category",
+				Description: "The policy exemption category. Possible values are Waiver and Mitigated.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExemptionProperties.ExemptionCategory"),
+			},
+			{
+				Name:        "expires_on",
+				Description: "The expiration date and time of the policy exemption. The exemption does not expire if it is not set.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ExemptionProperties.ExpiresOn").Transform(convertDateToTime),
+			},
+			{
+				Name:        "scope",
+				Description: "The scope the policy exemption is created at.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractPolicyExemptionScope),
+			},
+			{
+				Name:        "created_by",
+				Description: "The identity that created the policy exemption.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SystemData.CreatedBy"),
+			},
+			{
+				Name:        "created_at",
+				Description: "The time the policy exemption was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("SystemData.CreatedAt").Transform(convertDateToTime),
+			},
+			{
+				Name:        "last_modified_by",
+				Description: "The identity that last modified the policy exemption.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SystemData.LastModifiedBy"),
+			},
+			{
+				Name:        "last_modified_at",
+				Description: "The time the policy exemption was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("SystemData.LastModifiedAt").Transform(convertDateToTime),
+			},
+			{
+				Name:        "policy_definition_reference_ids",
+				Description: "The policy definition reference IDs of the exempted policies, when the policy assignment is an assignment of a policy set definition.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ExemptionProperties.PolicyDefinitionReferenceIds"),
+			},
+			{
+				Name:        "metadata",
+				Description: "The policy exemption metadata.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ExemptionProperties.Metadata"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExemptionProperties.DisplayName", "Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromSubAssessmentID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listPolicyExemptions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_policy_exemption.listPolicyExemptions", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := policy.NewExemptionsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	// The exemptions of the subscription include the ones of its resource groups and resources,
+	// and the ones inherited from its management groups
+	filter := ""
+	if assignmentID := d.EqualsQualString("policy_assignment_id"); assignmentID != "" {
+		filter = "policyAssignmentId eq '" + assignmentID + "'"
+	}
+
+	result, err := client.List(ctx, filter)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_policy_exemption.listPolicyExemptions", "api_error", err)
+		return nil, err
+	}
+
+	for _, exemption := range result.Values() {
+		d.StreamListItem(ctx, exemption)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_policy_exemption.listPolicyExemptions", "api_paging_error", err)
+			return nil, err
+		}
+		for _, exemption := range result.Values() {
+			d.StreamListItem(ctx, exemption)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getPolicyExemption(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQualString("id")
+
+	// Return nil, if no input provided
+	scope, name := policyExemptionScopeAndName(id)
+	if scope == "" || name == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_policy_exemption.getPolicyExemption", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := policy.NewExemptionsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, strings.TrimPrefix(scope, "/"), name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_policy_exemption.getPolicyExemption", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func extractPolicyExemptionScope(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	scope, _ := policyExemptionScopeAndName(types.SafeString(d.Value))
+	if scope == "" {
+		return nil, nil
+	}
+	return scope, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// The ID of a policy exemption is of the form {scope}/providers/Microsoft.Authorization/policyExemptions/{name}
+func policyExemptionScopeAndName(id string) (string, string) {
+	index := strings.LastIndex(strings.ToLower(id), "/providers/microsoft.authorization/policyexemptions/")
+	if index <= 0 {
+		return "", ""
+	}
+	return id[:index], id[index+len("/providers/microsoft.authorization/policyexemptions/"):]
+}
@@ -0,0 +1,364 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/resources/mgmt/resources"
+	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/policyinsights/mgmt/policyinsights"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzurePolicyRemediation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_policy_remediation",
+		Description: "Azure Policy Remediation, the tasks that deploy the resources of 'deployIfNotExists' and 'modify' policies to the non-compliant resources.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "name",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "resource_group",
+					Require: plugin.Optional,
+				},
+			},
+			Hydrate: getPolicyRemediation,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"RemediationNotFound", "ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listPolicyRemediations,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the remediation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the remediation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the remediation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The status of the remediation, for example 'Accepted', 'Evaluating', 'Succeeded', 'Canceled' or 'Failed'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RemediationProperties.ProvisioningState"),
+			},
+			{
+				Name:        "policy_assignment_id",
+				Description: "The ID of the policy assignment that is being remediated.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RemediationProperties.PolicyAssignmentID"),
+			},
+			{
+				Name:        "policy_definition_reference_id",
+				Description: "The policy definition reference ID of the remediated policy, when the policy assignment is an assignment of a policy set definition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RemediationProperties.PolicyDefinitionReferenceID"),
+			},
+			{
+				Name:        "resource_discovery_mode",
+				Description: "The way the resources to remediate are discovered. Possible values include: 'ExistingNonCompliant', 'ReEvaluateCompliance'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RemediationProperties.ResourceDiscoveryMode"),
+			},
+			{
+				Name:        "scope",
+				Description: "The scope the remediation is created at.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractPolicyRemediationScope),
+			},
+			{
+				Name:        "total_deployments",
+				Description: "The number of deployments of the remediation, one per remediated resource.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("RemediationProperties.DeploymentStatus.TotalDeployments"),
+			},
+			{
+				Name:        "successful_deployments",
+				Description: "The number of deployments of the remediation that succeeded.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("RemediationProperties.DeploymentStatus.SuccessfulDeployments"),
+			},
+			{
+				Name:        "failed_deployments",
+				Description: "The number of deployments of the remediation that failed.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("RemediationProperties.DeploymentStatus.FailedDeployments"),
+			},
+			{
+				Name:        "created_on",
+				Description: "The time the remediation was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("RemediationProperties.CreatedOn").Transform(convertDateToTime),
+			},
+			{
+				Name:        "last_updated_on",
+				Description: "The time the remediation was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("RemediationProperties.LastUpdatedOn").Transform(convertDateToTime),
+			},
+			{
+				Name:        "filter_locations",
+				Description: "The resource locations the remediation is restricted to.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("RemediationProperties.Filters.Locations"),
+			},
+			{
+				Name:        "failed_deployment_details",
+				Description: "The failed deployments of the remediation, with the remediated resources and the errors of the deployments.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listPolicyRemediationFailedDeployments,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromSubAssessmentID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listPolicyRemediations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_policy_remediation.listPolicyRemediations", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := policyinsights.NewRemediationsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	// The remediations are listed per scope, so the ones already streamed are skipped in case the
+	// listing of a scope includes the remediations of its child scopes
+	seen := map[string]bool{}
+
+	// Remediations created at the subscription scope
+	result, err := client.ListForSubscription(ctx, subscriptionID, nil, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_policy_remediation.listPolicyRemediations", "api_error", err)
+		return nil, err
+	}
+	more, err := streamPolicyRemediations(ctx, d, result, seen)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_policy_remediation.listPolicyRemediations", "api_paging_error", err)
+		return nil, err
+	}
+	if !more {
+		return nil, nil
+	}
+
+	// Remediations created at the resource group scope can only be listed per resource group
+	groupsClient := resources.NewGroupsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	groupsClient.Authorizer = session.Authorizer
+
+	groups, err := groupsClient.List(ctx, "", nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_policy_remediation.listPolicyRemediations", "api_error", err)
+		return nil, err
+	}
+
+	for {
+		for _, resourceGroup := range groups.Values() {
+			result, err := client.ListForResourceGroup(ctx, subscriptionID, *resourceGroup.Name, nil, "")
+			if err != nil {
+				plugin.Logger(ctx).Error("azure_policy_remediation.listPolicyRemediations", "api_error", err)
+				return nil, err
+			}
+			more, err := streamPolicyRemediations(ctx, d, result, seen)
+			if err != nil {
+				plugin.Logger(ctx).Error("azure_policy_remediation.listPolicyRemediations", "api_paging_error", err)
+				return nil, err
+			}
+			if !more {
+				return nil, nil
+			}
+		}
+
+		if !groups.NotDone() {
+			break
+		}
+		err = groups.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_policy_remediation.listPolicyRemediations", "api_paging_error", err)
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getPolicyRemediation(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if name == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_policy_remediation.getPolicyRemediation", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := policyinsights.NewRemediationsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	// Without a resource group, the remediation is looked up at the subscription scope
+	var op policyinsights.Remediation
+	if resourceGroup != "" {
+		op, err = client.GetAtResourceGroup(ctx, subscriptionID, resourceGroup, name)
+	} else {
+		op, err = client.GetAtSubscription(ctx, subscriptionID, name)
+	}
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_policy_remediation.getPolicyRemediation", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+func listPolicyRemediationFailedDeployments(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	remediation := h.Item.(policyinsights.Remediation)
+
+	// The deployments are only listed for the remediations with failed deployments
+	props := remediation.RemediationProperties
+	if props == nil || props.DeploymentStatus == nil || props.DeploymentStatus.FailedDeployments == nil || *props.DeploymentStatus.FailedDeployments == 0 {
+		return nil, nil
+	}
+	scope := policyRemediationScope(types.SafeString(remediation.ID))
+	if scope == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_policy_remediation.listPolicyRemediationFailedDeployments", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := policyinsights.NewRemediationsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListDeploymentsAtResource(ctx, strings.TrimPrefix(scope, "/"), *remediation.Name, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_policy_remediation.listPolicyRemediationFailedDeployments", "api_error", err)
+		return nil, err
+	}
+
+	var failedDeployments []policyinsights.RemediationDeployment
+	for {
+		for _, deployment := range result.Values() {
+			if deployment.Status != nil && strings.EqualFold(*deployment.Status, "Failed") {
+				failedDeployments = append(failedDeployments, deployment)
+			}
+		}
+		if !result.NotDone() {
+			break
+		}
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_policy_remediation.listPolicyRemediationFailedDeployments", "api_paging_error", err)
+			return nil, err
+		}
+	}
+
+	return failedDeployments, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func extractPolicyRemediationScope(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	scope := policyRemediationScope(types.SafeString(d.Value))
+	if scope == "" {
+		return nil, nil
+	}
+	return scope, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// streamPolicyRemediations streams the remediations of a page and of the following ones, and reports
+// false once the limit of the query has been hit
+func streamPolicyRemediations(ctx context.Context, d *plugin.QueryData, result policyinsights.RemediationListResultPage, seen map[string]bool) (bool, error) {
+	for {
+		for _, remediation := range result.Values() {
+			if remediation.ID == nil || seen[strings.ToLower(*remediation.ID)] {
+				continue
+			}
+			seen[strings.ToLower(*remediation.ID)] = true
+
+			d.StreamListItem(ctx, remediation)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return false, nil
+			}
+		}
+		if !result.NotDone() {
+			return true, nil
+		}
+		if err := result.NextWithContext(ctx); err != nil {
+			return false, err
+		}
+	}
+}
+
+// The ID of a remediation is of the form {scope}/providers/Microsoft.PolicyInsights/remediations/{name}
+func policyRemediationScope(id string) string {
+	index := strings.LastIndex(strings.ToLower(id), "/providers/microsoft.policyinsights/remediations/")
+	if index <= 0 {
+		return ""
+	}
+	return id[:index]
+}
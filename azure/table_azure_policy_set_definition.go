@@ -0,0 +1,237 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/resources/mgmt/policy"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzurePolicySetDefinition(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_policy_set_definition",
+		Description: "Azure Policy Set Definition",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getPolicySetDefinition,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"PolicySetDefinitionNotFound", "ResourceNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listPolicySetDefinitions,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "policy_type",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the policy set definition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the policy set definition.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the policy set definition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SetDefinitionProperties.DisplayName"),
+			},
+			{
+				Name:        "description",
+				Description: "The policy set definition description.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SetDefinitionProperties.Description"),
+			},
+			{
+				Name:        "policy_type",
+				Description: "The type of the policy set definition. Possible values include: 'NotSpecified', 'BuiltIn', 'Custom', 'Static'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SetDefinitionProperties.PolicyType"),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource (Microsoft.Authorization/policySetDefinitions).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_by",
+				Description: "The identity that created the policy set definition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SystemData.CreatedBy"),
+			},
+			{
+				Name:        "created_at",
+				Description: "The time the policy set definition was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("SystemData.CreatedAt").Transform(convertDateToTime),
+			},
+			{
+				Name:        "last_modified_by",
+				Description: "The identity that last modified the policy set definition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SystemData.LastModifiedBy"),
+			},
+			{
+				Name:        "last_modified_at",
+				Description: "The time the policy set definition was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("SystemData.LastModifiedAt").Transform(convertDateToTime),
+			},
+			{
+				Name:        "metadata",
+				Description: "The policy set definition metadata. Metadata is an open ended object and is typically a collection of key value pairs.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SetDefinitionProperties.Metadata"),
+			},
+			{
+				Name:        "parameters",
+				Description: "The parameter definitions of the policy set definition, which can be used in its policy definition references. The keys are the parameter names.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SetDefinitionProperties.Parameters"),
+			},
+			{
+				Name:        "policy_definitions",
+				Description: "The references to the policy definitions of the policy set definition, with the parameter values and the groups of each policy definition.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SetDefinitionProperties.PolicyDefinitions"),
+			},
+			{
+				Name:        "policy_definition_groups",
+				Description: "The groups of the policy definition references, for example the controls of a regulatory compliance standard.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SetDefinitionProperties.PolicyDefinitionGroups"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SetDefinitionProperties.DisplayName", "Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listPolicySetDefinitions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_policy_set_definition.listPolicySetDefinitions", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := policy.NewSetDefinitionsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	// The policy set definitions of the subscription include the built-in ones and the ones
+	// inherited from its management groups
+	filter := ""
+	if policyType := d.EqualsQualString("policy_type"); policyType != "" {
+		filter = "policyType eq '" + policyType + "'"
+	}
+
+	result, err := client.List(ctx, filter, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_policy_set_definition.listPolicySetDefinitions", "api_error", err)
+		return nil, err
+	}
+
+	for _, setDefinition := range result.Values() {
+		d.StreamListItem(ctx, setDefinition)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_policy_set_definition.listPolicySetDefinitions", "api_paging_error", err)
+			return nil, err
+		}
+		for _, setDefinition := range result.Values() {
+			d.StreamListItem(ctx, setDefinition)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getPolicySetDefinition(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQualString("id")
+
+	// Return nil, if no input provided
+	name := getLastPathElement(id)
+	if name == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_policy_set_definition.getPolicySetDefinition", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := policy.NewSetDefinitionsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	// The policy set definitions are defined at a management group, at a subscription, or are built-in
+	var op policy.SetDefinition
+	segments := strings.Split(id, "/")
+	switch {
+	case len(segments) > 4 && strings.EqualFold(segments[3], "managementGroups"):
+		op, err = client.GetAtManagementGroup(ctx, name, segments[4])
+	case len(segments) > 2 && strings.EqualFold(segments[1], "subscriptions"):
+		if !strings.EqualFold(segments[2], subscriptionID) {
+			return nil, nil
+		}
+		op, err = client.Get(ctx, name)
+	default:
+		op, err = client.GetBuiltIn(ctx, name)
+	}
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_policy_set_definition.getPolicySetDefinition", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
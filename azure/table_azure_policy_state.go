@@ -0,0 +1,268 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/policyinsights/mgmt/policyinsights"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzurePolicyState(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_policy_state",
+		Description: "Azure Policy State, the latest compliance state of each resource against each policy assigned to it.",
+		List: &plugin.ListConfig{
+			Hydrate: listPolicyStates,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "resource_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "policy_assignment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "compliance_state",
+					Require: plugin.Optional,
+				},
+				{
+					Name:      "timestamp",
+					Require:   plugin.Optional,
+					Operators: []string{">", ">="},
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "resource_id",
+				Description: "The ID of the evaluated resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceID"),
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the evaluated resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "compliance_state",
+				Description: "The compliance state of the resource against the policy. Possible values include: 'Compliant', 'NonCompliant', 'Exempt', 'Unknown'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "timestamp",
+				Description: "The time the resource was evaluated against the policy. Only the states of the last day are listed, unless an earlier time is given with this column.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Timestamp").Transform(convertDateToTime),
+			},
+			{
+				Name:        "policy_assignment_id",
+				Description: "The ID of the policy assignment, in lower case.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PolicyAssignmentID"),
+			},
+			{
+				Name:        "policy_assignment_name",
+				Description: "The name of the policy assignment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "policy_assignment_scope",
+				Description: "The scope of the policy assignment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "policy_assignment_owner",
+				Description: "The owner of the policy assignment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "policy_definition_id",
+				Description: "The ID of the policy definition, in lower case.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PolicyDefinitionID"),
+			},
+			{
+				Name:        "policy_definition_name",
+				Description: "The name of the policy definition.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "policy_definition_action",
+				Description: "The effect of the policy definition, for example 'audit' or 'deny'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "policy_definition_category",
+				Description: "The category of the policy definition.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "policy_definition_reference_id",
+				Description: "The reference ID of the policy definition inside the policy set definition, if the policy assignment is an assignment of a policy set definition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PolicyDefinitionReferenceID"),
+			},
+			{
+				Name:        "policy_set_definition_id",
+				Description: "The ID of the policy set definition, if the policy assignment is an assignment of a policy set definition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PolicySetDefinitionID"),
+			},
+			{
+				Name:        "policy_set_definition_name",
+				Description: "The name of the policy set definition, if the policy assignment is an assignment of a policy set definition.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "policy_set_definition_category",
+				Description: "The category of the policy set definition, if the policy assignment is an assignment of a policy set definition.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "management_group_ids",
+				Description: "The comma separated list of the IDs of the management groups the resource is under.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "policy_definition_group_names",
+				Description: "The groups of the policy definition in the policy set definition, for example the controls of a regulatory compliance standard.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "effective_parameters",
+				Description: "The effective parameters of the policy assignment.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("EffectiveParameters").Transform(unmarshalPolicyStateJSON),
+			},
+			{
+				Name:        "policy_assignment_parameters",
+				Description: "The parameters of the policy assignment.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PolicyAssignmentParameters").Transform(unmarshalPolicyStateJSON),
+			},
+			{
+				Name:        "policy_evaluation_details",
+				Description: "The details of the evaluation of the policy, with the expressions evaluated against the resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "resource_tags",
+				Description: "The tags of the evaluated resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ResourceTags").Transform(unmarshalPolicyStateJSON),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceLocation").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceGroup").Transform(toLower),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listPolicyStates(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_policy_state.listPolicyStates", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := policyinsights.NewPolicyStatesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	// The policy assignments may be at any scope above the subscription, so they are filtered on
+	// rather than queried at their own scope
+	var filters []string
+	if assignmentID := d.EqualsQualString("policy_assignment_id"); assignmentID != "" {
+		filters = append(filters, "PolicyAssignmentId eq '"+assignmentID+"'")
+	}
+	if complianceState := d.EqualsQualString("compliance_state"); complianceState != "" {
+		filters = append(filters, "ComplianceState eq '"+complianceState+"'")
+	}
+	filter := strings.Join(filters, " and ")
+
+	var from *date.Time
+	if d.Quals["timestamp"] != nil {
+		for _, q := range d.Quals["timestamp"].Quals {
+			value := q.Value.GetTimestampValue().AsTime()
+			if from == nil || value.After(from.Time) {
+				from = &date.Time{Time: value.UTC()}
+			}
+		}
+	}
+
+	var result policyinsights.PolicyStatesQueryResultsPage
+	if resourceID := d.EqualsQualString("resource_id"); resourceID != "" {
+		result, err = client.ListQueryResultsForResource(ctx, policyinsights.Latest, resourceID, nil, "", "", from, nil, filter, "", "", "")
+	} else {
+		result, err = client.ListQueryResultsForSubscription(ctx, policyinsights.Latest, subscriptionID, nil, "", "", from, nil, filter, "", "")
+	}
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_policy_state.listPolicyStates", "api_error", err)
+		return nil, err
+	}
+
+	for _, state := range result.Values() {
+		d.StreamListItem(ctx, state)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_policy_state.listPolicyStates", "api_paging_error", err)
+			return nil, err
+		}
+		for _, state := range result.Values() {
+			d.StreamListItem(ctx, state)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// The parameters and the tags of the policy states are returned as serialized JSON objects
+func unmarshalPolicyStateJSON(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	value := types.SafeString(d.Value)
+	if value == "" {
+		return nil, nil
+	}
+	var result interface{}
+	if err := json.Unmarshal([]byte(value), &result); err != nil {
+		return value, nil
+	}
+	return result, nil
+}
@@ -76,6 +76,34 @@ func tableAzurePostgreSqlFlexibleServer(_ context.Context) *plugin.Table {
 				Hydrate:     listPostgreSQLFlexibleServersConfigurations,
 				Transform:   transform.FromValue(),
 			},
+			{
+				Name:        "identity",
+				Description: "The managed identity of the server.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getPostgreSqlFlexibleServerIdentity,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "identity_type",
+				Description: "The type of managed identity used by the resource. Possible values include: 'SystemAssigned', 'UserAssigned', 'SystemAssigned, UserAssigned', 'None'.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getPostgreSqlFlexibleServerIdentity,
+				Transform:   transform.FromValue().Transform(extractManagedIdentityType),
+			},
+			{
+				Name:        "system_assigned_principal_id",
+				Description: "The principal ID of the system-assigned managed identity of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getPostgreSqlFlexibleServerIdentity,
+				Transform:   transform.FromValue().Transform(extractManagedIdentityPrincipalID),
+			},
+			{
+				Name:        "user_assigned_identity_ids",
+				Description: "The resource IDs of the user-assigned managed identities associated with the resource.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getPostgreSqlFlexibleServerIdentity,
+				Transform:   transform.FromValue().Transform(extractManagedIdentityUserAssignedIDs),
+			},
 			// Steampipe standard columns
 			{
 				Name:        "title",
@@ -226,6 +254,22 @@ func listPostgreSQLFlexibleServersConfigurations(ctx context.Context, d *plugin.
 	return postgreSQLFlexibleServersConfigurations, nil
 }
 
+// The SDK package of the Microsoft.DBforPostgreSQL resource provider does not return the managed
+// identity of the flexible server
+const postgreSqlFlexibleServerIdentityAPIVersion = "2022-12-01"
+
+func getPostgreSqlFlexibleServerIdentity(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	server := h.Item.(postgresqlflexibleservers.Server)
+
+	identity, err := getResourceManagerIdentity(ctx, d, *server.ID, postgreSqlFlexibleServerIdentityAPIVersion)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_postgresql_flexible_server.getPostgreSqlFlexibleServerIdentity", "api_error", err)
+		return nil, err
+	}
+
+	return identity, nil
+}
+
 //// TRANSFORM FUNCTION
 
 // If we return the API response directly, the output will not provide the properties of Configurations
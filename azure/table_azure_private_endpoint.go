@@ -2,6 +2,7 @@ package azure
 
 import (
 	"context"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/network/mgmt/network"
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/resources/mgmt/resources"
@@ -78,6 +79,43 @@ func tableAzurePrivateEndpoint(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("PrivateEndpointProperties.Subnet"),
 			},
+			{
+				Name:        "private_link_service_id",
+				Description: "The resource ID of the private link service or PaaS resource the private endpoint is connected to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(extractPrivateEndpointConnectionProperty, "PrivateLinkServiceID"),
+			},
+			{
+				Name:        "connection_status",
+				Description: "The status of the connection to the remote resource. Possible values include: 'Approved', 'Pending', 'Rejected', 'Disconnected'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(extractPrivateEndpointConnectionProperty, "Status"),
+			},
+			{
+				Name:        "connection_description",
+				Description: "The reason for approval or rejection of the connection to the remote resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(extractPrivateEndpointConnectionProperty, "Description"),
+			},
+			{
+				Name:        "group_ids",
+				Description: "The IDs of the group(s) obtained from the remote resource that the private endpoint connects to.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(extractPrivateEndpointConnectionProperty, "GroupIds"),
+			},
+			{
+				Name:        "is_manual_connection",
+				Description: "Indicates whether the connection to the remote resource requires manual approval.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromP(extractPrivateEndpointConnectionProperty, "IsManual"),
+			},
+			{
+				Name:        "private_dns_zone_configs",
+				Description: "The private DNS zone configurations of the private DNS zone groups of the private endpoint.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listPrivateEndpointPrivateDNSZoneConfigs,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "network_interfaces",
 				Description: "An array of references to the network interfaces created for this private endpoint.",
@@ -224,3 +262,89 @@ func getPrivateEndpoint(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 
 	return nil, nil
 }
+
+func listPrivateEndpointPrivateDNSZoneConfigs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	privateEndpoint := h.Item.(network.PrivateEndpoint)
+	resourceGroup := strings.Split(*privateEndpoint.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_private_endpoint.listPrivateEndpointPrivateDNSZoneConfigs", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := network.NewPrivateDNSZoneGroupsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListComplete(ctx, *privateEndpoint.Name, resourceGroup)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_private_endpoint.listPrivateEndpointPrivateDNSZoneConfigs", "api_error", err)
+		return nil, err
+	}
+
+	var configs []map[string]interface{}
+	for result.NotDone() {
+		group := result.Value()
+		if group.PrivateDNSZoneGroupPropertiesFormat != nil && group.PrivateDNSZoneConfigs != nil {
+			for _, config := range *group.PrivateDNSZoneConfigs {
+				objectMap := map[string]interface{}{
+					"privateDnsZoneGroupName": group.Name,
+					"name":                    config.Name,
+				}
+				if config.PrivateDNSZonePropertiesFormat != nil {
+					objectMap["privateDnsZoneId"] = config.PrivateDNSZoneID
+					objectMap["recordSets"] = config.RecordSets
+				}
+				configs = append(configs, objectMap)
+			}
+		}
+
+		if err := result.NextWithContext(ctx); err != nil {
+			plugin.Logger(ctx).Error("azure_private_endpoint.listPrivateEndpointPrivateDNSZoneConfigs", "api_error_paging", err)
+			return nil, err
+		}
+	}
+
+	return configs, nil
+}
+
+// A private endpoint connects to exactly one remote resource, either through an
+// automatically approved connection or through a manual connection.
+func extractPrivateEndpointConnectionProperty(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	privateEndpoint := d.HydrateItem.(network.PrivateEndpoint)
+	if privateEndpoint.PrivateEndpointProperties == nil {
+		return nil, nil
+	}
+
+	var connection *network.PrivateLinkServiceConnection
+	isManual := false
+	if privateEndpoint.PrivateLinkServiceConnections != nil && len(*privateEndpoint.PrivateLinkServiceConnections) > 0 {
+		connection = &(*privateEndpoint.PrivateLinkServiceConnections)[0]
+	} else if privateEndpoint.ManualPrivateLinkServiceConnections != nil && len(*privateEndpoint.ManualPrivateLinkServiceConnections) > 0 {
+		connection = &(*privateEndpoint.ManualPrivateLinkServiceConnections)[0]
+		isManual = true
+	}
+	if connection == nil || connection.PrivateLinkServiceConnectionProperties == nil {
+		return nil, nil
+	}
+
+	properties := connection.PrivateLinkServiceConnectionProperties
+	switch d.Param.(string) {
+	case "PrivateLinkServiceID":
+		return properties.PrivateLinkServiceID, nil
+	case "GroupIds":
+		return properties.GroupIds, nil
+	case "IsManual":
+		return isManual, nil
+	case "Status":
+		if properties.PrivateLinkServiceConnectionState != nil {
+			return properties.PrivateLinkServiceConnectionState.Status, nil
+		}
+	case "Description":
+		if properties.PrivateLinkServiceConnectionState != nil {
+			return properties.PrivateLinkServiceConnectionState.Description, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,256 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/network/mgmt/network"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzurePrivateLinkService(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_private_link_service",
+		Description: "Azure Private Link Service",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getPrivateLinkService,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listPrivateLinkServices,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the private link service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the private link service.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "etag",
+				Description: "A unique read-only string that changes whenever the resource is updated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the private link service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the private link service resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PrivateLinkServiceProperties.ProvisioningState").Transform(transform.ToString),
+			},
+			{
+				Name:        "alias",
+				Description: "The alias of the private link service, which consumers can use to request a connection.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PrivateLinkServiceProperties.Alias"),
+			},
+			{
+				Name:        "enable_proxy_protocol",
+				Description: "Indicates whether the private link service is enabled for proxy protocol.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("PrivateLinkServiceProperties.EnableProxyProtocol"),
+			},
+			{
+				Name:        "visibility_subscriptions",
+				Description: "The list of subscriptions that can discover the private link service. A value of '*' makes the service visible to all subscriptions.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PrivateLinkServiceProperties.Visibility.Subscriptions"),
+			},
+			{
+				Name:        "auto_approval_subscriptions",
+				Description: "The list of subscriptions whose private endpoint connections are approved automatically.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PrivateLinkServiceProperties.AutoApproval.Subscriptions"),
+			},
+			{
+				Name:        "fqdns",
+				Description: "The list of fully qualified domain names of the private link service.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PrivateLinkServiceProperties.Fqdns"),
+			},
+			{
+				Name:        "nat_ip_addresses",
+				Description: "The private IP addresses used to translate the source addresses of consumer traffic.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PrivateLinkServiceProperties.IPConfigurations").Transform(extractPrivateLinkServiceNatIPAddresses),
+			},
+			{
+				Name:        "ip_configurations",
+				Description: "An array of NAT IP configurations of the private link service.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PrivateLinkServiceProperties.IPConfigurations"),
+			},
+			{
+				Name:        "load_balancer_frontend_ip_configurations",
+				Description: "An array of references to the load balancer frontend IP configurations the private link service is attached to.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PrivateLinkServiceProperties.LoadBalancerFrontendIPConfigurations"),
+			},
+			{
+				Name:        "network_interfaces",
+				Description: "An array of references to the network interfaces created for the private link service.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PrivateLinkServiceProperties.NetworkInterfaces"),
+			},
+			{
+				Name:        "private_endpoint_connections",
+				Description: "An array of private endpoint connections to the private link service.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PrivateLinkServiceProperties.PrivateEndpointConnections"),
+			},
+			{
+				Name:        "extended_location",
+				Description: "The extended location of the private link service.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listPrivateLinkServices(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_private_link_service.listPrivateLinkServices", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := network.NewPrivateLinkServicesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListBySubscription(ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_private_link_service.listPrivateLinkServices", "api_error", err)
+		return nil, err
+	}
+
+	for _, service := range result.Values() {
+		d.StreamListItem(ctx, service)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_private_link_service.listPrivateLinkServices", "api_paging_error", err)
+			return nil, err
+		}
+		for _, service := range result.Values() {
+			d.StreamListItem(ctx, service)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getPrivateLinkService(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_private_link_service.getPrivateLinkService", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := network.NewPrivateLinkServicesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_private_link_service.getPrivateLinkService", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func extractPrivateLinkServiceNatIPAddresses(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	ipConfigurations, ok := d.Value.(*[]network.PrivateLinkServiceIPConfiguration)
+	if !ok || ipConfigurations == nil {
+		return nil, nil
+	}
+
+	var addresses []string
+	for _, ipConfiguration := range *ipConfigurations {
+		if ipConfiguration.PrivateLinkServiceIPConfigurationProperties != nil && ipConfiguration.PrivateIPAddress != nil {
+			addresses = append(addresses, *ipConfiguration.PrivateIPAddress)
+		}
+	}
+	return addresses, nil
+}
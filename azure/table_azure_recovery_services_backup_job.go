@@ -56,6 +56,54 @@ func tableAzureRecoveryServicesBackupJob(_ context.Context) *plugin.Table {
 				Description: "Resource type represents the complete path of the form Namespace/ResourceType/ResourceType/...",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "job_type",
+				Description: "The type of the job, for example 'AzureIaaSVMJob', 'AzureStorageJob' or 'AzureWorkloadJob'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Job.JobType"),
+			},
+			{
+				Name:        "operation",
+				Description: "The operation of the job, for example 'Backup', 'Restore' or 'ConfigureBackup'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Job.Operation"),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the job, for example 'InProgress', 'Completed', 'CompletedWithWarnings', 'Failed' or 'Cancelled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Job.Status"),
+			},
+			{
+				Name:        "start_time",
+				Description: "The time the job started.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Job.StartTime"),
+			},
+			{
+				Name:        "end_time",
+				Description: "The time the job ended.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Job.EndTime"),
+			},
+			{
+				Name:        "entity_friendly_name",
+				Description: "The friendly name of the item the job runs on.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Job.EntityFriendlyName"),
+			},
+			{
+				Name:        "backup_management_type",
+				Description: "The type of backup management of the job.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Job.BackupManagementType"),
+			},
+			{
+				Name:        "activity_id",
+				Description: "The activity ID of the job.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Job.ActivityID"),
+			},
 			{
 				Name:        "etag",
 				Description: "Optional ETag.",
@@ -112,6 +160,7 @@ type JobInfo struct {
 	ETag       *string
 	Location   *string
 	Properties armrecoveryservicesbackup.JobClassification
+	Job        *armrecoveryservicesbackup.Job
 	Tags       map[string]*string
 	ID         *string
 	Name       *string
@@ -159,10 +208,15 @@ func listRecoveryServicesBackupJobs(ctx context.Context, d *plugin.QueryData, h
 		}
 
 		for _, v := range page.Value {
+			var job *armrecoveryservicesbackup.Job
+			if v.Properties != nil {
+				job = v.Properties.GetJob()
+			}
 			d.StreamListItem(ctx, JobInfo{
 				ETag:       v.ETag,
 				Location:   v.Location,
 				Properties: v.Properties,
+				Job:        job,
 				Tags:       v.Tags,
 				ID:         v.ID,
 				Name:       v.Name,
@@ -0,0 +1,374 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/reservations/mgmt/reservations"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureReservation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_reservation",
+		Description: "Azure Reservation",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"reservation_order_id", "name"}),
+			Hydrate:    getReservation,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ReservationNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listReservations,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The ID of the reservation in its reservation order.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(lastPathElement),
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the reservation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the reservation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DisplayName"),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource (Microsoft.Capacity/reservationOrders/reservations).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "reservation_order_id",
+				Description: "The ID of the reservation order of the reservation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractReservationOrderIDFromID),
+			},
+			{
+				Name:        "sku_name",
+				Description: "The SKU reserved, for example 'Standard_D2s_v3'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Sku.Name"),
+			},
+			{
+				Name:        "sku_description",
+				Description: "The description of the SKU reserved.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.SkuDescription"),
+			},
+			{
+				Name:        "reserved_resource_type",
+				Description: "The type of the resource reserved, for example 'VirtualMachines', 'SqlDatabases' or 'CosmosDb'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ReservedResourceType").Transform(transform.ToString),
+			},
+			{
+				Name:        "quantity",
+				Description: "The number of instances reserved.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Properties.Quantity"),
+			},
+			{
+				Name:        "term",
+				Description: "The term of the reservation. Possible values include: 'P1Y', 'P3Y', 'P5Y'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Term").Transform(transform.ToString),
+			},
+			{
+				Name:        "billing_plan",
+				Description: "The billing plan of the reservation, 'Upfront' or 'Monthly'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.BillingPlan").Transform(transform.ToString),
+			},
+			{
+				Name:        "billing_scope_id",
+				Description: "The subscription billed for the reservation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.BillingScopeID"),
+			},
+			{
+				Name:        "applied_scope_type",
+				Description: "The type of the scope the reservation applies to, 'Single' or 'Shared'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.AppliedScopeType").Transform(transform.ToString),
+			},
+			{
+				Name:        "user_friendly_applied_scope_type",
+				Description: "The type of the scope the reservation applies to, as displayed in the portal. Possible values include: 'Single', 'Shared', 'ResourceGroup', 'ManagementGroup', 'None'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.UserFriendlyAppliedScopeType"),
+			},
+			{
+				Name:        "applied_scopes",
+				Description: "The scopes the reservation applies to, if it applies to a single scope.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.AppliedScopes"),
+			},
+			{
+				Name:        "instance_flexibility",
+				Description: "Indicates whether the reservation applies to the other sizes of the same size group, 'On' or 'Off'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.InstanceFlexibility").Transform(transform.ToString),
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the reservation, for example 'Succeeded', 'Expired' or 'Cancelled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ProvisioningState").Transform(transform.ToString),
+			},
+			{
+				Name:        "display_provisioning_state",
+				Description: "The provisioning state of the reservation, as displayed in the portal, for example 'Succeeded', 'Expiring' or 'Expired'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DisplayProvisioningState"),
+			},
+			{
+				Name:        "purchase_date",
+				Description: "The date when the reservation was purchased.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.PurchaseDate").Transform(convertDateOnlyToTime),
+			},
+			{
+				Name:        "benefit_start_time",
+				Description: "The time when the benefit of the reservation started.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.BenefitStartTime").Transform(convertDateToTime),
+			},
+			{
+				Name:        "effective_date_time",
+				Description: "The time when the reservation became effective.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.EffectiveDateTime").Transform(convertDateToTime),
+			},
+			{
+				Name:        "expiry_date",
+				Description: "The date when the reservation expires.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.ExpiryDate").Transform(convertDateOnlyToTime),
+			},
+			{
+				Name:        "last_updated_date_time",
+				Description: "The time when the reservation was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.LastUpdatedDateTime").Transform(convertDateToTime),
+			},
+			{
+				Name:        "renew",
+				Description: "Indicates whether the reservation is automatically renewed when it expires, or not.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.Renew"),
+			},
+			{
+				Name:        "archived",
+				Description: "Indicates whether the reservation is archived, or not.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.Archived"),
+			},
+			{
+				Name:        "utilization_trend",
+				Description: "The trend of the utilization of the reservation, 'UP', 'DOWN' or 'SAME'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Utilization.Trend"),
+			},
+			{
+				Name:        "utilization_1_day",
+				Description: "The percentage of the reservation used over the last day.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Properties.Utilization").TransformP(reservationUtilizationAggregate, float64(1)),
+			},
+			{
+				Name:        "utilization_7_day",
+				Description: "The percentage of the reservation used over the last 7 days.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Properties.Utilization").TransformP(reservationUtilizationAggregate, float64(7)),
+			},
+			{
+				Name:        "utilization_30_day",
+				Description: "The percentage of the reservation used over the last 30 days.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Properties.Utilization").TransformP(reservationUtilizationAggregate, float64(30)),
+			},
+			{
+				Name:        "utilization_aggregates",
+				Description: "The aggregates of the utilization of the reservation.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Utilization.Aggregates"),
+			},
+			{
+				Name:        "extended_status_info",
+				Description: "The extended status of the reservation.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.ExtendedStatusInfo"),
+			},
+			{
+				Name:        "renew_properties",
+				Description: "The properties of the renewal of the reservation.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.RenewProperties"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DisplayName", "Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listReservations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_reservation.listReservations", "session_error", err)
+		return nil, err
+	}
+
+	client := reservations.NewClientWithBaseURI(session.ResourceManagerEndpoint)
+	client.Authorizer = session.Authorizer
+
+	// The reservations of all the reservation orders the user has access to are listed,
+	// along with the summary of their utilization
+	result, err := client.ListAll(ctx, "", "", "", nil, "", nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_reservation.listReservations", "api_error", err)
+		return nil, err
+	}
+
+	for _, reservation := range result.Values() {
+		d.StreamListItem(ctx, reservation)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_reservation.listReservations", "api_paging_error", err)
+			return nil, err
+		}
+		for _, reservation := range result.Values() {
+			d.StreamListItem(ctx, reservation)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getReservation(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	orderID := d.EqualsQualString("reservation_order_id")
+	name := d.EqualsQualString("name")
+
+	// Return nil, if no input provided
+	if orderID == "" || name == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_reservation.getReservation", "session_error", err)
+		return nil, err
+	}
+
+	client := reservations.NewClientWithBaseURI(session.ResourceManagerEndpoint)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, name, orderID, "renewProperties")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_reservation.getReservation", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// reservationUtilizationAggregate returns the utilization percentage of the aggregate
+// whose grain is the number of days in the param
+func reservationUtilizationAggregate(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	utilization, ok := d.Value.(*reservations.PropertiesUtilization)
+	if !ok || utilization == nil || utilization.Aggregates == nil {
+		return nil, nil
+	}
+
+	grain := d.Param.(float64)
+	for _, aggregate := range *utilization.Aggregates {
+		if aggregate.Grain != nil && *aggregate.Grain == grain {
+			return aggregate.Value, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func convertDateOnlyToTime(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	value, ok := d.Value.(*date.Date)
+	if !ok || value == nil {
+		return nil, nil
+	}
+	return value.ToTime(), nil
+}
+
+func extractReservationOrderIDFromID(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	id, ok := d.Value.(*string)
+	if !ok || id == nil {
+		return nil, nil
+	}
+
+	// The ID of a reservation is /providers/Microsoft.Capacity/reservationOrders/{orderId}/reservations/{reservationId}
+	parts := strings.Split(*id, "/")
+	for i, part := range parts {
+		if strings.EqualFold(part, "reservationOrders") && i+1 < len(parts) {
+			return parts[i+1], nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,224 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/reservations/mgmt/reservations"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureReservationOrder(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_reservation_order",
+		Description: "Azure Reservation Order",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getReservationOrder,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ReservationOrderNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listReservationOrders,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The ID of the reservation order.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The fully qualified ID of the reservation order.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the reservation order.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OrderProperties.DisplayName"),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource (Microsoft.Capacity/reservationOrders).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the reservation order, for example 'Succeeded', 'Expired' or 'Cancelled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OrderProperties.ProvisioningState").Transform(transform.ToString),
+			},
+			{
+				Name:        "term",
+				Description: "The term of the reservation order. Possible values include: 'P1Y', 'P3Y', 'P5Y'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OrderProperties.Term").Transform(transform.ToString),
+			},
+			{
+				Name:        "billing_plan",
+				Description: "The billing plan of the reservation order, 'Upfront' or 'Monthly'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OrderProperties.BillingPlan").Transform(transform.ToString),
+			},
+			{
+				Name:        "original_quantity",
+				Description: "The number of instances purchased by the reservation order.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("OrderProperties.OriginalQuantity"),
+			},
+			{
+				Name:        "request_date_time",
+				Description: "The time when the reservation order was requested.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("OrderProperties.RequestDateTime").Transform(convertDateToTime),
+			},
+			{
+				Name:        "created_date_time",
+				Description: "The time when the reservation order was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("OrderProperties.CreatedDateTime").Transform(convertDateToTime),
+			},
+			{
+				Name:        "benefit_start_time",
+				Description: "The time when the benefit of the reservation order started.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("OrderProperties.BenefitStartTime").Transform(convertDateToTime),
+			},
+			{
+				Name:        "expiry_date",
+				Description: "The date when the reservation order expires.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("OrderProperties.ExpiryDate").Transform(convertDateOnlyToTime),
+			},
+			{
+				Name:        "plan_information",
+				Description: "The amounts and the payment schedule of the reservation order, if it is billed monthly.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("OrderProperties.PlanInformation"),
+			},
+			{
+				Name:        "reservations",
+				Description: "The IDs of the reservations of the reservation order.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("OrderProperties.ReservationsProperty").Transform(reservationOrderReservationIDs),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OrderProperties.DisplayName", "Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listReservationOrders(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_reservation_order.listReservationOrders", "session_error", err)
+		return nil, err
+	}
+
+	client := reservations.NewOrderClientWithBaseURI(session.ResourceManagerEndpoint)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_reservation_order.listReservationOrders", "api_error", err)
+		return nil, err
+	}
+
+	for _, order := range result.Values() {
+		d.StreamListItem(ctx, order)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_reservation_order.listReservationOrders", "api_paging_error", err)
+			return nil, err
+		}
+		for _, order := range result.Values() {
+			d.StreamListItem(ctx, order)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getReservationOrder(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Return nil, if no input provided
+	if name == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_reservation_order.getReservationOrder", "session_error", err)
+		return nil, err
+	}
+
+	client := reservations.NewOrderClientWithBaseURI(session.ResourceManagerEndpoint)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, name, "schedule")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_reservation_order.getReservationOrder", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func reservationOrderReservationIDs(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	items, ok := d.Value.(*[]reservations.Response)
+	if !ok || items == nil {
+		return nil, nil
+	}
+
+	ids := []string{}
+	for _, item := range *items {
+		if item.ID != nil {
+			ids = append(ids, *item.ID)
+		}
+	}
+	return ids, nil
+}
@@ -0,0 +1,363 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/consumption/mgmt/consumption"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureReservationRecommendation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_reservation_recommendation",
+		Description: "Azure Reservation Recommendation, the reservations recommended for purchase from the usage of a scope.",
+		List: &plugin.ListConfig{
+			Hydrate: listReservationRecommendations,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "scope",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "recommendation_scope",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "resource_type",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "look_back_period",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the recommendation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the recommendation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "kind",
+				Description: "The kind of the recommendation, 'legacy' for the Enterprise Agreement and pay-as-you-go subscriptions or 'modern' for the Microsoft Customer Agreement ones.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Kind").Transform(transform.ToString),
+			},
+			{
+				Name:        "sku_name",
+				Description: "The SKU recommended for purchase, for example 'Standard_D2s_v3'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the resource of the recommendation, for example 'VirtualMachines', 'SQLDatabases' or 'CosmosDB'. Defaults to 'VirtualMachines'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "recommendation_scope",
+				Description: "The scope the recommended reservation would apply to, 'Single' or 'Shared'. Defaults to 'Single'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "look_back_period",
+				Description: "The period of the usage the recommendation is computed from, 'Last7Days', 'Last30Days' or 'Last60Days'. Defaults to 'Last7Days'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "term",
+				Description: "The term of the recommended reservation, 'P1Y' or 'P3Y'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "recommended_quantity",
+				Description: "The number of instances recommended for purchase.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "recommended_quantity_normalized",
+				Description: "The number of instances recommended for purchase, normalized to the smallest size of the instance flexibility group.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "normalized_size",
+				Description: "The smallest size of the instance flexibility group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "instance_flexibility_group",
+				Description: "The instance flexibility group of the SKU.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "instance_flexibility_ratio",
+				Description: "The ratio of the SKU to the normalized size of its instance flexibility group.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "cost_with_no_reserved_instances",
+				Description: "The cost of the usage over the look back period, without reserved instances.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "total_cost_with_reserved_instances",
+				Description: "The cost of the usage over the look back period, with the recommended reserved instances.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "net_savings",
+				Description: "The savings over the look back period, if the recommended reserved instances had been purchased.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "currency",
+				Description: "The currency of the costs. It is only returned for the modern recommendations.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "first_usage_date",
+				Description: "The date of the first usage the recommendation is computed from.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("FirstUsageDate").Transform(convertDateToTime),
+			},
+			{
+				Name:        "meter_id",
+				Description: "The ID of the meter of the recommendation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MeterID"),
+			},
+			{
+				Name:        "sku_properties",
+				Description: "The properties of the SKU, such as its cores and memory.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "scope",
+				Description: "The scope of the recommendations, for example '/subscriptions/{subscriptionId}' or '/providers/Microsoft.Billing/billingAccounts/{billingAccountId}'. Defaults to the subscription of the connection.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SkuName", "Name"),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+		}),
+	}
+}
+
+type ReservationRecommendationInfo struct {
+	ID                             *string
+	Name                           *string
+	Kind                           consumption.KindBasicReservationRecommendation
+	Location                       *string
+	SkuName                        *string
+	ResourceType                   *string
+	RecommendationScope            *string
+	LookBackPeriod                 *string
+	Term                           *string
+	RecommendedQuantity            *float64
+	RecommendedQuantityNormalized  *float64
+	NormalizedSize                 *string
+	InstanceFlexibilityGroup       *string
+	InstanceFlexibilityRatio       *float64
+	CostWithNoReservedInstances    *float64
+	TotalCostWithReservedInstances *float64
+	NetSavings                     *float64
+	Currency                       *string
+	FirstUsageDate                 *date.Time
+	MeterID                        *string
+	SkuProperties                  *[]consumption.SkuProperty
+	Scope                          string
+}
+
+//// LIST FUNCTION
+
+func listReservationRecommendations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_reservation_recommendation.listReservationRecommendations", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := consumption.NewReservationRecommendationsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	scope := "/subscriptions/" + subscriptionID
+	if d.EqualsQualString("scope") != "" {
+		scope = d.EqualsQualString("scope")
+	}
+
+	filter := buildReservationRecommendationFilter(d.Quals)
+	result, err := client.List(ctx, scope, filter)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_reservation_recommendation.listReservationRecommendations", "api_error", err)
+		return nil, err
+	}
+
+	for _, recommendation := range result.Values() {
+		if info := reservationRecommendationInfo(d, recommendation, scope); info != nil {
+			d.StreamListItem(ctx, info)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_reservation_recommendation.listReservationRecommendations", "api_paging_error", err)
+			return nil, err
+		}
+		for _, recommendation := range result.Values() {
+			if info := reservationRecommendationInfo(d, recommendation, scope); info != nil {
+				d.StreamListItem(ctx, info)
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+func buildReservationRecommendationFilter(quals plugin.KeyColumnQualMap) string {
+	filterQuals := map[string]string{
+		"recommendation_scope": "properties/scope",
+		"resource_type":        "properties/resourceType",
+		"look_back_period":     "properties/lookBackPeriod",
+	}
+
+	filters := []string{}
+	for columnName, filterName := range filterQuals {
+		if quals[columnName] != nil {
+			for _, q := range quals[columnName].Quals {
+				if q.Operator == "=" {
+					filters = append(filters, filterName+" eq '"+q.Value.GetStringValue()+"'")
+				}
+			}
+		}
+	}
+
+	return strings.Join(filters, " and ")
+}
+
+// reservationRecommendationInfo flattens the properties of the legacy and the modern
+// recommendations. The filtered properties are returned as given in the quals, since
+// the recommendations do not always return them.
+func reservationRecommendationInfo(d *plugin.QueryData, recommendation consumption.BasicReservationRecommendation, scope string) *ReservationRecommendationInfo {
+	info := &ReservationRecommendationInfo{Scope: scope}
+
+	if legacy, ok := recommendation.AsLegacyReservationRecommendation(); ok {
+		info.ID = legacy.ID
+		info.Name = legacy.Name
+		info.Kind = legacy.Kind
+		info.Location = legacy.Location
+		info.SkuName = legacy.Sku
+		if p := legacy.LegacyReservationRecommendationProperties; p != nil {
+			info.ResourceType = p.ResourceType
+			info.RecommendationScope = p.Scope
+			info.LookBackPeriod = p.LookBackPeriod
+			info.Term = p.Term
+			info.RecommendedQuantity = decimalToFloat64(p.RecommendedQuantity)
+			info.RecommendedQuantityNormalized = p.RecommendedQuantityNormalized
+			info.NormalizedSize = p.NormalizedSize
+			info.InstanceFlexibilityGroup = p.InstanceFlexibilityGroup
+			info.InstanceFlexibilityRatio = p.InstanceFlexibilityRatio
+			info.CostWithNoReservedInstances = decimalToFloat64(p.CostWithNoReservedInstances)
+			info.TotalCostWithReservedInstances = decimalToFloat64(p.TotalCostWithReservedInstances)
+			info.NetSavings = decimalToFloat64(p.NetSavings)
+			info.FirstUsageDate = p.FirstUsageDate
+			if p.MeterID != nil {
+				meterID := p.MeterID.String()
+				info.MeterID = &meterID
+			}
+			info.SkuProperties = p.SkuProperties
+		}
+	} else if modern, ok := recommendation.AsModernReservationRecommendation(); ok {
+		info.ID = modern.ID
+		info.Name = modern.Name
+		info.Kind = modern.Kind
+		info.Location = modern.Location
+		info.SkuName = modern.Sku
+		if p := modern.ModernReservationRecommendationProperties; p != nil {
+			if p.SkuName != nil {
+				info.SkuName = p.SkuName
+			}
+			info.ResourceType = p.ResourceType
+			info.RecommendationScope = p.Scope
+			if p.LookBackPeriod != nil {
+				lookBackPeriod := fmt.Sprintf("Last%dDays", *p.LookBackPeriod)
+				info.LookBackPeriod = &lookBackPeriod
+			}
+			info.Term = p.Term
+			info.RecommendedQuantity = decimalToFloat64(p.RecommendedQuantity)
+			info.RecommendedQuantityNormalized = p.RecommendedQuantityNormalized
+			info.NormalizedSize = p.NormalizedSize
+			info.InstanceFlexibilityGroup = p.InstanceFlexibilityGroup
+			info.InstanceFlexibilityRatio = p.InstanceFlexibilityRatio
+			if p.CostWithNoReservedInstances != nil {
+				info.CostWithNoReservedInstances = decimalToFloat64(p.CostWithNoReservedInstances.Value)
+				info.Currency = p.CostWithNoReservedInstances.Currency
+			}
+			if p.TotalCostWithReservedInstances != nil {
+				info.TotalCostWithReservedInstances = decimalToFloat64(p.TotalCostWithReservedInstances.Value)
+			}
+			if p.NetSavings != nil {
+				info.NetSavings = decimalToFloat64(p.NetSavings.Value)
+			}
+			info.FirstUsageDate = p.FirstUsageDate
+			if p.MeterID != nil {
+				meterID := p.MeterID.String()
+				info.MeterID = &meterID
+			}
+			info.SkuProperties = p.SkuProperties
+		}
+	} else {
+		return nil
+	}
+
+	if value := d.EqualsQualString("recommendation_scope"); value != "" {
+		info.RecommendationScope = &value
+	}
+	if value := d.EqualsQualString("resource_type"); value != "" {
+		info.ResourceType = &value
+	}
+	if value := d.EqualsQualString("look_back_period"); value != "" {
+		info.LookBackPeriod = &value
+	}
+
+	return info
+}
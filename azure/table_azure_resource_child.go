@@ -0,0 +1,282 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/resources/mgmt/resources"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureResourceChild(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_resource_child",
+		Description: "Azure Resource Child, the child resources of a given type of a parent resource, for the nested resources without a dedicated table.",
+		List: &plugin.ListConfig{
+			Hydrate: listResourceChildren,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "resource_id",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "resource_type",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "api_version",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the child resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the child resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the child resource, as returned by its resource provider.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_id",
+				Description: "The ID of the parent resource the child resources are listed for.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ParentID"),
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the child resources to list, either in full (for example 'Microsoft.Sql/servers/firewallRules'), relative to the type of the parent resource (for example 'firewallRules'), or an extension resource type of another provider (for example 'Microsoft.Insights/diagnosticSettings').",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ChildType"),
+			},
+			{
+				Name:        "api_version",
+				Description: "The API version the child resources are listed with. Defaults to the latest stable API version of the resource type, or to its latest preview API version if it has no stable one.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("APIVersion"),
+			},
+			{
+				Name:        "kind",
+				Description: "The kind of the child resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "etag",
+				Description: "An unique read-only string that changes whenever the resource is updated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "properties",
+				Description: "The properties of the child resource, which depend on its type.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "sku",
+				Description: "The SKU of the child resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "identity",
+				Description: "The managed identity of the child resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "system_data",
+				Description: "The metadata of the creation and the last modification of the child resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromSubAssessmentID),
+			},
+		}),
+	}
+}
+
+type ResourceChild struct {
+	ID         *string            `json:"id,omitempty"`
+	Name       *string            `json:"name,omitempty"`
+	Type       *string            `json:"type,omitempty"`
+	Kind       *string            `json:"kind,omitempty"`
+	Etag       *string            `json:"etag,omitempty"`
+	Location   *string            `json:"location,omitempty"`
+	Tags       map[string]*string `json:"tags,omitempty"`
+	Properties interface{}        `json:"properties,omitempty"`
+	Sku        interface{}        `json:"sku,omitempty"`
+	Identity   interface{}        `json:"identity,omitempty"`
+	SystemData interface{}        `json:"systemData,omitempty"`
+	ParentID   string             `json:"-"`
+	ChildType  string             `json:"-"`
+	APIVersion string             `json:"-"`
+}
+
+//// LIST FUNCTION
+
+func listResourceChildren(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	parentID := strings.TrimSuffix(d.EqualsQualString("resource_id"), "/")
+	childType := strings.Trim(d.EqualsQualString("resource_type"), "/")
+
+	// Return nil, if no input provided
+	if parentID == "" || childType == "" {
+		return nil, nil
+	}
+
+	path, namespace, resourceType, err := resourceChildPath(parentID, childType)
+	if err != nil {
+		return nil, err
+	}
+
+	apiVersion := d.EqualsQualString("api_version")
+	if apiVersion == "" {
+		apiVersion, err = getResourceTypeAPIVersion(ctx, d, namespace, resourceType)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_resource_child.listResourceChildren", "api_version_error", err)
+			return nil, err
+		}
+	}
+
+	err = listResourceManagerResources(ctx, d, path, apiVersion, func(items []json.RawMessage) (bool, error) {
+		for _, item := range items {
+			var child ResourceChild
+			if err := json.Unmarshal(item, &child); err != nil {
+				return false, err
+			}
+			// The quals are kept as given, as they are checked again against the rows
+			child.ParentID = d.EqualsQualString("resource_id")
+			child.ChildType = d.EqualsQualString("resource_type")
+			child.APIVersion = apiVersion
+			d.StreamListItem(ctx, child)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_resource_child.listResourceChildren", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// resourceChildPath returns the path of the collection of the child resources of type childType
+// of the resource parentID, along with the namespace and the type of the child resources within
+// their provider
+func resourceChildPath(parentID string, childType string) (string, string, string, error) {
+	parentType := resourceTypeFromID(parentID)
+	if parentType == "" {
+		return "", "", "", fmt.Errorf("resource_id must be the ID of a resource, got '%s'", parentID)
+	}
+
+	// Child types relative to the parent type are expanded in the namespace of the parent
+	if !strings.Contains(childType, ".") {
+		childType = parentType + "/" + childType
+	}
+
+	segments := strings.Split(childType, "/")
+	if len(segments) < 2 {
+		return "", "", "", fmt.Errorf("resource_type must be a resource type, got '%s'", childType)
+	}
+	namespace := segments[0]
+
+	// Child resources are nested in the parent path, while extension resources of other
+	// providers are listed under the providers segment of the parent
+	if strings.HasPrefix(strings.ToLower(childType), strings.ToLower(parentType)+"/") {
+		childSegments := strings.Split(childType[len(parentType)+1:], "/")
+		if len(childSegments) != 1 {
+			return "", "", "", fmt.Errorf("resource_type '%s' is not a direct child type of '%s'", childType, parentType)
+		}
+		return parentID + "/" + childSegments[0], namespace, strings.Join(segments[1:], "/"), nil
+	}
+	return parentID + "/providers/" + childType, namespace, strings.Join(segments[1:], "/"), nil
+}
+
+// getResourceTypeAPIVersion returns the latest stable API version of a resource type, or its latest
+// preview API version if it has no stable one
+func getResourceTypeAPIVersion(ctx context.Context, d *plugin.QueryData, namespace string, resourceType string) (string, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return "", err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := resources.NewProvidersClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	provider, err := client.Get(ctx, namespace, "")
+	if err != nil {
+		return "", err
+	}
+
+	if provider.ResourceTypes != nil {
+		for _, providerType := range *provider.ResourceTypes {
+			if providerType.ResourceType == nil || !strings.EqualFold(*providerType.ResourceType, resourceType) || providerType.APIVersions == nil {
+				continue
+			}
+
+			// API versions are dates, optionally suffixed, so they sort lexically
+			versions := append([]string{}, *providerType.APIVersions...)
+			sort.Sort(sort.Reverse(sort.StringSlice(versions)))
+			for _, version := range versions {
+				if !strings.Contains(version, "-preview") {
+					return version, nil
+				}
+			}
+			if len(versions) > 0 {
+				return versions[0], nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("no API version found for resource type '%s/%s'", namespace, resourceType)
+}
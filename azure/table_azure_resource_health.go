@@ -0,0 +1,285 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/resourcehealth/mgmt/resourcehealth"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureResourceHealth(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_resource_health",
+		Description: "Azure Resource Health, the current availability status of the resources of the subscription.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("resource_id"),
+			Hydrate:    getResourceHealth,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listResourceHealths,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "resource_group",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "resource_id",
+				Description: "The ID of the resource of the availability status.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceHealthResourceID),
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the availability status.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the availability status, 'current'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource (Microsoft.ResourceHealth/AvailabilityStatuses).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "availability_state",
+				Description: "The availability state of the resource, 'Available', 'Degraded', 'Unavailable' or 'Unknown'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.AvailabilityState").Transform(transform.ToString),
+			},
+			{
+				Name:        "summary",
+				Description: "The summary of the availability status.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Summary"),
+			},
+			{
+				Name:        "detailed_status",
+				Description: "The details of the availability status.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DetailedStatus"),
+			},
+			{
+				Name:        "reason_type",
+				Description: "The reason of the availability status, for example 'Unplanned', 'Planned' or 'UserInitiated'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ReasonType"),
+			},
+			{
+				Name:        "reason_chronicity",
+				Description: "Indicates whether the availability status is 'Transient' or 'Persistent'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ReasonChronicity").Transform(transform.ToString),
+			},
+			{
+				Name:        "occurred_time",
+				Description: "The time when the availability state of the resource last changed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.OccurredTime").Transform(convertDateToTime),
+			},
+			{
+				Name:        "reported_time",
+				Description: "The time when the availability status was last checked.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.ReportedTime").Transform(convertDateToTime),
+			},
+			{
+				Name:        "root_cause_attribution_time",
+				Description: "The time when the root cause of the unavailability was attributed, if it was caused by a platform event.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.RootCauseAttributionTime").Transform(convertDateToTime),
+			},
+			{
+				Name:        "resolution_eta",
+				Description: "The estimated time when the resource will be available again, if it is unavailable because of a platform event.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.ResolutionETA").Transform(convertDateToTime),
+			},
+			{
+				Name:        "health_event_id",
+				Description: "The ID of the service health event causing the availability status, if any.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.HealthEventID"),
+			},
+			{
+				Name:        "health_event_type",
+				Description: "The type of the service health event causing the availability status.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.HealthEventType"),
+			},
+			{
+				Name:        "health_event_cause",
+				Description: "The cause of the service health event causing the availability status.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.HealthEventCause"),
+			},
+			{
+				Name:        "health_event_category",
+				Description: "The category of the service health event causing the availability status.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.HealthEventCategory"),
+			},
+			{
+				Name:        "recently_resolved",
+				Description: "The last unavailability of the resource, if it has recently been resolved.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.RecentlyResolved"),
+			},
+			{
+				Name:        "recommended_actions",
+				Description: "The actions recommended to restore the availability of the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.RecommendedActions"),
+			},
+			{
+				Name:        "service_impacting_events",
+				Description: "The service health events impacting the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.ServiceImpactingEvents"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Title", "Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listResourceHealths(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_resource_health.listResourceHealths", "session_error", err)
+		return nil, err
+	}
+
+	client := resourcehealth.NewAvailabilityStatusesClientWithBaseURI(session.ResourceManagerEndpoint, session.SubscriptionID)
+	client.Authorizer = session.Authorizer
+
+	var result resourcehealth.AvailabilityStatusListResultPage
+	if resourceGroup := d.EqualsQualString("resource_group"); resourceGroup != "" {
+		result, err = client.ListByResourceGroup(ctx, resourceGroup, "", "recommendedactions")
+	} else {
+		result, err = client.ListBySubscriptionID(ctx, "", "recommendedactions")
+	}
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_resource_health.listResourceHealths", "api_error", err)
+		return nil, err
+	}
+
+	for _, status := range result.Values() {
+		d.StreamListItem(ctx, status)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_resource_health.listResourceHealths", "api_paging_error", err)
+			return nil, err
+		}
+		for _, status := range result.Values() {
+			d.StreamListItem(ctx, status)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getResourceHealth(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	resourceID := d.EqualsQualString("resource_id")
+
+	// Return nil, if no input provided
+	if resourceID == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_resource_health.getResourceHealth", "session_error", err)
+		return nil, err
+	}
+
+	client := resourcehealth.NewAvailabilityStatusesClientWithBaseURI(session.ResourceManagerEndpoint, session.SubscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.GetByResource(ctx, strings.TrimPrefix(resourceID, "/"), "", "recommendedactions")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_resource_health.getResourceHealth", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// The ID of an availability status is {resourceId}/providers/Microsoft.ResourceHealth/availabilityStatuses/current
+func extractResourceHealthResourceID(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	id, ok := d.Value.(*string)
+	if !ok || id == nil {
+		return nil, nil
+	}
+
+	if i := strings.Index(strings.ToLower(*id), "/providers/microsoft.resourcehealth/"); i > 0 {
+		return (*id)[:i], nil
+	}
+
+	return nil, nil
+}
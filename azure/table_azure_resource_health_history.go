@@ -0,0 +1,273 @@
+package azure
+
+import (
+	"context"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resourcehealth/mgmt/2020-05-01/resourcehealth"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureResourceHealthHistory(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_resource_health_history",
+		Description: "Azure Resource Health History, the availability status history of a resource over the last 30 days.",
+		List: &plugin.ListConfig{
+			Hydrate: listResourceHealthHistory,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "resource_id",
+					Require: plugin.Required,
+				},
+				{
+					Name:      "occurred_time",
+					Require:   plugin.Optional,
+					Operators: []string{">", "<", ">=", "<="},
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "resource_id",
+				Description: "The resource ID of the resource the availability status belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("resource_id"),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the availability status.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the availability status.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "availability_state",
+				Description: "The availability state of the resource. Possible values include: 'Available', 'Unavailable', 'Degraded', 'Unknown'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.AvailabilityState"),
+			},
+			{
+				Name:        "occurred_time",
+				Description: "The time the availability state changed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.OccurredTime").Transform(convertDateToTime),
+			},
+			{
+				Name:        "reported_time",
+				Description: "The time the availability status was reported.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.ReportedTime").Transform(convertDateToTime),
+			},
+			{
+				Name:        "summary",
+				Description: "The summary of the availability status.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Summary"),
+			},
+			{
+				Name:        "detailed_status",
+				Description: "The details of the availability status.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DetailedStatus"),
+			},
+			{
+				Name:        "reason_type",
+				Description: "The reason of the availability state, for example 'Unplanned', 'Planned' or 'UserInitiated'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ReasonType"),
+			},
+			{
+				Name:        "reason_chronicity",
+				Description: "Indicates whether the availability state is transient or persistent. Possible values include: 'Transient', 'Persistent'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ReasonChronicity"),
+			},
+			{
+				Name:        "health_event_type",
+				Description: "The type of the health event that caused the availability state.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.HealthEventType"),
+			},
+			{
+				Name:        "health_event_cause",
+				Description: "The cause of the health event, for example 'PlatformInitiated' or 'UserInitiated'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.HealthEventCause"),
+			},
+			{
+				Name:        "health_event_category",
+				Description: "The category of the health event.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.HealthEventCategory"),
+			},
+			{
+				Name:        "health_event_id",
+				Description: "The ID of the health event.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.HealthEventID"),
+			},
+			{
+				Name:        "root_cause_attribution_time",
+				Description: "The time the root cause of the health event was attributed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.RootCauseAttributionTime").Transform(convertDateToTime),
+			},
+			{
+				Name:        "resolution_eta",
+				Description: "The estimated time the health event will be resolved.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.ResolutionETA").Transform(convertDateToTime),
+			},
+			{
+				Name:        "recently_resolved",
+				Description: "The details of the unavailability that was recently resolved, if any.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.RecentlyResolved"),
+			},
+			{
+				Name:        "recommended_actions",
+				Description: "The actions recommended to recover the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.RecommendedActions"),
+			},
+			{
+				Name:        "service_impacting_events",
+				Description: "The service health events that impacted the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.ServiceImpactingEvents"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Title"),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("resource_id").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listResourceHealthHistory(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	resourceID := d.EqualsQualString("resource_id")
+	if resourceID == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_resource_health_history.listResourceHealthHistory", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := resourcehealth.NewAvailabilityStatusesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	// The API returns the history of the last 30 days and doesn't support filtering on time,
+	// so rows outside the requested time range are skipped before being streamed
+	from, to := resourceHealthHistoryTimeRange(d.Quals)
+
+	result, err := client.List(ctx, resourceID, "", "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_resource_health_history.listResourceHealthHistory", "api_error", err)
+		return nil, err
+	}
+
+	for _, status := range result.Values() {
+		if !isResourceHealthStatusInRange(status, from, to) {
+			continue
+		}
+		d.StreamListItem(ctx, status)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_resource_health_history.listResourceHealthHistory", "api_paging_error", err)
+			return nil, err
+		}
+		for _, status := range result.Values() {
+			if !isResourceHealthStatusInRange(status, from, to) {
+				continue
+			}
+			d.StreamListItem(ctx, status)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// resourceHealthHistoryTimeRange returns the bounds of the occurred_time quals, zero if unbounded
+func resourceHealthHistoryTimeRange(quals plugin.KeyColumnQualMap) (time.Time, time.Time) {
+	var from, to time.Time
+	if quals["occurred_time"] == nil {
+		return from, to
+	}
+
+	for _, q := range quals["occurred_time"].Quals {
+		value := q.Value.GetTimestampValue().AsTime()
+		switch q.Operator {
+		case ">", ">=":
+			if from.IsZero() || value.After(from) {
+				from = value
+			}
+		case "<", "<=":
+			if to.IsZero() || value.Before(to) {
+				to = value
+			}
+		}
+	}
+	return from, to
+}
+
+func isResourceHealthStatusInRange(status resourcehealth.AvailabilityStatus, from time.Time, to time.Time) bool {
+	if status.Properties == nil || status.Properties.OccurredTime == nil {
+		return true
+	}
+
+	occurred := status.Properties.OccurredTime.ToTime()
+	if !from.IsZero() && occurred.Before(from) {
+		return false
+	}
+	if !to.IsZero() && occurred.After(to) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,275 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/resourcemover/mgmt/resourcemover"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureResourceMoverMoveCollection(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_resource_mover_move_collection",
+		Description: "Azure Resource Mover Move Collection",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getResourceMoverMoveCollection,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listResourceMoverMoveCollections,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the move collection.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "Fully qualified resource ID of the move collection.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "etag",
+				Description: "An unique read-only string that changes whenever the resource is updated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the move collection. Possible values include: 'Succeeded', 'Updating', 'Creating', 'Failed'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ProvisioningState"),
+			},
+			{
+				Name:        "source_region",
+				Description: "The region from which the resources are being moved.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.SourceRegion"),
+			},
+			{
+				Name:        "target_region",
+				Description: "The region to which the resources are being moved.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.TargetRegion"),
+			},
+			{
+				Name:        "errors",
+				Description: "The move collection errors.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Errors.Properties"),
+			},
+			{
+				Name:        "identity",
+				Description: "The managed identity assigned to the move collection.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "identity_type",
+				Description: "The type of managed identity used by the resource. Possible values include: 'SystemAssigned', 'UserAssigned', 'SystemAssigned, UserAssigned', 'None'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityType),
+			},
+			{
+				Name:        "system_assigned_principal_id",
+				Description: "The principal ID of the system-assigned managed identity of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityPrincipalID),
+			},
+			{
+				Name:        "user_assigned_identity_ids",
+				Description: "The resource IDs of the user-assigned managed identities associated with the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityUserAssignedIDs),
+			},
+			{
+				Name:        "move_resources",
+				Description: "The resources added to the move collection, along with their source and target IDs and current move state.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listResourceMoverMoveResources,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listResourceMoverMoveCollections(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_resource_mover_move_collection.listResourceMoverMoveCollections", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := resourcemover.NewMoveCollectionsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListMoveCollectionsBySubscription(ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_resource_mover_move_collection.listResourceMoverMoveCollections", "api_error", err)
+		return nil, err
+	}
+
+	for _, collection := range result.Values() {
+		d.StreamListItem(ctx, collection)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_resource_mover_move_collection.listResourceMoverMoveCollections", "api_paging_error", err)
+			return nil, err
+		}
+
+		for _, collection := range result.Values() {
+			d.StreamListItem(ctx, collection)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getResourceMoverMoveCollection(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_resource_mover_move_collection.getResourceMoverMoveCollection", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := resourcemover.NewMoveCollectionsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_resource_mover_move_collection.getResourceMoverMoveCollection", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+func listResourceMoverMoveResources(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	collection := h.Item.(resourcemover.MoveCollection)
+	resourceGroup := strings.Split(*collection.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_resource_mover_move_collection.listResourceMoverMoveResources", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := resourcemover.NewMoveResourcesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListComplete(ctx, resourceGroup, *collection.Name, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_resource_mover_move_collection.listResourceMoverMoveResources", "api_error", err)
+		return nil, err
+	}
+
+	var moveResources []map[string]interface{}
+	for result.NotDone() {
+		moveResource := result.Value()
+		objectMap := map[string]interface{}{
+			"id":   moveResource.ID,
+			"name": moveResource.Name,
+		}
+		if moveResource.Properties != nil {
+			objectMap["provisioningState"] = moveResource.Properties.ProvisioningState
+			objectMap["sourceId"] = moveResource.Properties.SourceID
+			objectMap["targetId"] = moveResource.Properties.TargetID
+			objectMap["existingTargetId"] = moveResource.Properties.ExistingTargetID
+			objectMap["isResolveRequired"] = moveResource.Properties.IsResolveRequired
+			objectMap["dependsOn"] = moveResource.Properties.DependsOn
+			if moveResource.Properties.MoveStatus != nil {
+				objectMap["moveState"] = moveResource.Properties.MoveStatus.MoveState
+				objectMap["jobStatus"] = moveResource.Properties.MoveStatus.JobStatus
+				objectMap["moveErrors"] = moveResource.Properties.MoveStatus.Errors
+			}
+		}
+		moveResources = append(moveResources, objectMap)
+
+		if err := result.NextWithContext(ctx); err != nil {
+			plugin.Logger(ctx).Error("azure_resource_mover_move_collection.listResourceMoverMoveResources", "api_paging_error", err)
+			return nil, err
+		}
+	}
+
+	return moveResources, nil
+}
@@ -0,0 +1,459 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/apimanagement/mgmt/apimanagement"
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/containerservice/mgmt/containerservice"
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/datafactory/mgmt/datafactory"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureResourceServicePrincipalCredential(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_resource_service_principal_credential",
+		Description: "Azure Resource Service Principal Credential, the credentials of the service principals referenced by resources, fetched from Microsoft Graph.",
+		List: &plugin.ListConfig{
+			Hydrate: listResourceServicePrincipalCredentials,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "reference_type",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "resource_id",
+				Description: "The ID of the resource referencing the service principal.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Reference.ResourceID"),
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the resource referencing the service principal.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Reference.ResourceType"),
+			},
+			{
+				Name:        "reference_type",
+				Description: "How the resource references the service principal. Possible values are: 'kubernetes_cluster_service_principal', 'api_management_identity', 'data_factory_linked_service'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Reference.ReferenceType"),
+			},
+			{
+				Name:        "app_id",
+				Description: "The application (client) ID of the service principal.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServicePrincipal.AppID"),
+			},
+			{
+				Name:        "service_principal_id",
+				Description: "The object ID of the service principal.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServicePrincipal.ID"),
+			},
+			{
+				Name:        "service_principal_name",
+				Description: "The display name of the service principal.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServicePrincipal.DisplayName"),
+			},
+			{
+				Name:        "service_principal_type",
+				Description: "The type of the service principal. Possible values include: 'Application', 'ManagedIdentity', 'Legacy'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServicePrincipal.ServicePrincipalType"),
+			},
+			{
+				Name:        "account_enabled",
+				Description: "Indicates whether the service principal account is enabled.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("ServicePrincipal.AccountEnabled"),
+			},
+			{
+				Name:        "credential_source",
+				Description: "The directory object the credential is defined on. Possible values are: 'application', 'service_principal'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "credential_type",
+				Description: "The type of the credential. Possible values include: 'Password', 'AsymmetricX509Cert', 'Symmetric'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "key_id",
+				Description: "The unique identifier of the credential.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "credential_display_name",
+				Description: "The friendly name of the credential.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+			{
+				Name:        "start_date_time",
+				Description: "The date and time at which the credential becomes valid.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "end_date_time",
+				Description: "The date and time at which the credential expires.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+		}),
+	}
+}
+
+type servicePrincipalReference struct {
+	ResourceID    string
+	ResourceType  string
+	ReferenceType string
+	// The service principal is identified either by its application ID or by its object ID
+	AppID    string
+	ObjectID string
+}
+
+type ServicePrincipalCredentialInfo struct {
+	Reference        servicePrincipalReference
+	ServicePrincipal *GraphServicePrincipal
+	CredentialSource string
+	CredentialType   string
+	KeyID            *string
+	DisplayName      *string
+	StartDateTime    *string
+	EndDateTime      *string
+}
+
+//// LIST FUNCTION
+
+func listResourceServicePrincipalCredentials(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	referenceType := d.EqualsQualString("reference_type")
+
+	var references []servicePrincipalReference
+	referenceLists := []struct {
+		referenceType string
+		list          func(context.Context, *plugin.QueryData) ([]servicePrincipalReference, error)
+	}{
+		{"kubernetes_cluster_service_principal", listKubernetesClusterServicePrincipalReferences},
+		{"api_management_identity", listAPIManagementServicePrincipalReferences},
+		{"data_factory_linked_service", listDataFactoryLinkedServiceServicePrincipalReferences},
+	}
+	for _, referenceList := range referenceLists {
+		if referenceType != "" && referenceType != referenceList.referenceType {
+			continue
+		}
+		items, err := referenceList.list(ctx, d)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_resource_service_principal_credential.listResourceServicePrincipalCredentials", "api_error", err)
+			return nil, err
+		}
+		references = append(references, items...)
+	}
+
+	graphClient, err := getMicrosoftGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_resource_service_principal_credential.listResourceServicePrincipalCredentials", "client_error", err)
+		return nil, err
+	}
+
+	// Several resources can reference the same service principal
+	servicePrincipals := map[string]*GraphServicePrincipal{}
+	applications := map[string]*GraphApplication{}
+
+	for _, reference := range references {
+		key := reference.AppID + "/" + reference.ObjectID
+		servicePrincipal, ok := servicePrincipals[key]
+		if !ok {
+			servicePrincipal, err = getGraphServicePrincipal(ctx, graphClient, reference)
+			if err != nil {
+				plugin.Logger(ctx).Error("azure_resource_service_principal_credential.listResourceServicePrincipalCredentials", "api_error", err)
+				return nil, err
+			}
+			servicePrincipals[key] = servicePrincipal
+		}
+		if servicePrincipal == nil {
+			continue
+		}
+
+		credentials := buildServicePrincipalCredentials(reference, servicePrincipal, "service_principal", servicePrincipal.PasswordCredentials, servicePrincipal.KeyCredentials)
+
+		// Secrets of app registrations are defined on the application object, which only
+		// exists in the tenant owning the application
+		if servicePrincipal.AppID != nil && types.SafeString(servicePrincipal.ServicePrincipalType) == "Application" {
+			application, ok := applications[*servicePrincipal.AppID]
+			if !ok {
+				application, err = getGraphApplication(ctx, graphClient, *servicePrincipal.AppID)
+				if err != nil {
+					plugin.Logger(ctx).Error("azure_resource_service_principal_credential.listResourceServicePrincipalCredentials", "api_error", err)
+					return nil, err
+				}
+				applications[*servicePrincipal.AppID] = application
+			}
+			if application != nil {
+				credentials = append(credentials, buildServicePrincipalCredentials(reference, servicePrincipal, "application", application.PasswordCredentials, application.KeyCredentials)...)
+			}
+		}
+
+		for _, credential := range credentials {
+			d.StreamListItem(ctx, credential)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func listKubernetesClusterServicePrincipalReferences(ctx context.Context, d *plugin.QueryData) ([]servicePrincipalReference, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := containerservice.NewManagedClustersClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListComplete(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var references []servicePrincipalReference
+	for result.NotDone() {
+		cluster := result.Value()
+		if cluster.ManagedClusterProperties != nil && cluster.ManagedClusterProperties.ServicePrincipalProfile != nil {
+			clientID := types.SafeString(cluster.ManagedClusterProperties.ServicePrincipalProfile.ClientID)
+			// Clusters using a managed identity report the client ID "msi"
+			if clientID != "" && clientID != "msi" {
+				references = append(references, servicePrincipalReference{
+					ResourceID:    *cluster.ID,
+					ResourceType:  types.SafeString(cluster.Type),
+					ReferenceType: "kubernetes_cluster_service_principal",
+					AppID:         clientID,
+				})
+			}
+		}
+		if err := result.NextWithContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return references, nil
+}
+
+func listAPIManagementServicePrincipalReferences(ctx context.Context, d *plugin.QueryData) ([]servicePrincipalReference, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := apimanagement.NewServiceClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListComplete(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var references []servicePrincipalReference
+	for result.NotDone() {
+		service := result.Value()
+		if service.Identity != nil {
+			if service.Identity.PrincipalID != nil {
+				references = append(references, servicePrincipalReference{
+					ResourceID:    *service.ID,
+					ResourceType:  types.SafeString(service.Type),
+					ReferenceType: "api_management_identity",
+					ObjectID:      service.Identity.PrincipalID.String(),
+				})
+			}
+			for _, identity := range service.Identity.UserAssignedIdentities {
+				if identity == nil || identity.PrincipalID == nil {
+					continue
+				}
+				references = append(references, servicePrincipalReference{
+					ResourceID:    *service.ID,
+					ResourceType:  types.SafeString(service.Type),
+					ReferenceType: "api_management_identity",
+					ObjectID:      *identity.PrincipalID,
+				})
+			}
+		}
+		if err := result.NextWithContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return references, nil
+}
+
+func listDataFactoryLinkedServiceServicePrincipalReferences(ctx context.Context, d *plugin.QueryData) ([]servicePrincipalReference, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	factoryClient := datafactory.NewFactoriesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	factoryClient.Authorizer = session.Authorizer
+
+	linkedServiceClient := datafactory.NewLinkedServicesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	linkedServiceClient.Authorizer = session.Authorizer
+
+	factories, err := factoryClient.ListComplete(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var references []servicePrincipalReference
+	for factories.NotDone() {
+		factory := factories.Value()
+		resourceGroup := strings.Split(*factory.ID, "/")[4]
+
+		linkedServices, err := linkedServiceClient.ListByFactoryComplete(ctx, resourceGroup, *factory.Name)
+		if err != nil {
+			return nil, err
+		}
+		for linkedServices.NotDone() {
+			linkedService := linkedServices.Value()
+			if appID := extractLinkedServiceServicePrincipalID(linkedService.Properties); appID != "" {
+				references = append(references, servicePrincipalReference{
+					ResourceID:    *linkedService.ID,
+					ResourceType:  types.SafeString(linkedService.Type),
+					ReferenceType: "data_factory_linked_service",
+					AppID:         appID,
+				})
+			}
+			if err := linkedServices.NextWithContext(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := factories.NextWithContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return references, nil
+}
+
+func getGraphServicePrincipal(ctx context.Context, client *MicrosoftGraphClient, reference servicePrincipalReference) (*GraphServicePrincipal, error) {
+	if reference.ObjectID != "" {
+		var servicePrincipal GraphServicePrincipal
+		if err := client.Get(ctx, "servicePrincipals/"+url.PathEscape(reference.ObjectID), nil, &servicePrincipal); err != nil {
+			// The credentials are only listed when the identity can read the service principal
+			// from Microsoft Graph
+			if isGraphNotFoundError(err) || isGraphAccessDeniedError(err) {
+				plugin.Logger(ctx).Debug("azure_resource_service_principal_credential.getGraphServicePrincipal", "object_id", reference.ObjectID, "unresolved", err)
+				return nil, nil
+			}
+			return nil, err
+		}
+		return &servicePrincipal, nil
+	}
+
+	query := url.Values{"$filter": []string{"appId eq " + graphStringLiteral(reference.AppID)}}
+	servicePrincipals, err := listMicrosoftGraphObjects[GraphServicePrincipal](ctx, client, "servicePrincipals", query)
+	if err != nil {
+		if isGraphAccessDeniedError(err) {
+			plugin.Logger(ctx).Debug("azure_resource_service_principal_credential.getGraphServicePrincipal", "app_id", reference.AppID, "unresolved", err)
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(servicePrincipals) == 0 {
+		return nil, nil
+	}
+	return &servicePrincipals[0], nil
+}
+
+func getGraphApplication(ctx context.Context, client *MicrosoftGraphClient, appID string) (*GraphApplication, error) {
+	query := url.Values{"$filter": []string{"appId eq " + graphStringLiteral(appID)}}
+	applications, err := listMicrosoftGraphObjects[GraphApplication](ctx, client, "applications", query)
+	if err != nil {
+		// The application object is only read when the identity is allowed to read it
+		if isGraphAccessDeniedError(err) {
+			plugin.Logger(ctx).Debug("azure_resource_service_principal_credential.getGraphApplication", "app_id", appID, "unresolved", err)
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(applications) == 0 {
+		return nil, nil
+	}
+	return &applications[0], nil
+}
+
+//// UTILITY FUNCTIONS
+
+func buildServicePrincipalCredentials(reference servicePrincipalReference, servicePrincipal *GraphServicePrincipal, source string, passwords []GraphPasswordCredential, keys []GraphKeyCredential) []ServicePrincipalCredentialInfo {
+	var credentials []ServicePrincipalCredentialInfo
+	for _, password := range passwords {
+		credentials = append(credentials, ServicePrincipalCredentialInfo{
+			Reference:        reference,
+			ServicePrincipal: servicePrincipal,
+			CredentialSource: source,
+			CredentialType:   "Password",
+			KeyID:            password.KeyID,
+			DisplayName:      password.DisplayName,
+			StartDateTime:    password.StartDateTime,
+			EndDateTime:      password.EndDateTime,
+		})
+	}
+	for _, key := range keys {
+		credentials = append(credentials, ServicePrincipalCredentialInfo{
+			Reference:        reference,
+			ServicePrincipal: servicePrincipal,
+			CredentialSource: source,
+			CredentialType:   types.SafeString(key.Type),
+			KeyID:            key.KeyID,
+			DisplayName:      key.DisplayName,
+			StartDateTime:    key.StartDateTime,
+			EndDateTime:      key.EndDateTime,
+		})
+	}
+	return credentials
+}
+
+// extractLinkedServiceServicePrincipalID returns the service principal a linked service authenticates with.
+// Linked services of many types support service principal authentication, so the ID is read from the
+// serialized type properties instead of from each linked service type.
+func extractLinkedServiceServicePrincipalID(properties datafactory.BasicLinkedService) string {
+	if properties == nil {
+		return ""
+	}
+	data, err := json.Marshal(properties)
+	if err != nil {
+		return ""
+	}
+
+	var linkedService struct {
+		TypeProperties map[string]interface{} `json:"typeProperties"`
+	}
+	if err := json.Unmarshal(data, &linkedService); err != nil {
+		return ""
+	}
+
+	// Parameterized values are expressions, which cannot be resolved
+	if servicePrincipalID, ok := linkedService.TypeProperties["servicePrincipalId"].(string); ok {
+		return servicePrincipalID
+	}
+	return ""
+}
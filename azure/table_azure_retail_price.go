@@ -0,0 +1,300 @@
+package azure
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureRetailPrice(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_retail_price",
+		Description: "Azure Retail Price, the list prices of the meters of the Azure services.",
+		List: &plugin.ListConfig{
+			Hydrate: listRetailPrices,
+			KeyColumns: plugin.OptionalColumns([]string{
+				"service_name",
+				"service_id",
+				"service_family",
+				"product_name",
+				"product_id",
+				"sku_name",
+				"sku_id",
+				"arm_sku_name",
+				"meter_name",
+				"meter_id",
+				"region",
+				"location",
+				"type",
+				"currency_code",
+			}),
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "meter_id",
+				Description: "The ID of the meter of the price.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MeterID"),
+			},
+			{
+				Name:        "meter_name",
+				Description: "The name of the meter of the price, for example 'D2s v3'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_name",
+				Description: "The name of the service, for example 'Virtual Machines'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_id",
+				Description: "The ID of the service.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServiceID"),
+			},
+			{
+				Name:        "service_family",
+				Description: "The family of the service, for example 'Compute', 'Storage' or 'Databases'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "product_name",
+				Description: "The name of the product, for example 'Virtual Machines DSv3 Series'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "product_id",
+				Description: "The ID of the product.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProductID"),
+			},
+			{
+				Name:        "sku_name",
+				Description: "The name of the SKU, for example 'D2s v3 Spot'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "sku_id",
+				Description: "The ID of the SKU, made of the product ID and the SKU.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SkuID"),
+			},
+			{
+				Name:        "arm_sku_name",
+				Description: "The name of the SKU in Resource Manager, for example 'Standard_D2s_v3'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the price, 'Consumption', 'Reservation' or 'DevTestConsumption'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "retail_price",
+				Description: "The list price of a unit, without any discount.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "unit_price",
+				Description: "The price of a unit, the same as the retail price.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "currency_code",
+				Description: "The currency of the prices. Defaults to 'USD'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "unit_of_measure",
+				Description: "The unit the price applies to, for example '1 Hour' or '1 GB/Month'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tier_minimum_units",
+				Description: "The minimum number of units of the pricing tier of the price.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "reservation_term",
+				Description: "The term of the reservation the price applies to, for example '1 Year' or '3 Years'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_primary_meter_region",
+				Description: "Indicates whether the region is the primary region of the meter, or not. The usage of a meter is billed at the price of its primary region.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "effective_start_date",
+				Description: "The date from which the price is effective.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "effective_end_date",
+				Description: "The date until which the price is effective.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "savings_plan",
+				Description: "The prices of the meter with a savings plan, for each term.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "location",
+				Description: "The display name of the region of the price, for example 'US East'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "region",
+				Description: "The name of the region of the price in Resource Manager, for example 'eastus'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ArmRegionName"),
+			},
+		},
+	}
+}
+
+// The Retail Prices API is public, it does not need any credential and is only available in the
+// Azure public cloud, whatever the environment of the connection
+const (
+	retailPricesEndpoint   = "https://prices.azure.com/api/retail/prices"
+	retailPricesAPIVersion = "2023-01-01-preview"
+)
+
+type RetailPrice struct {
+	CurrencyCode         *string                  `json:"currencyCode,omitempty"`
+	TierMinimumUnits     *float64                 `json:"tierMinimumUnits,omitempty"`
+	ReservationTerm      *string                  `json:"reservationTerm,omitempty"`
+	RetailPrice          *float64                 `json:"retailPrice,omitempty"`
+	UnitPrice            *float64                 `json:"unitPrice,omitempty"`
+	ArmRegionName        *string                  `json:"armRegionName,omitempty"`
+	Location             *string                  `json:"location,omitempty"`
+	EffectiveStartDate   *time.Time               `json:"effectiveStartDate,omitempty"`
+	EffectiveEndDate     *time.Time               `json:"effectiveEndDate,omitempty"`
+	MeterID              *string                  `json:"meterId,omitempty"`
+	MeterName            *string                  `json:"meterName,omitempty"`
+	ProductID            *string                  `json:"productId,omitempty"`
+	SkuID                *string                  `json:"skuId,omitempty"`
+	ProductName          *string                  `json:"productName,omitempty"`
+	SkuName              *string                  `json:"skuName,omitempty"`
+	ServiceName          *string                  `json:"serviceName,omitempty"`
+	ServiceID            *string                  `json:"serviceId,omitempty"`
+	ServiceFamily        *string                  `json:"serviceFamily,omitempty"`
+	UnitOfMeasure        *string                  `json:"unitOfMeasure,omitempty"`
+	Type                 *string                  `json:"type,omitempty"`
+	IsPrimaryMeterRegion *bool                    `json:"isPrimaryMeterRegion,omitempty"`
+	ArmSkuName           *string                  `json:"armSkuName,omitempty"`
+	SavingsPlan          []RetailPriceSavingsPlan `json:"savingsPlan,omitempty"`
+}
+
+type RetailPriceSavingsPlan struct {
+	UnitPrice   *float64 `json:"unitPrice,omitempty"`
+	RetailPrice *float64 `json:"retailPrice,omitempty"`
+	Term        *string  `json:"term,omitempty"`
+}
+
+type retailPricePage struct {
+	Items        []RetailPrice `json:"Items"`
+	NextPageLink *string       `json:"NextPageLink"`
+}
+
+//// LIST FUNCTION
+
+func listRetailPrices(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	pipeline := runtime.NewPipeline("steampipe-plugin-azure", "v1", runtime.PipelineOptions{}, nil)
+
+	query := url.Values{}
+	query.Set("api-version", retailPricesAPIVersion)
+	if d.EqualsQualString("currency_code") != "" {
+		query.Set("currencyCode", "'"+d.EqualsQualString("currency_code")+"'")
+	}
+	if filter := buildRetailPriceFilter(d.Quals); filter != "" {
+		query.Set("$filter", filter)
+	}
+
+	requestURL := retailPricesEndpoint + "?" + query.Encode()
+	for requestURL != "" {
+		var page retailPricePage
+		if err := getRetailPricePage(ctx, pipeline, requestURL, &page); err != nil {
+			plugin.Logger(ctx).Error("azure_retail_price.listRetailPrices", "api_error", err)
+			return nil, err
+		}
+
+		for _, price := range page.Items {
+			d.StreamListItem(ctx, price)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		requestURL = ""
+		if page.NextPageLink != nil {
+			requestURL = *page.NextPageLink
+		}
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+func getRetailPricePage(ctx context.Context, pipeline runtime.Pipeline, requestURL string, result *retailPricePage) error {
+	req, err := runtime.NewRequest(ctx, http.MethodGet, requestURL)
+	if err != nil {
+		return err
+	}
+	req.Raw().Header.Set("Accept", "application/json")
+
+	resp, err := pipeline.Do(req)
+	if err != nil {
+		return err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return runtime.NewResponseError(resp)
+	}
+	return runtime.UnmarshalAsJSON(resp, result)
+}
+
+// buildRetailPriceFilter returns the OData filter of the quals. The values of the filter are
+// case sensitive, e.g. 'Virtual Machines' and 'eastus'.
+func buildRetailPriceFilter(quals plugin.KeyColumnQualMap) string {
+	var filters []string
+
+	filterQuals := map[string]string{
+		"service_name":   "serviceName",
+		"service_id":     "serviceId",
+		"service_family": "serviceFamily",
+		"product_name":   "productName",
+		"product_id":     "productId",
+		"sku_name":       "skuName",
+		"sku_id":         "skuId",
+		"arm_sku_name":   "armSkuName",
+		"meter_name":     "meterName",
+		"meter_id":       "meterId",
+		"region":         "armRegionName",
+		"location":       "location",
+		"type":           "priceType",
+	}
+	for columnName, filterName := range filterQuals {
+		if quals[columnName] != nil {
+			for _, q := range quals[columnName].Quals {
+				if q.Operator == "=" {
+					filters = append(filters, filterName+" eq '"+strings.ReplaceAll(q.Value.GetStringValue(), "'", "''")+"'")
+				}
+			}
+		}
+	}
+
+	return strings.Join(filters, " and ")
+}
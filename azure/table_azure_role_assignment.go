@@ -2,10 +2,13 @@ package azure
 
 import (
 	"context"
+	"net/url"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/authorization/armauthorization/v2"
+	"github.com/turbot/go-kit/types"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/memoize"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
@@ -79,6 +82,20 @@ func tableAzureIamRoleAssignment(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Properties.PrincipalType"),
 			},
+			{
+				Name:        "principal_display_name",
+				Description: "The display name of the principal, resolved from Microsoft Graph. It is not set if the identity of the connection is not allowed to read the principal.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getRoleAssignmentPrincipal,
+				Transform:   transform.FromField("DisplayName"),
+			},
+			{
+				Name:        "sign_in_name",
+				Description: "The sign-in name of the principal, if it is a user, resolved from Microsoft Graph. It is not set if the identity of the connection is not allowed to read the principal.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getRoleAssignmentPrincipal,
+				Transform:   transform.FromField("UserPrincipalName"),
+			},
 			{
 				Name:        "created_on",
 				Description: "Time it was created.",
@@ -203,3 +220,60 @@ func getIamRoleAssignment(ctx context.Context, d *plugin.QueryData, h *plugin.Hy
 
 	return op, nil
 }
+
+// The principals are resolved once per principal, as they are often assigned several roles
+// if the caching is required other than per connection, build a cache key for the call and use it in Memoize.
+var getRoleAssignmentPrincipalMemoized = plugin.HydrateFunc(getRoleAssignmentPrincipalUncached).Memoize(memoize.WithCacheKeyFunction(getRoleAssignmentPrincipalCacheKey))
+
+func getRoleAssignmentPrincipal(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return getRoleAssignmentPrincipalMemoized(ctx, d, h)
+}
+
+func getRoleAssignmentPrincipalCacheKey(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	key := "getRoleAssignmentPrincipal/" + roleAssignmentPrincipalID(h.Item)
+	return key, nil
+}
+
+func getRoleAssignmentPrincipalUncached(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	principalID := roleAssignmentPrincipalID(h.Item)
+	if principalID == "" {
+		return nil, nil
+	}
+
+	client, err := getMicrosoftGraphClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_role_assignment.getRoleAssignmentPrincipal", "client_error", err)
+		return nil, err
+	}
+
+	var principal GraphDirectoryObject
+	err = client.Get(ctx, "directoryObjects/"+url.PathEscape(principalID), nil, &principal)
+	if err != nil {
+		// The principals are only resolved when the identity can read them from Microsoft Graph,
+		// and the principals of deleted identities no longer exist
+		if isGraphNotFoundError(err) || isGraphAccessDeniedError(err) {
+			plugin.Logger(ctx).Debug("azure_role_assignment.getRoleAssignmentPrincipal", "principal_id", principalID, "unresolved", err)
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("azure_role_assignment.getRoleAssignmentPrincipal", "api_error", err)
+		return nil, err
+	}
+
+	return principal, nil
+}
+
+//// UTILITY FUNCTIONS
+
+func roleAssignmentPrincipalID(item interface{}) string {
+	switch roleAssignment := item.(type) {
+	case *armauthorization.RoleAssignment:
+		if roleAssignment.Properties != nil {
+			return types.SafeString(roleAssignment.Properties.PrincipalID)
+		}
+	case armauthorization.RoleAssignmentsClientGetByIDResponse:
+		if roleAssignment.Properties != nil {
+			return types.SafeString(roleAssignment.Properties.PrincipalID)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,286 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/resources/mgmt/resources"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/authorization/armauthorization/v2"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureRoleAssignmentResource(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_role_assignment_resource",
+		Description: "Azure Role Assignment Resource, the resources a role assignment applies to through its scope.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listRoleAssignmentResourceRoleAssignments,
+			Hydrate:       listRoleAssignmentResources,
+			KeyColumns:    plugin.OptionalColumns([]string{"resource_id"}),
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "role_assignment_id",
+				Description: "The ID of the role assignment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RoleAssignment.ID"),
+			},
+			{
+				Name:        "role_assignment_name",
+				Description: "The name of the role assignment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RoleAssignment.Name"),
+			},
+			{
+				Name:        "role_definition_id",
+				Description: "The ID of the assigned role definition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RoleAssignment.Properties.RoleDefinitionID"),
+			},
+			{
+				Name:        "principal_id",
+				Description: "The principal ID the role is assigned to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RoleAssignment.Properties.PrincipalID"),
+			},
+			{
+				Name:        "principal_type",
+				Description: "The type of the principal the role is assigned to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RoleAssignment.Properties.PrincipalType"),
+			},
+			{
+				Name:        "scope",
+				Description: "The scope of the role assignment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RoleAssignment.Properties.Scope"),
+			},
+			{
+				Name:        "scope_type",
+				Description: "The level of the role assignment scope. Possible values are: 'root', 'management_group', 'subscription', 'resource_group', 'resource'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RoleAssignment.Properties.Scope").Transform(roleAssignmentScopeType),
+			},
+			{
+				Name:        "resource_id",
+				Description: "The ID of a resource the role assignment applies to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceID"),
+			},
+			{
+				Name:        "resource_name",
+				Description: "The name of the resource the role assignment applies to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceName"),
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the resource the role assignment applies to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceType"),
+			},
+			{
+				Name:        "resource_group",
+				Description: "The resource group of the resource the role assignment applies to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+// roleAssignmentResourceParent is a role assignment listed by the parent hydrate, with the resources
+// of the subscription, which are listed once per query and shared by all the role assignments.
+type roleAssignmentResourceParent struct {
+	RoleAssignment *armauthorization.RoleAssignment
+	Resources      []resources.GenericResourceExpanded
+}
+
+type RoleAssignmentResourceInfo struct {
+	RoleAssignment *armauthorization.RoleAssignment
+	ResourceID     string
+	ResourceName   string
+	ResourceType   string
+}
+
+//// LIST FUNCTION
+
+// listRoleAssignmentResourceRoleAssignments lists all the role assignments of the subscription,
+// without the atScope() filter of azure_role_assignment, which only returns the assignments at or
+// above the subscription. The assignments made at resource group and resource scopes are needed
+// here, and are matched with the resources they cover by listRoleAssignmentResources.
+func listRoleAssignmentResourceRoleAssignments(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_role_assignment_resource.listRoleAssignmentResourceRoleAssignments", "session_error", err)
+		return nil, err
+	}
+
+	client, err := armauthorization.NewRoleAssignmentsClient(session.SubscriptionID, session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_role_assignment_resource.listRoleAssignmentResourceRoleAssignments", "client_error", err)
+		return nil, err
+	}
+
+	// The resources are not needed if a resource is specified
+	var subscriptionResources []resources.GenericResourceExpanded
+	if d.EqualsQualString("resource_id") == "" {
+		subscriptionResources, err = listRoleAssignmentSubscriptionResources(ctx, d)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pager := client.NewListForSubscriptionPager(&armauthorization.RoleAssignmentsClientListForSubscriptionOptions{
+		TenantID: &session.TenantID,
+	})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_role_assignment_resource.listRoleAssignmentResourceRoleAssignments", "api_error", err)
+			return nil, err
+		}
+		for _, roleAssignment := range page.Value {
+			d.StreamListItem(ctx, roleAssignmentResourceParent{roleAssignment, subscriptionResources})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func listRoleAssignmentResources(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	parent := h.Item.(roleAssignmentResourceParent)
+	roleAssignment := parent.RoleAssignment
+	if roleAssignment.Properties == nil || roleAssignment.Properties.Scope == nil {
+		return nil, nil
+	}
+	scope := *roleAssignment.Properties.Scope
+
+	// If a resource is specified, only check whether the scope covers it
+	if resourceID := d.EqualsQualString("resource_id"); resourceID != "" {
+		if roleAssignmentScopeCovers(scope, resourceID) {
+			d.StreamListItem(ctx, buildRoleAssignmentResourceInfo(roleAssignment, resourceID, "", ""))
+		}
+		return nil, nil
+	}
+
+	for _, resource := range parent.Resources {
+		if resource.ID == nil || !roleAssignmentScopeCovers(scope, *resource.ID) {
+			continue
+		}
+		d.StreamListItem(ctx, buildRoleAssignmentResourceInfo(roleAssignment, *resource.ID, types.SafeString(resource.Name), types.SafeString(resource.Type)))
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func listRoleAssignmentSubscriptionResources(ctx context.Context, d *plugin.QueryData) ([]resources.GenericResourceExpanded, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_role_assignment_resource.listRoleAssignmentSubscriptionResources", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := resources.NewClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListComplete(ctx, "", "", nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_role_assignment_resource.listRoleAssignmentSubscriptionResources", "api_error", err)
+		return nil, err
+	}
+
+	var subscriptionResources []resources.GenericResourceExpanded
+	for result.NotDone() {
+		subscriptionResources = append(subscriptionResources, result.Value())
+		if err := result.NextWithContext(ctx); err != nil {
+			plugin.Logger(ctx).Error("azure_role_assignment_resource.listRoleAssignmentSubscriptionResources", "api_paging_error", err)
+			return nil, err
+		}
+	}
+
+	return subscriptionResources, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func roleAssignmentScopeType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	scope := strings.Trim(strings.ToLower(types.SafeString(d.Value)), "/")
+	if scope == "" {
+		return "root", nil
+	}
+
+	segments := strings.Split(scope, "/")
+	switch {
+	case strings.HasPrefix(scope, "providers/microsoft.management/managementgroups/"):
+		return "management_group", nil
+	case len(segments) == 2 && segments[0] == "subscriptions":
+		return "subscription", nil
+	case len(segments) == 4 && segments[2] == "resourcegroups":
+		return "resource_group", nil
+	}
+	return "resource", nil
+}
+
+//// UTILITY FUNCTIONS
+
+// roleAssignmentScopeCovers reports whether a role assignment made at scope applies to resourceID.
+// Role assignments listed for a subscription include the ones inherited from its management groups,
+// so management group and root scopes cover every resource in the subscription.
+func roleAssignmentScopeCovers(scope string, resourceID string) bool {
+	scope = strings.TrimSuffix(strings.ToLower(scope), "/")
+	if scope == "" || strings.HasPrefix(scope, "/providers/microsoft.management/managementgroups/") {
+		return true
+	}
+	return strings.HasPrefix(strings.ToLower(resourceID)+"/", scope+"/")
+}
+
+func buildRoleAssignmentResourceInfo(roleAssignment *armauthorization.RoleAssignment, resourceID string, name string, resourceType string) RoleAssignmentResourceInfo {
+	if name == "" {
+		name = getLastPathElement(resourceID)
+	}
+	if resourceType == "" {
+		resourceType = resourceTypeFromID(resourceID)
+	}
+	return RoleAssignmentResourceInfo{
+		RoleAssignment: roleAssignment,
+		ResourceID:     resourceID,
+		ResourceName:   name,
+		ResourceType:   resourceType,
+	}
+}
+
+// resourceTypeFromID derives the resource type from an ID of the form
+// /subscriptions/{id}/resourceGroups/{rg}/providers/{namespace}/{type}/{name}[/{child type}/{child name}]
+func resourceTypeFromID(id string) string {
+	segments := strings.Split(strings.Trim(id, "/"), "/")
+	for i, segment := range segments {
+		if !strings.EqualFold(segment, "providers") || i+1 >= len(segments) {
+			continue
+		}
+		resourceType := segments[i+1]
+		for j := i + 2; j < len(segments); j += 2 {
+			resourceType += "/" + segments[j]
+		}
+		return resourceType
+	}
+	return ""
+}
@@ -0,0 +1,261 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/authorization/armauthorization/v2"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureRoleAssignmentScheduleInstance(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_role_assignment_schedule_instance",
+		Description: "Azure Role Assignment Schedule Instance, the current role assignments, both standing and activated just in time.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getRoleAssignmentScheduleInstance,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"RoleAssignmentScheduleInstanceNotFound", "RoleEligibilityScheduleInstanceNotFound", "ResourceNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listRoleAssignmentScheduleInstances,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "principal_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the role assignment schedule instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the role assignment schedule instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource (Microsoft.Authorization/roleAssignmentScheduleInstances).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "principal_id",
+				Description: "The ID of the principal the role is assigned to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.PrincipalID"),
+			},
+			{
+				Name:        "principal_type",
+				Description: "The type of the principal the role is assigned to. Possible values include: 'User', 'Group', 'ServicePrincipal', 'ForeignGroup', 'Device'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.PrincipalType"),
+			},
+			{
+				Name:        "principal_display_name",
+				Description: "The display name of the principal the role is assigned to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ExpandedProperties.Principal.DisplayName"),
+			},
+			{
+				Name:        "principal_email",
+				Description: "The email of the principal the role is assigned to, if it is a user.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ExpandedProperties.Principal.Email"),
+			},
+			{
+				Name:        "role_definition_id",
+				Description: "The ID of the role definition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.RoleDefinitionID"),
+			},
+			{
+				Name:        "role_definition_display_name",
+				Description: "The display name of the role definition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ExpandedProperties.RoleDefinition.DisplayName"),
+			},
+			{
+				Name:        "scope",
+				Description: "The scope the role is assigned at.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Scope"),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the role assignment schedule instance, for example 'Provisioned'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Status"),
+			},
+			{
+				Name:        "member_type",
+				Description: "The membership type of the role assignment schedule instance. Possible values include: 'Direct', 'Group', 'Inherited'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.MemberType"),
+			},
+			{
+				Name:        "assignment_type",
+				Description: "The type of the role assignment. Possible values include: 'Activated', for a just-in-time activation of an eligible role, and 'Assigned', for a standing assignment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.AssignmentType"),
+			},
+			{
+				Name:        "role_assignment_schedule_id",
+				Description: "The ID of the role assignment schedule the instance belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.RoleAssignmentScheduleID"),
+			},
+			{
+				Name:        "origin_role_assignment_id",
+				Description: "The ID of the role assignment the instance is represented by.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.OriginRoleAssignmentID"),
+			},
+			{
+				Name:        "linked_role_eligibility_schedule_id",
+				Description: "The ID of the role eligibility schedule that was activated, if the role assignment is an activation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.LinkedRoleEligibilityScheduleID"),
+			},
+			{
+				Name:        "linked_role_eligibility_schedule_instance_id",
+				Description: "The ID of the role eligibility schedule instance that was activated, if the role assignment is an activation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.LinkedRoleEligibilityScheduleInstanceID"),
+			},
+			{
+				Name:        "start_date_time",
+				Description: "The time the role assignment schedule instance starts.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.StartDateTime"),
+			},
+			{
+				Name:        "end_date_time",
+				Description: "The time the role assignment schedule instance ends. The assignment is permanent if it is not set.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.EndDateTime"),
+			},
+			{
+				Name:        "created_on",
+				Description: "The time the role assignment schedule instance was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.CreatedOn"),
+			},
+			{
+				Name:        "condition",
+				Description: "The condition on the role assignment, which limits the resources it applies to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Condition"),
+			},
+			{
+				Name:        "condition_version",
+				Description: "The version of the condition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ConditionVersion"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRoleAssignmentScheduleInstances(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_role_assignment_schedule_instance.listRoleAssignmentScheduleInstances", "session_error", err)
+		return nil, err
+	}
+
+	client, err := armauthorization.NewRoleAssignmentScheduleInstancesClient(session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_role_assignment_schedule_instance.listRoleAssignmentScheduleInstances", "client_error", err)
+		return nil, err
+	}
+
+	// The instances of the subscription include the ones at, above and below it
+	options := &armauthorization.RoleAssignmentScheduleInstancesClientListForScopeOptions{}
+	if principalID := d.EqualsQualString("principal_id"); principalID != "" {
+		filter := "principalId eq '" + principalID + "'"
+		options.Filter = &filter
+	}
+
+	pager := client.NewListForScopePager("/subscriptions/"+session.SubscriptionID, options)
+	for pager.More() {
+		result, err := pager.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_role_assignment_schedule_instance.listRoleAssignmentScheduleInstances", "api_error", err)
+			return nil, err
+		}
+		for _, instance := range result.Value {
+			d.StreamListItem(ctx, *instance)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRoleAssignmentScheduleInstance(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQualString("id")
+
+	// Return nil, if no input provided
+	scope, name := roleScheduleInstanceScopeAndName(id, "roleAssignmentScheduleInstances")
+	if scope == "" || name == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_role_assignment_schedule_instance.getRoleAssignmentScheduleInstance", "session_error", err)
+		return nil, err
+	}
+
+	client, err := armauthorization.NewRoleAssignmentScheduleInstancesClient(session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_role_assignment_schedule_instance.getRoleAssignmentScheduleInstance", "client_error", err)
+		return nil, err
+	}
+
+	op, err := client.Get(ctx, scope, name, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_role_assignment_schedule_instance.getRoleAssignmentScheduleInstance", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op.RoleAssignmentScheduleInstance, nil
+	}
+
+	return nil, nil
+}
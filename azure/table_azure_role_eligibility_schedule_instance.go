@@ -0,0 +1,251 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/authorization/armauthorization/v2"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureRoleEligibilityScheduleInstance(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_role_eligibility_schedule_instance",
+		Description: "Azure Role Eligibility Schedule Instance, the eligibilities of principals to activate a role just in time.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getRoleEligibilityScheduleInstance,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"RoleAssignmentScheduleInstanceNotFound", "RoleEligibilityScheduleInstanceNotFound", "ResourceNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listRoleEligibilityScheduleInstances,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "principal_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the role eligibility schedule instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the role eligibility schedule instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource (Microsoft.Authorization/roleEligibilityScheduleInstances).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "principal_id",
+				Description: "The ID of the principal the role is eligible to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.PrincipalID"),
+			},
+			{
+				Name:        "principal_type",
+				Description: "The type of the principal the role is eligible to. Possible values include: 'User', 'Group', 'ServicePrincipal', 'ForeignGroup', 'Device'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.PrincipalType"),
+			},
+			{
+				Name:        "principal_display_name",
+				Description: "The display name of the principal the role is eligible to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ExpandedProperties.Principal.DisplayName"),
+			},
+			{
+				Name:        "principal_email",
+				Description: "The email of the principal the role is eligible to, if it is a user.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ExpandedProperties.Principal.Email"),
+			},
+			{
+				Name:        "role_definition_id",
+				Description: "The ID of the role definition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.RoleDefinitionID"),
+			},
+			{
+				Name:        "role_definition_display_name",
+				Description: "The display name of the role definition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ExpandedProperties.RoleDefinition.DisplayName"),
+			},
+			{
+				Name:        "scope",
+				Description: "The scope the role is eligible at.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Scope"),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the role eligibility schedule instance, for example 'Provisioned'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Status"),
+			},
+			{
+				Name:        "member_type",
+				Description: "The membership type of the role eligibility schedule instance. Possible values include: 'Direct', 'Group', 'Inherited'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.MemberType"),
+			},
+			{
+				Name:        "role_eligibility_schedule_id",
+				Description: "The ID of the role eligibility schedule the instance belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.RoleEligibilityScheduleID"),
+			},
+			{
+				Name:        "start_date_time",
+				Description: "The time the role eligibility schedule instance starts.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.StartDateTime"),
+			},
+			{
+				Name:        "end_date_time",
+				Description: "The time the role eligibility schedule instance ends. The eligibility is permanent if it is not set.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.EndDateTime"),
+			},
+			{
+				Name:        "created_on",
+				Description: "The time the role eligibility schedule instance was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.CreatedOn"),
+			},
+			{
+				Name:        "condition",
+				Description: "The condition on the role eligibility, which limits the resources it applies to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Condition"),
+			},
+			{
+				Name:        "condition_version",
+				Description: "The version of the condition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ConditionVersion"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRoleEligibilityScheduleInstances(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_role_eligibility_schedule_instance.listRoleEligibilityScheduleInstances", "session_error", err)
+		return nil, err
+	}
+
+	client, err := armauthorization.NewRoleEligibilityScheduleInstancesClient(session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_role_eligibility_schedule_instance.listRoleEligibilityScheduleInstances", "client_error", err)
+		return nil, err
+	}
+
+	// The instances of the subscription include the ones at, above and below it
+	options := &armauthorization.RoleEligibilityScheduleInstancesClientListForScopeOptions{}
+	if principalID := d.EqualsQualString("principal_id"); principalID != "" {
+		filter := "principalId eq '" + principalID + "'"
+		options.Filter = &filter
+	}
+
+	pager := client.NewListForScopePager("/subscriptions/"+session.SubscriptionID, options)
+	for pager.More() {
+		result, err := pager.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_role_eligibility_schedule_instance.listRoleEligibilityScheduleInstances", "api_error", err)
+			return nil, err
+		}
+		for _, instance := range result.Value {
+			d.StreamListItem(ctx, *instance)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRoleEligibilityScheduleInstance(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	id := d.EqualsQualString("id")
+
+	// Return nil, if no input provided
+	scope, name := roleScheduleInstanceScopeAndName(id, "roleEligibilityScheduleInstances")
+	if scope == "" || name == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_role_eligibility_schedule_instance.getRoleEligibilityScheduleInstance", "session_error", err)
+		return nil, err
+	}
+
+	client, err := armauthorization.NewRoleEligibilityScheduleInstancesClient(session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_role_eligibility_schedule_instance.getRoleEligibilityScheduleInstance", "client_error", err)
+		return nil, err
+	}
+
+	op, err := client.Get(ctx, scope, name, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_role_eligibility_schedule_instance.getRoleEligibilityScheduleInstance", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op.RoleEligibilityScheduleInstance, nil
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// The ID of a role schedule instance is of the form
+// {scope}/providers/Microsoft.Authorization/{collection}/{name}
+func roleScheduleInstanceScopeAndName(id string, collection string) (string, string) {
+	separator := "/providers/microsoft.authorization/" + strings.ToLower(collection) + "/"
+	index := strings.LastIndex(strings.ToLower(id), separator)
+	if index <= 0 {
+		return "", ""
+	}
+	return id[:index], id[index+len(separator):]
+}
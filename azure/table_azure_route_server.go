@@ -0,0 +1,281 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/network/mgmt/network"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureRouteServer(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_route_server",
+		Description: "Azure Route Server",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getRouteServer,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listRouteServers,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the route server.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the route server.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "etag",
+				Description: "A unique read-only string that changes whenever the resource is updated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the route server.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the route server resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualHubProperties.ProvisioningState").Transform(transform.ToString),
+			},
+			{
+				Name:        "routing_state",
+				Description: "The routing state of the route server. Possible values include: 'None', 'Provisioned', 'Provisioning', 'Failed'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualHubProperties.RoutingState").Transform(transform.ToString),
+			},
+			{
+				Name:        "sku",
+				Description: "The SKU of the route server.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualHubProperties.Sku"),
+			},
+			{
+				Name:        "virtual_router_asn",
+				Description: "The autonomous system number of the route server.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("VirtualHubProperties.VirtualRouterAsn"),
+			},
+			{
+				Name:        "virtual_router_ips",
+				Description: "The IP addresses the route server uses to peer with network virtual appliances.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("VirtualHubProperties.VirtualRouterIps"),
+			},
+			{
+				Name:        "allow_branch_to_branch_traffic",
+				Description: "Indicates whether routes are exchanged between the network virtual appliances and the virtual network gateways of the virtual network.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("VirtualHubProperties.AllowBranchToBranchTraffic"),
+			},
+			{
+				Name:        "hub_routing_preference",
+				Description: "The routing preference of the route server. Possible values include: 'ExpressRoute', 'VpnGateway', 'ASPath'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualHubProperties.HubRoutingPreference").Transform(transform.ToString),
+			},
+			{
+				Name:        "ip_configurations",
+				Description: "The list of references to the IP configurations of the route server.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("VirtualHubProperties.IPConfigurations"),
+			},
+			{
+				Name:        "bgp_peerings",
+				Description: "The BGP peerings of the route server with network virtual appliances, including the peer ASN, peer IP and connection state.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listRouteServerBgpPeerings,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRouteServers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_route_server.listRouteServers", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := network.NewVirtualHubsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_route_server.listRouteServers", "api_error", err)
+		return nil, err
+	}
+
+	for _, hub := range result.Values() {
+		// Route servers are virtual hubs of kind RouteServer
+		if !isRouteServer(hub) {
+			continue
+		}
+		d.StreamListItem(ctx, hub)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_route_server.listRouteServers", "api_paging_error", err)
+			return nil, err
+		}
+		for _, hub := range result.Values() {
+			if !isRouteServer(hub) {
+				continue
+			}
+			d.StreamListItem(ctx, hub)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRouteServer(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_route_server.getRouteServer", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := network.NewVirtualHubsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_route_server.getRouteServer", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil && isRouteServer(op) {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+func listRouteServerBgpPeerings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	routeServer := h.Item.(network.VirtualHub)
+	resourceGroup := strings.Split(*routeServer.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_route_server.listRouteServerBgpPeerings", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := network.NewVirtualHubBgpConnectionsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListComplete(ctx, resourceGroup, *routeServer.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_route_server.listRouteServerBgpPeerings", "api_error", err)
+		return nil, err
+	}
+
+	var peerings []map[string]interface{}
+	for result.NotDone() {
+		connection := result.Value()
+		objectMap := map[string]interface{}{
+			"id":   connection.ID,
+			"name": connection.Name,
+		}
+		if connection.BgpConnectionProperties != nil {
+			objectMap["peerAsn"] = connection.PeerAsn
+			objectMap["peerIp"] = connection.PeerIP
+			objectMap["provisioningState"] = connection.BgpConnectionProperties.ProvisioningState
+			objectMap["connectionState"] = connection.ConnectionState
+		}
+		peerings = append(peerings, objectMap)
+
+		if err := result.NextWithContext(ctx); err != nil {
+			plugin.Logger(ctx).Error("azure_route_server.listRouteServerBgpPeerings", "api_paging_error", err)
+			return nil, err
+		}
+	}
+
+	return peerings, nil
+}
+
+//// UTILITY FUNCTIONS
+
+func isRouteServer(hub network.VirtualHub) bool {
+	return hub.Kind != nil && strings.EqualFold(*hub.Kind, "RouteServer")
+}
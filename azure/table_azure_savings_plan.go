@@ -0,0 +1,370 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureSavingsPlan(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_savings_plan",
+		Description: "Azure Savings Plan",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"savings_plan_order_id", "name"}),
+			Hydrate:    getSavingsPlan,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "SavingsPlanNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listSavingsPlans,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The ID of the savings plan in its savings plan order.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the savings plan.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the savings plan.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DisplayName"),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource (Microsoft.BillingBenefits/savingsPlanOrders/savingsPlans).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "savings_plan_order_id",
+				Description: "The ID of the savings plan order of the savings plan.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractSavingsPlanOrderIDFromID),
+			},
+			{
+				Name:        "sku_name",
+				Description: "The SKU of the savings plan, for example 'Compute_Savings_Plan'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Sku.Name"),
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the savings plan, for example 'Succeeded', 'Expired' or 'Cancelled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ProvisioningState"),
+			},
+			{
+				Name:        "display_provisioning_state",
+				Description: "The provisioning state of the savings plan, as displayed in the portal.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DisplayProvisioningState"),
+			},
+			{
+				Name:        "term",
+				Description: "The term of the savings plan, 'P1Y', 'P3Y' or 'P5Y'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Term"),
+			},
+			{
+				Name:        "billing_plan",
+				Description: "The billing plan of the savings plan, for example 'P1M' for monthly payments.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.BillingPlan"),
+			},
+			{
+				Name:        "commitment_amount",
+				Description: "The amount committed to per commitment grain.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Properties.Commitment.Amount"),
+			},
+			{
+				Name:        "commitment_currency_code",
+				Description: "The currency of the commitment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Commitment.CurrencyCode"),
+			},
+			{
+				Name:        "commitment_grain",
+				Description: "The grain of the commitment, 'Hourly'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Commitment.Grain"),
+			},
+			{
+				Name:        "applied_scope_type",
+				Description: "The type of the scope the savings plan applies to, 'Single', 'Shared' or 'ManagementGroup'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.AppliedScopeType"),
+			},
+			{
+				Name:        "user_friendly_applied_scope_type",
+				Description: "The type of the scope the savings plan applies to, as displayed in the portal.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.UserFriendlyAppliedScopeType"),
+			},
+			{
+				Name:        "applied_scope_properties",
+				Description: "The properties of the scope the savings plan applies to, such as its subscription, resource group or management group.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.AppliedScopeProperties"),
+			},
+			{
+				Name:        "billing_scope_id",
+				Description: "The subscription billed for the savings plan.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.BillingScopeID"),
+			},
+			{
+				Name:        "billing_account_id",
+				Description: "The ID of the billing account of the savings plan.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.BillingAccountID"),
+			},
+			{
+				Name:        "billing_profile_id",
+				Description: "The ID of the billing profile of the savings plan, for the Microsoft Customer Agreement billing accounts.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.BillingProfileID"),
+			},
+			{
+				Name:        "renew",
+				Description: "Indicates whether the savings plan is automatically renewed when it expires, or not.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.Renew"),
+			},
+			{
+				Name:        "purchase_date_time",
+				Description: "The time when the savings plan was purchased.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.PurchaseDateTime"),
+			},
+			{
+				Name:        "benefit_start_time",
+				Description: "The time when the benefit of the savings plan started.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.BenefitStartTime"),
+			},
+			{
+				Name:        "effective_date_time",
+				Description: "The time when the savings plan became effective.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.EffectiveDateTime"),
+			},
+			{
+				Name:        "expiry_date_time",
+				Description: "The time when the savings plan expires.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.ExpiryDateTime"),
+			},
+			{
+				Name:        "utilization_trend",
+				Description: "The trend of the utilization of the savings plan, 'UP', 'DOWN' or 'SAME'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Utilization.Trend"),
+			},
+			{
+				Name:        "utilization_1_day",
+				Description: "The percentage of the commitment of the savings plan used over the last day.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Properties.Utilization").TransformP(savingsPlanUtilizationAggregate, float64(1)),
+			},
+			{
+				Name:        "utilization_7_day",
+				Description: "The percentage of the commitment of the savings plan used over the last 7 days.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Properties.Utilization").TransformP(savingsPlanUtilizationAggregate, float64(7)),
+			},
+			{
+				Name:        "utilization_30_day",
+				Description: "The percentage of the commitment of the savings plan used over the last 30 days.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Properties.Utilization").TransformP(savingsPlanUtilizationAggregate, float64(30)),
+			},
+			{
+				Name:        "utilization_aggregates",
+				Description: "The aggregates of the utilization of the savings plan.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Utilization.Aggregates"),
+			},
+			{
+				Name:        "extended_status_info",
+				Description: "The extended status of the savings plan.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.ExtendedStatusInfo"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DisplayName", "Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+		}),
+	}
+}
+
+// There is no SDK package for the Microsoft.BillingBenefits resource provider
+const savingsPlanAPIVersion = "2022-11-01"
+
+type SavingsPlan struct {
+	ID         *string                `json:"id,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+	Sku        *SavingsPlanSku        `json:"sku,omitempty"`
+	Properties *SavingsPlanProperties `json:"properties,omitempty"`
+}
+
+type SavingsPlanSku struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type SavingsPlanProperties struct {
+	DisplayName                  *string                 `json:"displayName,omitempty"`
+	ProvisioningState            *string                 `json:"provisioningState,omitempty"`
+	DisplayProvisioningState     *string                 `json:"displayProvisioningState,omitempty"`
+	UserFriendlyAppliedScopeType *string                 `json:"userFriendlyAppliedScopeType,omitempty"`
+	BillingScopeID               *string                 `json:"billingScopeId,omitempty"`
+	BillingProfileID             *string                 `json:"billingProfileId,omitempty"`
+	BillingAccountID             *string                 `json:"billingAccountId,omitempty"`
+	Term                         *string                 `json:"term,omitempty"`
+	Renew                        *bool                   `json:"renew,omitempty"`
+	BillingPlan                  *string                 `json:"billingPlan,omitempty"`
+	AppliedScopeType             *string                 `json:"appliedScopeType,omitempty"`
+	AppliedScopeProperties       map[string]interface{}  `json:"appliedScopeProperties,omitempty"`
+	Commitment                   *SavingsPlanCommitment  `json:"commitment,omitempty"`
+	EffectiveDateTime            *time.Time              `json:"effectiveDateTime,omitempty"`
+	BenefitStartTime             *time.Time              `json:"benefitStartTime,omitempty"`
+	ExpiryDateTime               *time.Time              `json:"expiryDateTime,omitempty"`
+	PurchaseDateTime             *time.Time              `json:"purchaseDateTime,omitempty"`
+	Utilization                  *SavingsPlanUtilization `json:"utilization,omitempty"`
+	ExtendedStatusInfo           map[string]interface{}  `json:"extendedStatusInfo,omitempty"`
+}
+
+type SavingsPlanCommitment struct {
+	Grain        *string  `json:"grain,omitempty"`
+	CurrencyCode *string  `json:"currencyCode,omitempty"`
+	Amount       *float64 `json:"amount,omitempty"`
+}
+
+type SavingsPlanUtilization struct {
+	Trend      *string                           `json:"trend,omitempty"`
+	Aggregates []SavingsPlanUtilizationAggregate `json:"aggregates,omitempty"`
+}
+
+type SavingsPlanUtilizationAggregate struct {
+	Grain     *float64 `json:"grain,omitempty"`
+	GrainUnit *string  `json:"grainUnit,omitempty"`
+	Value     *float64 `json:"value,omitempty"`
+	ValueUnit *string  `json:"valueUnit,omitempty"`
+}
+
+//// LIST FUNCTION
+
+func listSavingsPlans(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// The savings plans of all the savings plan orders the user has access to are listed
+	err := listResourceManagerResources(ctx, d, "/providers/Microsoft.BillingBenefits/savingsPlans", savingsPlanAPIVersion, func(items []json.RawMessage) (bool, error) {
+		for _, item := range items {
+			var savingsPlan SavingsPlan
+			if err := json.Unmarshal(item, &savingsPlan); err != nil {
+				return false, err
+			}
+			d.StreamListItem(ctx, savingsPlan)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_savings_plan.listSavingsPlans", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSavingsPlan(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	orderID := d.EqualsQualString("savings_plan_order_id")
+	name := d.EqualsQualString("name")
+
+	// Return nil, if no input provided
+	if orderID == "" || name == "" {
+		return nil, nil
+	}
+
+	var savingsPlan SavingsPlan
+	path := "/providers/Microsoft.BillingBenefits/savingsPlanOrders/" + orderID + "/savingsPlans/" + name
+	found, err := getResourceManagerResource(ctx, d, path, savingsPlanAPIVersion, &savingsPlan)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_savings_plan.getSavingsPlan", "api_error", err)
+		return nil, err
+	}
+
+	if found && savingsPlan.ID != nil {
+		return savingsPlan, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// savingsPlanUtilizationAggregate returns the utilization percentage of the aggregate
+// whose grain is the number of days in the param
+func savingsPlanUtilizationAggregate(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	utilization, ok := d.Value.(*SavingsPlanUtilization)
+	if !ok || utilization == nil {
+		return nil, nil
+	}
+
+	grain := d.Param.(float64)
+	for _, aggregate := range utilization.Aggregates {
+		if aggregate.Grain != nil && *aggregate.Grain == grain {
+			return aggregate.Value, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func extractSavingsPlanOrderIDFromID(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	id, ok := d.Value.(*string)
+	if !ok || id == nil {
+		return nil, nil
+	}
+
+	// The ID of a savings plan is /providers/Microsoft.BillingBenefits/savingsPlanOrders/{orderId}/savingsPlans/{savingsPlanId}
+	parts := strings.Split(*id, "/")
+	for i, part := range parts {
+		if strings.EqualFold(part, "savingsPlanOrders") && i+1 < len(parts) {
+			return parts[i+1], nil
+		}
+	}
+
+	return nil, nil
+}
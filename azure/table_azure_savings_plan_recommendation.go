@@ -0,0 +1,296 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/costmanagement/armcostmanagement/v2"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureSavingsPlanRecommendation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_savings_plan_recommendation",
+		Description: "Azure Savings Plan Recommendation, the hourly commitments of savings plans recommended for purchase from the usage of a scope.",
+		List: &plugin.ListConfig{
+			Hydrate: listSavingsPlanRecommendations,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "scope",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "recommendation_scope",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "look_back_period",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "term",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the recommendation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the recommendation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "kind",
+				Description: "The kind of benefit of the recommendation, 'SavingsPlan'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Kind").Transform(transform.ToString),
+			},
+			{
+				Name:        "recommendation_scope",
+				Description: "The scope the recommended savings plan would apply to, 'Single' or 'Shared'. Defaults to 'Shared'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Scope").Transform(transform.ToString),
+			},
+			{
+				Name:        "look_back_period",
+				Description: "The period of the usage the recommendation is computed from, 'Last7Days', 'Last30Days' or 'Last60Days'. Defaults to 'Last60Days'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.LookBackPeriod").Transform(transform.ToString),
+			},
+			{
+				Name:        "term",
+				Description: "The term of the recommended savings plan, 'P1Y' or 'P3Y'. Defaults to 'P3Y'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Term").Transform(transform.ToString),
+			},
+			{
+				Name:        "commitment_granularity",
+				Description: "The granularity of the commitment, 'Hourly'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.CommitmentGranularity").Transform(transform.ToString),
+			},
+			{
+				Name:        "commitment_amount",
+				Description: "The recommended amount to commit to per commitment granularity.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Properties.RecommendationDetails.CommitmentAmount"),
+			},
+			{
+				Name:        "currency_code",
+				Description: "The currency of the amounts.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.CurrencyCode"),
+			},
+			{
+				Name:        "cost_without_benefit",
+				Description: "The cost of the usage over the look back period, without the savings plan.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Properties.CostWithoutBenefit"),
+			},
+			{
+				Name:        "total_cost",
+				Description: "The cost of the usage over the look back period, with the recommended savings plan.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Properties.RecommendationDetails.TotalCost"),
+			},
+			{
+				Name:        "savings_amount",
+				Description: "The savings over the look back period, if the recommended savings plan had been purchased.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Properties.RecommendationDetails.SavingsAmount"),
+			},
+			{
+				Name:        "savings_percentage",
+				Description: "The savings as a percentage of the cost without the savings plan.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Properties.RecommendationDetails.SavingsPercentage"),
+			},
+			{
+				Name:        "average_utilization_percentage",
+				Description: "The average utilization of the recommended savings plan over the look back period.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Properties.RecommendationDetails.AverageUtilizationPercentage"),
+			},
+			{
+				Name:        "coverage_percentage",
+				Description: "The percentage of the usage covered by the recommended savings plan.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Properties.RecommendationDetails.CoveragePercentage"),
+			},
+			{
+				Name:        "benefit_cost",
+				Description: "The cost of the recommended savings plan over the look back period.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Properties.RecommendationDetails.BenefitCost"),
+			},
+			{
+				Name:        "overage_cost",
+				Description: "The cost of the usage not covered by the recommended savings plan over the look back period.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Properties.RecommendationDetails.OverageCost"),
+			},
+			{
+				Name:        "wastage_cost",
+				Description: "The cost of the commitment of the recommended savings plan that would not have been used over the look back period.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Properties.RecommendationDetails.WastageCost"),
+			},
+			{
+				Name:        "first_consumption_date",
+				Description: "The date of the first usage the recommendation is computed from.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.FirstConsumptionDate"),
+			},
+			{
+				Name:        "last_consumption_date",
+				Description: "The date of the last usage the recommendation is computed from.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.LastConsumptionDate"),
+			},
+			{
+				Name:        "total_hours",
+				Description: "The number of hours of usage the recommendation is computed from.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Properties.TotalHours"),
+			},
+			{
+				Name:        "recommendation_subscription_id",
+				Description: "The subscription the recommended savings plan would apply to, if its scope is 'Single'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "recommendation_resource_group",
+				Description: "The resource group the recommended savings plan would apply to, if its scope is 'Single'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "all_recommendation_details",
+				Description: "The savings of the other commitment amounts considered for the recommendation.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.AllRecommendationDetails.Value"),
+			},
+			{
+				Name:        "usage",
+				Description: "The hourly charges of the usage the recommendation is computed from.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Usage"),
+			},
+			{
+				Name:        "scope",
+				Description: "The scope of the recommendations, for example '/subscriptions/{subscriptionId}' or '/providers/Microsoft.Billing/billingAccounts/{billingAccountId}'. Defaults to the subscription of the connection.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BillingScope"),
+			},
+		}),
+	}
+}
+
+type SavingsPlanRecommendationInfo struct {
+	ID                           *string
+	Name                         *string
+	Kind                         *armcostmanagement.BenefitKind
+	BillingScope                 string
+	RecommendationSubscriptionID *string
+	RecommendationResourceGroup  *string
+	Properties                   *armcostmanagement.BenefitRecommendationProperties
+}
+
+//// LIST FUNCTION
+
+func listSavingsPlanRecommendations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_savings_plan_recommendation.listSavingsPlanRecommendations", "session_error", err)
+		return nil, err
+	}
+
+	client, err := armcostmanagement.NewBenefitRecommendationsClient(session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_savings_plan_recommendation.listSavingsPlanRecommendations", "client_error", err)
+		return nil, err
+	}
+
+	scope := "/subscriptions/" + session.SubscriptionID
+	if d.EqualsQualString("scope") != "" {
+		scope = d.EqualsQualString("scope")
+	}
+
+	options := &armcostmanagement.BenefitRecommendationsClientListOptions{
+		Expand: to.Ptr("properties/usage,properties/allRecommendationDetails"),
+	}
+	if filter := buildSavingsPlanRecommendationFilter(d.Quals); filter != "" {
+		options.Filter = &filter
+	}
+
+	pager := client.NewListPager(strings.TrimPrefix(scope, "/"), options)
+	for pager.More() {
+		result, err := pager.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_savings_plan_recommendation.listSavingsPlanRecommendations", "api_error", err)
+			return nil, err
+		}
+		for _, recommendation := range result.Value {
+			d.StreamListItem(ctx, savingsPlanRecommendationInfo(recommendation, scope))
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+func buildSavingsPlanRecommendationFilter(quals plugin.KeyColumnQualMap) string {
+	filterQuals := map[string]string{
+		"recommendation_scope": "properties/scope",
+		"look_back_period":     "properties/lookBackPeriod",
+		"term":                 "properties/term",
+	}
+
+	filters := []string{}
+	for columnName, filterName := range filterQuals {
+		if quals[columnName] != nil {
+			for _, q := range quals[columnName].Quals {
+				if q.Operator == "=" {
+					filters = append(filters, filterName+" eq '"+q.Value.GetStringValue()+"'")
+				}
+			}
+		}
+	}
+
+	return strings.Join(filters, " and ")
+}
+
+// The properties of the recommendations differ by their scope, the single scope
+// ones also having the subscription and the resource group they apply to
+func savingsPlanRecommendationInfo(recommendation *armcostmanagement.BenefitRecommendationModel, scope string) SavingsPlanRecommendationInfo {
+	info := SavingsPlanRecommendationInfo{
+		ID:           recommendation.ID,
+		Name:         recommendation.Name,
+		Kind:         recommendation.Kind,
+		BillingScope: scope,
+	}
+	if recommendation.Properties != nil {
+		info.Properties = recommendation.Properties.GetBenefitRecommendationProperties()
+		if single, ok := recommendation.Properties.(*armcostmanagement.SingleScopeBenefitRecommendationProperties); ok {
+			info.RecommendationSubscriptionID = single.SubscriptionID
+			info.RecommendationResourceGroup = single.ResourceGroup
+		}
+	}
+	return info
+}
@@ -0,0 +1,215 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/compute/mgmt/compute"
+	"github.com/Azure/azure-sdk-for-go/services/preview/maintenance/mgmt/2022-07-01-preview/maintenance"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureScheduledEvent(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_scheduled_event",
+		Description: "Azure Scheduled Event, the pending platform maintenance of the virtual machines and virtual machine scale sets.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listScheduledEventResources,
+			Hydrate:       listScheduledEvents,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "resource_name",
+				Description: "The name of the virtual machine or virtual machine scale set impacted by the maintenance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_id",
+				Description: "The resource ID of the virtual machine or virtual machine scale set impacted by the maintenance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_type",
+				Description: "The resource type of the resource impacted by the maintenance, either 'Microsoft.Compute/virtualMachines' or 'Microsoft.Compute/virtualMachineScaleSets'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "impact_type",
+				Description: "The impact of the maintenance on the resource. Possible values include: 'None', 'Freeze', 'Restart', 'Redeploy'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Update.ImpactType"),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the maintenance. Possible values include: 'Pending', 'InProgress', 'Completed', 'RetryNow', 'RetryLater'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Update.Status"),
+			},
+			{
+				Name:        "maintenance_scope",
+				Description: "The scope of the maintenance. Possible values include: 'Host', 'Resource', 'OSImage', 'Extension', 'InGuestPatch', 'SQLDB', 'SQLManagedInstance'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Update.MaintenanceScope"),
+			},
+			{
+				Name:        "impact_duration_in_sec",
+				Description: "The expected duration of the impact on the resource, in seconds.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Update.ImpactDurationInSec"),
+			},
+			{
+				Name:        "not_before",
+				Description: "The time before which the platform does not apply the maintenance.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Update.NotBefore").Transform(convertDateToTime),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceName"),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+// The maintenance updates are listed per resource, for each virtual machine and scale set
+type ScheduledEventResource struct {
+	ResourceID   string
+	ResourceName string
+	ResourceType string
+	Location     *string
+}
+
+type ScheduledEventInfo struct {
+	ScheduledEventResource
+	Update maintenance.Update
+}
+
+//// LIST FUNCTION
+
+func listScheduledEventResources(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_scheduled_event.listScheduledEventResources", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	vmClient := compute.NewVirtualMachinesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	vmClient.Authorizer = session.Authorizer
+
+	vms, err := vmClient.ListAll(ctx, "", "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_scheduled_event.listScheduledEventResources", "api_error", err)
+		return nil, err
+	}
+	for {
+		for _, vm := range vms.Values() {
+			if vm.ID == nil || vm.Name == nil {
+				continue
+			}
+			d.StreamListItem(ctx, ScheduledEventResource{*vm.ID, *vm.Name, "Microsoft.Compute/virtualMachines", vm.Location})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if !vms.NotDone() {
+			break
+		}
+		if err := vms.NextWithContext(ctx); err != nil {
+			plugin.Logger(ctx).Error("azure_scheduled_event.listScheduledEventResources", "api_paging_error", err)
+			return nil, err
+		}
+	}
+
+	vmssClient := compute.NewVirtualMachineScaleSetsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	vmssClient.Authorizer = session.Authorizer
+
+	scaleSets, err := vmssClient.ListAll(ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_scheduled_event.listScheduledEventResources", "api_error", err)
+		return nil, err
+	}
+	for {
+		for _, scaleSet := range scaleSets.Values() {
+			if scaleSet.ID == nil || scaleSet.Name == nil {
+				continue
+			}
+			d.StreamListItem(ctx, ScheduledEventResource{*scaleSet.ID, *scaleSet.Name, "Microsoft.Compute/virtualMachineScaleSets", scaleSet.Location})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if !scaleSets.NotDone() {
+			break
+		}
+		if err := scaleSets.NextWithContext(ctx); err != nil {
+			plugin.Logger(ctx).Error("azure_scheduled_event.listScheduledEventResources", "api_paging_error", err)
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+func listScheduledEvents(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	resource := h.Item.(ScheduledEventResource)
+	resourceGroup := strings.Split(resource.ResourceID, "/")[4]
+	resourceType := strings.TrimPrefix(resource.ResourceType, "Microsoft.Compute/")
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_scheduled_event.listScheduledEvents", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := maintenance.NewUpdatesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	// The API doesn't support pagination
+	result, err := client.List(ctx, resourceGroup, "Microsoft.Compute", resourceType, resource.ResourceName)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_scheduled_event.listScheduledEvents", "api_error", err)
+		return nil, err
+	}
+	if result.Value == nil {
+		return nil, nil
+	}
+
+	for _, update := range *result.Value {
+		d.StreamListItem(ctx, ScheduledEventInfo{resource, update})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
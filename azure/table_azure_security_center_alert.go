@@ -0,0 +1,507 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/security/mgmt/security"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureSecurityCenterAlert(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_security_center_alert",
+		Description: "Azure Security Center Alert, the security alerts raised by Microsoft Defender for Cloud on the resources of the subscription.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "name",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "region",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "resource_group",
+					Require: plugin.Optional,
+				},
+			},
+			Hydrate: getSecurityCenterAlert,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listSecurityCenterAlerts,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "resource_group",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "severity",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "status",
+					Require: plugin.Optional,
+				},
+				{
+					Name:      "start_time",
+					Require:   plugin.Optional,
+					Operators: []string{">", ">=", "<", "<="},
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the alert.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the alert.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the alert.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "alert_display_name",
+				Description: "The display name of the alert.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AlertProperties.AlertDisplayName"),
+			},
+			{
+				Name:        "alert_type",
+				Description: "The unique identifier of the detection logic of the alert. All alerts raised by the same detection logic have the same alert type.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AlertProperties.AlertType"),
+			},
+			{
+				Name:        "system_alert_id",
+				Description: "The unique identifier of the alert.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AlertProperties.SystemAlertID"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the suspicious activity that was detected.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AlertProperties.Description"),
+			},
+			{
+				Name:        "severity",
+				Description: "The risk level of the threat that was detected. Possible values include: 'Informational', 'Low', 'Medium', 'High'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AlertProperties.Severity"),
+			},
+			{
+				Name:        "status",
+				Description: "The life cycle status of the alert. Possible values include: 'Active', 'InProgress', 'Resolved', 'Dismissed'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AlertProperties.Status"),
+			},
+			{
+				Name:        "intent",
+				Description: "The kill chain intents behind the alert, as a comma separated list.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AlertProperties.Intent"),
+			},
+			{
+				Name:        "affected_resource_id",
+				Description: "The resource ID of the Azure resource the alert was raised on.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AlertProperties.ResourceIdentifiers").Transform(extractSecurityCenterAlertAffectedResourceID),
+			},
+			{
+				Name:        "compromised_entity",
+				Description: "The display name of the resource most related to the alert.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AlertProperties.CompromisedEntity"),
+			},
+			{
+				Name:        "start_time",
+				Description: "The time of the first event or activity included in the alert.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("AlertProperties.StartTimeUtc").Transform(convertDateToTime),
+			},
+			{
+				Name:        "end_time",
+				Description: "The time of the last event or activity included in the alert.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("AlertProperties.EndTimeUtc").Transform(convertDateToTime),
+			},
+			{
+				Name:        "time_generated",
+				Description: "The time the alert was generated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("AlertProperties.TimeGeneratedUtc").Transform(convertDateToTime),
+			},
+			{
+				Name:        "processing_end_time",
+				Description: "The time the alert was made available for consumption.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("AlertProperties.ProcessingEndTimeUtc").Transform(convertDateToTime),
+			},
+			{
+				Name:        "product_name",
+				Description: "The name of the product that raised the alert.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AlertProperties.ProductName"),
+			},
+			{
+				Name:        "product_component_name",
+				Description: "The name of the Defender plan that raised the alert, for example 'Servers' or 'Storage'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AlertProperties.ProductComponentName"),
+			},
+			{
+				Name:        "vendor_name",
+				Description: "The name of the vendor that raised the alert.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AlertProperties.VendorName"),
+			},
+			{
+				Name:        "alert_uri",
+				Description: "The link to the alert in the Azure portal.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AlertProperties.AlertURI"),
+			},
+			{
+				Name:        "is_incident",
+				Description: "Indicates whether the alert is an incident, that is a collection of related alerts.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("AlertProperties.IsIncident"),
+			},
+			{
+				Name:        "correlation_key",
+				Description: "The key of the alerts that are related to each other.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AlertProperties.CorrelationKey"),
+			},
+			{
+				Name:        "version",
+				Description: "The schema version of the alert.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AlertProperties.Version"),
+			},
+			{
+				Name:        "tactics",
+				Description: "The kill chain intents behind the alert.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AlertProperties.Intent").Transform(extractSecurityCenterAlertTactics),
+			},
+			{
+				Name:        "techniques",
+				Description: "The MITRE ATT&CK techniques of the alert.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AlertProperties.Techniques"),
+			},
+			{
+				Name:        "sub_techniques",
+				Description: "The MITRE ATT&CK sub-techniques of the alert.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AlertProperties.SubTechniques"),
+			},
+			{
+				Name:        "remediation_steps",
+				Description: "The manual steps to take to remediate the alert.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AlertProperties.RemediationSteps"),
+			},
+			{
+				Name:        "resource_identifiers",
+				Description: "The identifiers of the resources the alert was raised on.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AlertProperties.ResourceIdentifiers").Transform(extractSecurityCenterAlertResourceIdentifiers),
+			},
+			{
+				Name:        "entities",
+				Description: "The entities related to the alert.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AlertProperties.Entities"),
+			},
+			{
+				Name:        "extended_properties",
+				Description: "The custom properties of the alert.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AlertProperties.ExtendedProperties"),
+			},
+			{
+				Name:        "extended_links",
+				Description: "The links related to the alert.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AlertProperties.ExtendedLinks"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AlertProperties.AlertDisplayName", "Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractSecurityCenterAlertLocation),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractSecurityCenterAlertResourceGroup),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSecurityCenterAlerts(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_alert.listSecurityCenterAlerts", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := security.NewAlertsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	var result security.AlertListPage
+	if resourceGroup := d.EqualsQualString("resource_group"); resourceGroup != "" {
+		result, err = client.ListByResourceGroup(ctx, resourceGroup)
+	} else {
+		result, err = client.List(ctx)
+	}
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_alert.listSecurityCenterAlerts", "api_error", err)
+		return nil, err
+	}
+
+	for _, alert := range result.Values() {
+		// The API does not filter alerts, so the quals are applied before streaming
+		if !securityCenterAlertMatchesQuals(alert, d.Quals) {
+			continue
+		}
+		d.StreamListItem(ctx, alert)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_security_center_alert.listSecurityCenterAlerts", "api_paging_error", err)
+			return nil, err
+		}
+		for _, alert := range result.Values() {
+			if !securityCenterAlertMatchesQuals(alert, d.Quals) {
+				continue
+			}
+			d.StreamListItem(ctx, alert)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSecurityCenterAlert(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	region := d.EqualsQualString("region")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if name == "" || region == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_alert.getSecurityCenterAlert", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := security.NewAlertsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	// Alerts raised on resources belong to the resource group of the resource, the
+	// others to the subscription
+	var op security.Alert
+	if resourceGroup != "" {
+		op, err = client.GetResourceGroupLevel(ctx, resourceGroup, region, name)
+	} else {
+		op, err = client.GetSubscriptionLevel(ctx, region, name)
+	}
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_alert.getSecurityCenterAlert", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func extractSecurityCenterAlertAffectedResourceID(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	identifiers, ok := d.Value.(*[]security.BasicResourceIdentifier)
+	if !ok || identifiers == nil {
+		return nil, nil
+	}
+
+	for _, identifier := range *identifiers {
+		if azureIdentifier, ok := identifier.AsAzureResourceIdentifier(); ok && azureIdentifier.AzureResourceID != nil {
+			return *azureIdentifier.AzureResourceID, nil
+		}
+	}
+	return nil, nil
+}
+
+// The identifiers are polymorphic types whose read-only properties are not marshalled, so
+// they are converted explicitly
+func extractSecurityCenterAlertResourceIdentifiers(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	identifiers, ok := d.Value.(*[]security.BasicResourceIdentifier)
+	if !ok || identifiers == nil {
+		return nil, nil
+	}
+
+	result := []map[string]interface{}{}
+	for _, identifier := range *identifiers {
+		if azureIdentifier, ok := identifier.AsAzureResourceIdentifier(); ok {
+			result = append(result, map[string]interface{}{
+				"type":            azureIdentifier.Type,
+				"azureResourceId": azureIdentifier.AzureResourceID,
+			})
+		} else if logAnalyticsIdentifier, ok := identifier.AsLogAnalyticsIdentifier(); ok {
+			result = append(result, map[string]interface{}{
+				"type":                    logAnalyticsIdentifier.Type,
+				"workspaceId":             logAnalyticsIdentifier.WorkspaceID,
+				"workspaceSubscriptionId": logAnalyticsIdentifier.WorkspaceSubscriptionID,
+				"workspaceResourceGroup":  logAnalyticsIdentifier.WorkspaceResourceGroup,
+				"agentId":                 logAnalyticsIdentifier.AgentID,
+			})
+		}
+	}
+	return result, nil
+}
+
+func extractSecurityCenterAlertTactics(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	intent, ok := d.Value.(security.Intent)
+	if !ok || intent == "" {
+		return nil, nil
+	}
+
+	tactics := []string{}
+	for _, tactic := range strings.Split(string(intent), ",") {
+		if tactic = strings.TrimSpace(tactic); tactic != "" {
+			tactics = append(tactics, tactic)
+		}
+	}
+	return tactics, nil
+}
+
+// Alert IDs have the form
+// /subscriptions/{id}[/resourceGroups/{rg}]/providers/Microsoft.Security/locations/{location}/alerts/{name}
+func extractSecurityCenterAlertLocation(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	segments := strings.Split(types.SafeString(d.Value), "/")
+	for i, segment := range segments {
+		if strings.EqualFold(segment, "locations") && i+1 < len(segments) {
+			return strings.ToLower(segments[i+1]), nil
+		}
+	}
+	return nil, nil
+}
+
+func extractSecurityCenterAlertResourceGroup(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	segments := strings.Split(types.SafeString(d.Value), "/")
+	if len(segments) > 4 && strings.EqualFold(segments[3], "resourceGroups") {
+		return strings.ToLower(segments[4]), nil
+	}
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+func securityCenterAlertMatchesQuals(alert security.Alert, quals plugin.KeyColumnQualMap) bool {
+	if alert.AlertProperties == nil {
+		return true
+	}
+
+	if quals["severity"] != nil {
+		for _, q := range quals["severity"].Quals {
+			if !strings.EqualFold(string(alert.Severity), q.Value.GetStringValue()) {
+				return false
+			}
+		}
+	}
+	if quals["status"] != nil {
+		for _, q := range quals["status"].Quals {
+			if !strings.EqualFold(string(alert.Status), q.Value.GetStringValue()) {
+				return false
+			}
+		}
+	}
+	if quals["start_time"] != nil && alert.StartTimeUtc != nil {
+		startTime := alert.StartTimeUtc.Time
+		for _, q := range quals["start_time"].Quals {
+			value := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case ">":
+				if !startTime.After(value) {
+					return false
+				}
+			case ">=":
+				if startTime.Before(value) {
+					return false
+				}
+			case "<":
+				if !startTime.Before(value) {
+					return false
+				}
+			case "<=":
+				if startTime.After(value) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,305 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/security/mgmt/security"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureSecurityCenterAssessment(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_security_center_assessment",
+		Description: "Azure Security Center Assessment, the per-resource results of the Microsoft Defender for Cloud recommendations, with their status and severity.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"resource_id", "name"}),
+			Hydrate:    getSecurityCenterAssessment,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listSecurityCenterAssessments,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the assessment, which is also the name of its assessment metadata.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the assessment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "display_name",
+				Description: "The user friendly display name of the assessment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AssessmentPropertiesResponse.DisplayName"),
+			},
+			{
+				Name:        "status_code",
+				Description: "The status of the assessment on the resource. Possible values include: 'Healthy', 'Unhealthy', 'NotApplicable'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AssessmentPropertiesResponse.Status.Code"),
+			},
+			{
+				Name:        "status_cause",
+				Description: "The programmatic code for the cause of the assessment status.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AssessmentPropertiesResponse.Status.Cause"),
+			},
+			{
+				Name:        "status_description",
+				Description: "The human readable description of the assessment status.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AssessmentPropertiesResponse.Status.Description"),
+			},
+			{
+				Name:        "severity",
+				Description: "The severity of the recommendation of the assessment. Possible values include: 'Low', 'Medium', 'High'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "first_evaluation_date",
+				Description: "The time the assessment was first evaluated on the resource.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("AssessmentPropertiesResponse.Status.FirstEvaluationDate").Transform(convertDateToTime),
+			},
+			{
+				Name:        "status_change_date",
+				Description: "The time the status of the assessment last changed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("AssessmentPropertiesResponse.Status.StatusChangeDate").Transform(convertDateToTime),
+			},
+			{
+				Name:        "resource_id",
+				Description: "The ID of the assessed resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractSecurityCenterAssessmentResourceID),
+			},
+			{
+				Name:        "azure_portal_uri",
+				Description: "The link to the assessment in the Azure Portal.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AssessmentPropertiesResponse.Links.AzurePortalURI"),
+			},
+			{
+				Name:        "resource_details",
+				Description: "The details of the assessed resource, which depend on its source.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AssessmentPropertiesResponse.ResourceDetails").Transform(extractSecurityCenterAssessmentResourceDetails),
+			},
+			{
+				Name:        "additional_data",
+				Description: "The additional data of the assessment on the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AssessmentPropertiesResponse.AdditionalData"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AssessmentPropertiesResponse.DisplayName", "Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromSubAssessmentID),
+			},
+		}),
+	}
+}
+
+type SecurityCenterAssessmentInfo struct {
+	Severity security.Severity
+	security.AssessmentResponse
+}
+
+//// LIST FUNCTION
+
+func listSecurityCenterAssessments(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_assessment.listSecurityCenterAssessments", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	// The listed assessments do not always carry their metadata, so the severities are read
+	// from the assessment metadata
+	metadataClient := security.NewAssessmentsMetadataClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	metadataClient.Authorizer = session.Authorizer
+
+	severities := map[string]security.Severity{}
+	metadataResult, err := metadataClient.List(ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_assessment.listSecurityCenterAssessments", "api_error", err)
+		return nil, err
+	}
+	for {
+		for _, metadata := range metadataResult.Values() {
+			if metadata.Name != nil && metadata.AssessmentMetadataPropertiesResponse != nil {
+				severities[strings.ToLower(*metadata.Name)] = metadata.AssessmentMetadataPropertiesResponse.Severity
+			}
+		}
+		if !metadataResult.NotDone() {
+			break
+		}
+		err = metadataResult.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_security_center_assessment.listSecurityCenterAssessments", "api_paging_error", err)
+			return nil, err
+		}
+	}
+
+	client := security.NewAssessmentsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx, "/subscriptions/"+subscriptionID)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_assessment.listSecurityCenterAssessments", "api_error", err)
+		return nil, err
+	}
+
+	for _, assessment := range result.Values() {
+		d.StreamListItem(ctx, newSecurityCenterAssessmentInfo(assessment, severities))
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_security_center_assessment.listSecurityCenterAssessments", "api_paging_error", err)
+			return nil, err
+		}
+		for _, assessment := range result.Values() {
+			d.StreamListItem(ctx, newSecurityCenterAssessmentInfo(assessment, severities))
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSecurityCenterAssessment(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	resourceID := d.EqualsQualString("resource_id")
+	name := d.EqualsQualString("name")
+
+	// Return nil, if no input provided
+	if resourceID == "" || name == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_assessment.getSecurityCenterAssessment", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := security.NewAssessmentsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceID, name, security.Metadata)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_assessment.getSecurityCenterAssessment", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return newSecurityCenterAssessmentInfo(op, nil), nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// The ID of an assessment is of the form {resourceId}/providers/Microsoft.Security/assessments/{assessmentName}
+func extractSecurityCenterAssessmentResourceID(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	resourceID := securityCenterAssessmentResourceID(types.SafeString(d.Value))
+	if resourceID == "" {
+		return nil, nil
+	}
+	return resourceID, nil
+}
+
+func extractSecurityCenterAssessmentResourceDetails(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	resourceDetails, ok := d.Value.(security.BasicResourceDetails)
+	if !ok || resourceDetails == nil {
+		return nil, nil
+	}
+
+	if azureResourceDetails, ok := resourceDetails.AsAzureResourceDetails(); ok {
+		return extractAzureResourceDetails(azureResourceDetails), nil
+	}
+	if onPremiseResourceDetails, ok := resourceDetails.AsOnPremiseResourceDetails(); ok {
+		return extractOnPremiseResourceDetails(onPremiseResourceDetails), nil
+	}
+	if onPremiseSQLResourceDetails, ok := resourceDetails.AsOnPremiseSQLResourceDetails(); ok {
+		return extractOnPremiseSQLResourceDetails(onPremiseSQLResourceDetails), nil
+	}
+	if resourceDetail, ok := resourceDetails.AsResourceDetails(); ok {
+		return extractResourceDetail(resourceDetail), nil
+	}
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// newSecurityCenterAssessmentInfo reads the severity of the assessment from its metadata, or from
+// the severities of the assessment metadata if the assessment does not carry its metadata
+func newSecurityCenterAssessmentInfo(assessment security.AssessmentResponse, severities map[string]security.Severity) SecurityCenterAssessmentInfo {
+	info := SecurityCenterAssessmentInfo{AssessmentResponse: assessment}
+	if props := assessment.AssessmentPropertiesResponse; props != nil && props.Metadata != nil && props.Metadata.Severity != "" {
+		info.Severity = props.Metadata.Severity
+	} else if assessment.Name != nil {
+		info.Severity = severities[strings.ToLower(*assessment.Name)]
+	}
+	return info
+}
+
+func securityCenterAssessmentResourceID(id string) string {
+	index := strings.LastIndex(strings.ToLower(id), "/providers/microsoft.security/assessments/")
+	if index <= 0 {
+		return ""
+	}
+	return id[:index]
+}
@@ -0,0 +1,233 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/security/mgmt/security"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureSecurityCenterAssessmentMetadata(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_security_center_assessment_metadata",
+		Description: "Azure Security Center Assessment Metadata, the definitions of the Microsoft Defender for Cloud recommendations, with their description, remediation steps, severity and categories.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getSecurityCenterAssessmentMetadata,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listSecurityCenterAssessmentMetadata,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name (assessment key) of the assessment metadata.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the assessment metadata.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "display_name",
+				Description: "The user friendly display name of the recommendation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AssessmentMetadataPropertiesResponse.DisplayName"),
+			},
+			{
+				Name:        "description",
+				Description: "The human readable description of the recommendation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AssessmentMetadataPropertiesResponse.Description"),
+			},
+			{
+				Name:        "remediation_description",
+				Description: "The human readable steps to remediate the recommendation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AssessmentMetadataPropertiesResponse.RemediationDescription"),
+			},
+			{
+				Name:        "severity",
+				Description: "The severity of the recommendation. Possible values include: 'Low', 'Medium', 'High'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AssessmentMetadataPropertiesResponse.Severity"),
+			},
+			{
+				Name:        "assessment_type",
+				Description: "The type of the assessment. Possible values include: 'BuiltIn', 'CustomPolicy', 'CustomerManaged', 'VerifiedPartner'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AssessmentMetadataPropertiesResponse.AssessmentType"),
+			},
+			{
+				Name:        "user_impact",
+				Description: "The user impact of remediating the recommendation. Possible values include: 'Low', 'Moderate', 'High'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AssessmentMetadataPropertiesResponse.UserImpact"),
+			},
+			{
+				Name:        "implementation_effort",
+				Description: "The implementation effort required to remediate the recommendation. Possible values include: 'Low', 'Moderate', 'High'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AssessmentMetadataPropertiesResponse.ImplementationEffort"),
+			},
+			{
+				Name:        "policy_definition_id",
+				Description: "The ID of the policy definition the assessment is evaluated by.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AssessmentMetadataPropertiesResponse.PolicyDefinitionID"),
+			},
+			{
+				Name:        "preview",
+				Description: "Indicates whether the recommendation is in preview.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("AssessmentMetadataPropertiesResponse.Preview"),
+			},
+			{
+				Name:        "planned_deprecation_date",
+				Description: "The date the recommendation is planned to be deprecated.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AssessmentMetadataPropertiesResponse.PlannedDeprecationDate"),
+			},
+			{
+				Name:        "categories",
+				Description: "The categories of the recommendation, for example 'Compute', 'Networking', 'Data', 'IdentityAndAccess' or 'IoT'.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AssessmentMetadataPropertiesResponse.Categories"),
+			},
+			{
+				Name:        "threats",
+				Description: "The threats the recommendation protects against.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AssessmentMetadataPropertiesResponse.Threats"),
+			},
+			{
+				Name:        "tactics",
+				Description: "The MITRE ATT&CK tactics the recommendation protects against.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AssessmentMetadataPropertiesResponse.Tactics"),
+			},
+			{
+				Name:        "techniques",
+				Description: "The MITRE ATT&CK techniques the recommendation protects against.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AssessmentMetadataPropertiesResponse.Techniques"),
+			},
+			{
+				Name:        "publish_dates",
+				Description: "The dates the recommendation was published in public preview and in general availability.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AssessmentMetadataPropertiesResponse.PublishDates"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AssessmentMetadataPropertiesResponse.DisplayName", "Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSecurityCenterAssessmentMetadata(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_assessment_metadata.listSecurityCenterAssessmentMetadata", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := security.NewAssessmentsMetadataClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_assessment_metadata.listSecurityCenterAssessmentMetadata", "api_error", err)
+		return nil, err
+	}
+
+	for _, metadata := range result.Values() {
+		d.StreamListItem(ctx, metadata)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_security_center_assessment_metadata.listSecurityCenterAssessmentMetadata", "api_paging_error", err)
+			return nil, err
+		}
+		for _, metadata := range result.Values() {
+			d.StreamListItem(ctx, metadata)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSecurityCenterAssessmentMetadata(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Return nil, if no input provided
+	if name == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_assessment_metadata.getSecurityCenterAssessmentMetadata", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := security.NewAssessmentsMetadataClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_assessment_metadata.getSecurityCenterAssessmentMetadata", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
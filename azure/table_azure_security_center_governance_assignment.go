@@ -0,0 +1,269 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/security/mgmt/security"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureSecurityCenterGovernanceAssignment(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_security_center_governance_assignment",
+		Description: "Azure Security Center Governance Assignment, the owners and due dates assigned to the remediation of the unhealthy assessments of Microsoft Defender for Cloud.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"resource_id", "assessment_name", "name"}),
+			Hydrate:    getSecurityCenterGovernanceAssignment,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listSecurityCenterAssessments,
+			Hydrate:       listSecurityCenterGovernanceAssignments,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "404"}),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "assessment_name",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name (assignment key) of the governance assignment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the governance assignment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "assessment_name",
+				Description: "The name of the assessment the governance assignment belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractSecurityCenterGovernanceAssignmentAssessmentName),
+			},
+			{
+				Name:        "resource_id",
+				Description: "The ID of the resource of the assessment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractSecurityCenterAssessmentResourceID),
+			},
+			{
+				Name:        "owner",
+				Description: "The email address of the owner responsible for the remediation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GovernanceAssignmentProperties.Owner"),
+			},
+			{
+				Name:        "remediation_due_date",
+				Description: "The date the remediation is due.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("GovernanceAssignmentProperties.RemediationDueDate").Transform(convertDateToTime),
+			},
+			{
+				Name:        "remediation_eta",
+				Description: "The estimated time of the remediation, set by the owner.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("GovernanceAssignmentProperties.RemediationEta.Eta").Transform(convertDateToTime),
+			},
+			{
+				Name:        "remediation_eta_justification",
+				Description: "The justification of the owner for the estimated time of the remediation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GovernanceAssignmentProperties.RemediationEta.Justification"),
+			},
+			{
+				Name:        "is_grace_period",
+				Description: "Indicates whether the secure score is not affected by the assessment until the due date.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("GovernanceAssignmentProperties.IsGracePeriod"),
+			},
+			{
+				Name:        "disable_manager_email_notification",
+				Description: "Indicates whether the weekly email notifications of the manager of the owner are disabled.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("GovernanceAssignmentProperties.GovernanceEmailNotification.DisableManagerEmailNotification"),
+			},
+			{
+				Name:        "disable_owner_email_notification",
+				Description: "Indicates whether the weekly email notifications of the owner are disabled.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("GovernanceAssignmentProperties.GovernanceEmailNotification.DisableOwnerEmailNotification"),
+			},
+			{
+				Name:        "ticket_number",
+				Description: "The number of the ticket of the remediation, for governance rules integrated with ServiceNow.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("GovernanceAssignmentProperties.AdditionalData.TicketNumber"),
+			},
+			{
+				Name:        "ticket_link",
+				Description: "The link to the ticket of the remediation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GovernanceAssignmentProperties.AdditionalData.TicketLink"),
+			},
+			{
+				Name:        "ticket_status",
+				Description: "The status of the ticket of the remediation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GovernanceAssignmentProperties.AdditionalData.TicketStatus"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromSubAssessmentID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSecurityCenterGovernanceAssignments(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	assessment := h.Item.(SecurityCenterAssessmentInfo)
+
+	// Only the unhealthy assessments are assigned an owner
+	if assessment.AssessmentPropertiesResponse == nil || assessment.Status == nil || assessment.Status.Code != security.Unhealthy {
+		return nil, nil
+	}
+
+	assessmentName := d.EqualsQualString("assessment_name")
+	if assessmentName != "" && !strings.EqualFold(assessmentName, *assessment.Name) {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_governance_assignment.listSecurityCenterGovernanceAssignments", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := security.NewGovernanceAssignmentsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	// The assignments are listed at the scope of the assessed resource
+	resourceID := securityCenterAssessmentResourceID(*assessment.ID)
+	if resourceID == "" {
+		return nil, nil
+	}
+
+	result, err := client.List(ctx, strings.TrimPrefix(resourceID, "/"), *assessment.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_governance_assignment.listSecurityCenterGovernanceAssignments", "api_error", err)
+		return nil, err
+	}
+
+	for _, assignment := range result.Values() {
+		d.StreamListItem(ctx, assignment)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_security_center_governance_assignment.listSecurityCenterGovernanceAssignments", "api_paging_error", err)
+			return nil, err
+		}
+		for _, assignment := range result.Values() {
+			d.StreamListItem(ctx, assignment)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSecurityCenterGovernanceAssignment(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	resourceID := d.EqualsQualString("resource_id")
+	assessmentName := d.EqualsQualString("assessment_name")
+	name := d.EqualsQualString("name")
+
+	// Return nil, if no input provided
+	if resourceID == "" || assessmentName == "" || name == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_governance_assignment.getSecurityCenterGovernanceAssignment", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := security.NewGovernanceAssignmentsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, strings.TrimPrefix(resourceID, "/"), assessmentName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_governance_assignment.getSecurityCenterGovernanceAssignment", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// The ID of a governance assignment is of the form
+// {resourceId}/providers/Microsoft.Security/assessments/{assessmentName}/governanceAssignments/{assignmentKey}
+func extractSecurityCenterGovernanceAssignmentAssessmentName(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	segments := strings.Split(types.SafeString(d.Value), "/")
+	for i := len(segments) - 1; i > 0; i-- {
+		if strings.EqualFold(segments[i-1], "assessments") {
+			return segments[i], nil
+		}
+	}
+	return nil, nil
+}
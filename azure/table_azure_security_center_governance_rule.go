@@ -0,0 +1,221 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/security/mgmt/security"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureSecurityCenterGovernanceRule(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_security_center_governance_rule",
+		Description: "Azure Security Center Governance Rule, the rules of Microsoft Defender for Cloud that assign an owner and a due date to the remediation of the recommendations.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getSecurityCenterGovernanceRule,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listSecurityCenterGovernanceRules,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name (rule ID) of the governance rule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the governance rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the governance rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GovernanceRuleProperties.DisplayName"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the governance rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GovernanceRuleProperties.Description"),
+			},
+			{
+				Name:        "is_disabled",
+				Description: "Indicates whether the governance rule is disabled.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("GovernanceRuleProperties.IsDisabled"),
+			},
+			{
+				Name:        "rule_type",
+				Description: "The type of the governance rule. Possible values include: 'Integrated', 'ServiceNow'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GovernanceRuleProperties.RuleType"),
+			},
+			{
+				Name:        "rule_priority",
+				Description: "The priority of the governance rule, from 1 (highest) to 1000. Rules with a higher priority are applied first.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("GovernanceRuleProperties.RulePriority"),
+			},
+			{
+				Name:        "remediation_timeframe",
+				Description: "The time the owners have to remediate the recommendations, in the format 'days.hours:minutes:seconds'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GovernanceRuleProperties.RemediationTimeframe"),
+			},
+			{
+				Name:        "is_grace_period",
+				Description: "Indicates whether the secure score is not affected by the recommendations until their due date.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("GovernanceRuleProperties.IsGracePeriod"),
+			},
+			{
+				Name:        "source_resource_type",
+				Description: "The type of the resources the governance rule applies to. Possible values include: 'Assessments'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GovernanceRuleProperties.SourceResourceType"),
+			},
+			{
+				Name:        "owner_source_type",
+				Description: "How the owner of the recommendations is set. Possible values include: 'ByTag', 'Manually'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GovernanceRuleProperties.OwnerSource.Type"),
+			},
+			{
+				Name:        "owner_source_value",
+				Description: "The email address of the owner, or the name of the tag of the resources that holds it.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GovernanceRuleProperties.OwnerSource.Value"),
+			},
+			{
+				Name:        "disable_manager_email_notification",
+				Description: "Indicates whether the weekly email notifications of the managers of the owners are disabled.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("GovernanceRuleProperties.GovernanceEmailNotification.DisableManagerEmailNotification"),
+			},
+			{
+				Name:        "disable_owner_email_notification",
+				Description: "Indicates whether the weekly email notifications of the owners are disabled.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("GovernanceRuleProperties.GovernanceEmailNotification.DisableOwnerEmailNotification"),
+			},
+			{
+				Name:        "condition_sets",
+				Description: "The conditions the recommendations must match for the governance rule to apply.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("GovernanceRuleProperties.ConditionSets"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GovernanceRuleProperties.DisplayName", "Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSecurityCenterGovernanceRules(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_governance_rule.listSecurityCenterGovernanceRules", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := security.NewGovernanceRuleClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_governance_rule.listSecurityCenterGovernanceRules", "api_error", err)
+		return nil, err
+	}
+
+	for _, rule := range result.Values() {
+		d.StreamListItem(ctx, rule)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_security_center_governance_rule.listSecurityCenterGovernanceRules", "api_paging_error", err)
+			return nil, err
+		}
+		for _, rule := range result.Values() {
+			d.StreamListItem(ctx, rule)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSecurityCenterGovernanceRule(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Return nil, if no input provided
+	if name == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_governance_rule.getSecurityCenterGovernanceRule", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := security.NewGovernanceRulesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_governance_rule.getSecurityCenterGovernanceRule", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,262 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/security/mgmt/security"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureSecurityCenterRegulatoryComplianceAssessment(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_security_center_regulatory_compliance_assessment",
+		Description: "Azure Security Center Regulatory Compliance Assessment, the assessments that evaluate the controls of the regulatory compliance standards, with the number of passed and failed resources.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"standard_name", "control_name", "name"}),
+			Hydrate:    getSecurityCenterRegulatoryComplianceAssessment,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listSecurityCenterRegulatoryComplianceStandards,
+			Hydrate:       listSecurityCenterRegulatoryComplianceAssessments,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				// A qualified control that is not part of the standard is not found
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "404"}),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "standard_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "control_name",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the regulatory compliance assessment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the regulatory compliance assessment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "standard_name",
+				Description: "The name of the regulatory compliance standard the assessment belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "control_name",
+				Description: "The name of the regulatory compliance control the assessment belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The resource type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the regulatory compliance assessment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RegulatoryComplianceAssessmentProperties.Description"),
+			},
+			{
+				Name:        "assessment_type",
+				Description: "The expected type of the assessment contained in the AssessmentDetailsLink.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RegulatoryComplianceAssessmentProperties.AssessmentType"),
+			},
+			{
+				Name:        "assessment_details_link",
+				Description: "The link to the details of the assessment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RegulatoryComplianceAssessmentProperties.AssessmentDetailsLink"),
+			},
+			{
+				Name:        "state",
+				Description: "The state of the assessment. Possible values include: 'Passed', 'Failed', 'Skipped', 'Unsupported'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RegulatoryComplianceAssessmentProperties.State"),
+			},
+			{
+				Name:        "passed_resources",
+				Description: "The number of resources that passed the assessment.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("RegulatoryComplianceAssessmentProperties.PassedResources"),
+			},
+			{
+				Name:        "failed_resources",
+				Description: "The number of resources that failed the assessment.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("RegulatoryComplianceAssessmentProperties.FailedResources"),
+			},
+			{
+				Name:        "skipped_resources",
+				Description: "The number of resources that were skipped by the assessment.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("RegulatoryComplianceAssessmentProperties.SkippedResources"),
+			},
+			{
+				Name:        "unsupported_resources",
+				Description: "The number of resources that the assessment does not support.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("RegulatoryComplianceAssessmentProperties.UnsupportedResources"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+		}),
+	}
+}
+
+type SecurityCenterRegulatoryComplianceAssessmentInfo struct {
+	StandardName *string
+	ControlName  *string
+	security.RegulatoryComplianceAssessment
+}
+
+//// LIST FUNCTION
+
+func listSecurityCenterRegulatoryComplianceAssessments(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	standard := h.Item.(security.RegulatoryComplianceStandard)
+
+	standardName := d.EqualsQualString("standard_name")
+	if standardName != "" && standardName != *standard.Name {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_regulatory_compliance_assessment.listSecurityCenterRegulatoryComplianceAssessments", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	controlClient := security.NewRegulatoryComplianceControlsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	controlClient.Authorizer = session.Authorizer
+
+	assessmentClient := security.NewRegulatoryComplianceAssessmentsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	assessmentClient.Authorizer = session.Authorizer
+
+	// The assessments are listed per control, so the controls of the standard are listed first
+	var controlNames []string
+	if controlName := d.EqualsQualString("control_name"); controlName != "" {
+		controlNames = append(controlNames, controlName)
+	} else {
+		result, err := controlClient.List(ctx, *standard.Name, "")
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_security_center_regulatory_compliance_assessment.listSecurityCenterRegulatoryComplianceAssessments", "api_error", err)
+			return nil, err
+		}
+		for _, control := range result.Values() {
+			controlNames = append(controlNames, *control.Name)
+		}
+		for result.NotDone() {
+			err = result.NextWithContext(ctx)
+			if err != nil {
+				plugin.Logger(ctx).Error("azure_security_center_regulatory_compliance_assessment.listSecurityCenterRegulatoryComplianceAssessments", "api_paging_error", err)
+				return nil, err
+			}
+			for _, control := range result.Values() {
+				controlNames = append(controlNames, *control.Name)
+			}
+		}
+	}
+
+	for _, controlName := range controlNames {
+		controlName := controlName
+		result, err := assessmentClient.List(ctx, *standard.Name, controlName, "")
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_security_center_regulatory_compliance_assessment.listSecurityCenterRegulatoryComplianceAssessments", "api_error", err)
+			return nil, err
+		}
+
+		for _, assessment := range result.Values() {
+			d.StreamListItem(ctx, SecurityCenterRegulatoryComplianceAssessmentInfo{standard.Name, &controlName, assessment})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		for result.NotDone() {
+			err = result.NextWithContext(ctx)
+			if err != nil {
+				plugin.Logger(ctx).Error("azure_security_center_regulatory_compliance_assessment.listSecurityCenterRegulatoryComplianceAssessments", "api_paging_error", err)
+				return nil, err
+			}
+			for _, assessment := range result.Values() {
+				d.StreamListItem(ctx, SecurityCenterRegulatoryComplianceAssessmentInfo{standard.Name, &controlName, assessment})
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSecurityCenterRegulatoryComplianceAssessment(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	standardName := d.EqualsQualString("standard_name")
+	controlName := d.EqualsQualString("control_name")
+	name := d.EqualsQualString("name")
+
+	// Return nil, if no input provided
+	if standardName == "" || controlName == "" || name == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_regulatory_compliance_assessment.getSecurityCenterRegulatoryComplianceAssessment", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := security.NewRegulatoryComplianceAssessmentsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, standardName, controlName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_regulatory_compliance_assessment.getSecurityCenterRegulatoryComplianceAssessment", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return SecurityCenterRegulatoryComplianceAssessmentInfo{&standardName, &controlName, op}, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,198 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/security/mgmt/security"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureSecurityCenterRegulatoryComplianceControl(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_security_center_regulatory_compliance_control",
+		Description: "Azure Security Center Regulatory Compliance Control, the controls of the regulatory compliance standards assessed by Microsoft Defender for Cloud, with the number of passed and failed assessments.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"standard_name", "name"}),
+			Hydrate:    getSecurityCenterRegulatoryComplianceControl,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listSecurityCenterRegulatoryComplianceStandards,
+			Hydrate:       listSecurityCenterRegulatoryComplianceControls,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "standard_name",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the regulatory compliance control, for example '1.1'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the regulatory compliance control.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "standard_name",
+				Description: "The name of the regulatory compliance standard the control belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The resource type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the regulatory compliance control.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RegulatoryComplianceControlProperties.Description"),
+			},
+			{
+				Name:        "state",
+				Description: "The aggregative state based on the assessments of the control. Possible values include: 'Passed', 'Failed', 'Skipped', 'Unsupported'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RegulatoryComplianceControlProperties.State"),
+			},
+			{
+				Name:        "passed_assessments",
+				Description: "The number of assessments of the control that passed.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("RegulatoryComplianceControlProperties.PassedAssessments"),
+			},
+			{
+				Name:        "failed_assessments",
+				Description: "The number of assessments of the control that failed.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("RegulatoryComplianceControlProperties.FailedAssessments"),
+			},
+			{
+				Name:        "skipped_assessments",
+				Description: "The number of assessments of the control that were skipped.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("RegulatoryComplianceControlProperties.SkippedAssessments"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+		}),
+	}
+}
+
+type SecurityCenterRegulatoryComplianceControlInfo struct {
+	StandardName *string
+	security.RegulatoryComplianceControl
+}
+
+//// LIST FUNCTION
+
+func listSecurityCenterRegulatoryComplianceControls(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	standard := h.Item.(security.RegulatoryComplianceStandard)
+
+	standardName := d.EqualsQualString("standard_name")
+	if standardName != "" && standardName != *standard.Name {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_regulatory_compliance_control.listSecurityCenterRegulatoryComplianceControls", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := security.NewRegulatoryComplianceControlsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx, *standard.Name, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_regulatory_compliance_control.listSecurityCenterRegulatoryComplianceControls", "api_error", err)
+		return nil, err
+	}
+
+	for _, control := range result.Values() {
+		d.StreamListItem(ctx, SecurityCenterRegulatoryComplianceControlInfo{standard.Name, control})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_security_center_regulatory_compliance_control.listSecurityCenterRegulatoryComplianceControls", "api_paging_error", err)
+			return nil, err
+		}
+		for _, control := range result.Values() {
+			d.StreamListItem(ctx, SecurityCenterRegulatoryComplianceControlInfo{standard.Name, control})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSecurityCenterRegulatoryComplianceControl(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	standardName := d.EqualsQualString("standard_name")
+	name := d.EqualsQualString("name")
+
+	// Return nil, if no input provided
+	if standardName == "" || name == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_regulatory_compliance_control.getSecurityCenterRegulatoryComplianceControl", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := security.NewRegulatoryComplianceControlsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, standardName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_regulatory_compliance_control.getSecurityCenterRegulatoryComplianceControl", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return SecurityCenterRegulatoryComplianceControlInfo{&standardName, op}, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,173 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/security/mgmt/security"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureSecurityCenterRegulatoryComplianceStandard(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_security_center_regulatory_compliance_standard",
+		Description: "Azure Security Center Regulatory Compliance Standard, the compliance standards like PCI DSS, ISO 27001 or NIST SP 800-53 assessed by Microsoft Defender for Cloud, with the number of passed and failed controls.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getSecurityCenterRegulatoryComplianceStandard,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listSecurityCenterRegulatoryComplianceStandards,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the regulatory compliance standard, for example 'PCI-DSS-4' or 'ISO-27001'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the regulatory compliance standard.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The aggregative state based on the controls of the standard. Possible values include: 'Passed', 'Failed', 'Skipped', 'Unsupported'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RegulatoryComplianceStandardProperties.State"),
+			},
+			{
+				Name:        "passed_controls",
+				Description: "The number of controls of the standard that passed.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("RegulatoryComplianceStandardProperties.PassedControls"),
+			},
+			{
+				Name:        "failed_controls",
+				Description: "The number of controls of the standard that failed.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("RegulatoryComplianceStandardProperties.FailedControls"),
+			},
+			{
+				Name:        "skipped_controls",
+				Description: "The number of controls of the standard that were skipped.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("RegulatoryComplianceStandardProperties.SkippedControls"),
+			},
+			{
+				Name:        "unsupported_controls",
+				Description: "The number of controls of the standard that are not supported by automated assessments.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("RegulatoryComplianceStandardProperties.UnsupportedControls"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSecurityCenterRegulatoryComplianceStandards(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_regulatory_compliance_standard.listSecurityCenterRegulatoryComplianceStandards", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := security.NewRegulatoryComplianceStandardsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_regulatory_compliance_standard.listSecurityCenterRegulatoryComplianceStandards", "api_error", err)
+		return nil, err
+	}
+
+	for _, standard := range result.Values() {
+		d.StreamListItem(ctx, standard)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_security_center_regulatory_compliance_standard.listSecurityCenterRegulatoryComplianceStandards", "api_paging_error", err)
+			return nil, err
+		}
+		for _, standard := range result.Values() {
+			d.StreamListItem(ctx, standard)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSecurityCenterRegulatoryComplianceStandard(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Return nil, if no input provided
+	if name == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_regulatory_compliance_standard.getSecurityCenterRegulatoryComplianceStandard", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := security.NewRegulatoryComplianceStandardsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_regulatory_compliance_standard.getSecurityCenterRegulatoryComplianceStandard", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
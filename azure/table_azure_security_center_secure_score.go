@@ -0,0 +1,173 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/security/mgmt/security"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureSecurityCenterSecureScore(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_security_center_secure_score",
+		Description: "Azure Security Center Secure Score, the current and maximum secure score of the subscription calculated by Microsoft Defender for Cloud.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getSecurityCenterSecureScore,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listSecurityCenterSecureScores,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the secure score, 'ascScore' for the score of the default initiative.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the secure score.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the initiative the secure score is calculated for.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SecureScoreItemProperties.DisplayName"),
+			},
+			{
+				Name:        "current_score",
+				Description: "The current score of the subscription.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("SecureScoreItemProperties.ScoreDetails.Current"),
+			},
+			{
+				Name:        "max_score",
+				Description: "The maximum score the subscription can reach.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("SecureScoreItemProperties.ScoreDetails.Max"),
+			},
+			{
+				Name:        "percentage",
+				Description: "The ratio of the current score to the maximum score, between 0 and 1.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("SecureScoreItemProperties.ScoreDetails.Percentage"),
+			},
+			{
+				Name:        "weight",
+				Description: "The relative weight of the subscription, used to aggregate the secure scores of several subscriptions.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("SecureScoreItemProperties.Weight"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SecureScoreItemProperties.DisplayName", "Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSecurityCenterSecureScores(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_secure_score.listSecurityCenterSecureScores", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := security.NewSecureScoresClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_secure_score.listSecurityCenterSecureScores", "api_error", err)
+		return nil, err
+	}
+
+	for _, score := range result.Values() {
+		d.StreamListItem(ctx, score)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_security_center_secure_score.listSecurityCenterSecureScores", "api_paging_error", err)
+			return nil, err
+		}
+		for _, score := range result.Values() {
+			d.StreamListItem(ctx, score)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSecurityCenterSecureScore(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Return nil, if no input provided
+	if name == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_secure_score.getSecurityCenterSecureScore", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := security.NewSecureScoresClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_secure_score.getSecurityCenterSecureScore", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
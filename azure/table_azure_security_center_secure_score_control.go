@@ -0,0 +1,219 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/security/mgmt/security"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureSecurityCenterSecureScoreControl(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_security_center_secure_score_control",
+		Description: "Azure Security Center Secure Score Control, the security controls of the secure score with their score and the number of healthy and unhealthy resources.",
+		List: &plugin.ListConfig{
+			Hydrate: listSecurityCenterSecureScoreControls,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "404"}),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "secure_score_name",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name (control ID) of the secure score control.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the secure score control.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "secure_score_name",
+				Description: "The name of the secure score the control belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractSecureScoreControlSecureScoreName),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the security control, for example 'Enable MFA' or 'Remediate vulnerabilities'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SecureScoreControlScoreDetails.DisplayName"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the security control.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SecureScoreControlScoreDetails.Definition.SecureScoreControlDefinitionItemProperties.Description"),
+			},
+			{
+				Name:        "control_type",
+				Description: "The type of the security control. Possible values include: 'BuiltIn', 'Custom'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SecureScoreControlScoreDetails.Definition.SecureScoreControlDefinitionItemProperties.Source.SourceType"),
+			},
+			{
+				Name:        "current_score",
+				Description: "The current score of the security control.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("SecureScoreControlScoreDetails.ScoreDetails.Current"),
+			},
+			{
+				Name:        "max_score",
+				Description: "The maximum score the security control can reach.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("SecureScoreControlScoreDetails.ScoreDetails.Max"),
+			},
+			{
+				Name:        "percentage",
+				Description: "The ratio of the current score to the maximum score, between 0 and 1.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("SecureScoreControlScoreDetails.ScoreDetails.Percentage"),
+			},
+			{
+				Name:        "healthy_resource_count",
+				Description: "The number of healthy resources in the security control.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("SecureScoreControlScoreDetails.HealthyResourceCount"),
+			},
+			{
+				Name:        "unhealthy_resource_count",
+				Description: "The number of unhealthy resources in the security control.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("SecureScoreControlScoreDetails.UnhealthyResourceCount"),
+			},
+			{
+				Name:        "not_applicable_resource_count",
+				Description: "The number of resources the security control does not apply to.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("SecureScoreControlScoreDetails.NotApplicableResourceCount"),
+			},
+			{
+				Name:        "weight",
+				Description: "The relative weight of the security control, used to aggregate the scores of several subscriptions.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("SecureScoreControlScoreDetails.Weight"),
+			},
+			{
+				Name:        "assessment_definition_ids",
+				Description: "The IDs of the assessment definitions (recommendations) of the security control.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SecureScoreControlScoreDetails.Definition.SecureScoreControlDefinitionItemProperties.AssessmentDefinitions").Transform(extractSecureScoreControlAssessmentDefinitionIDs),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SecureScoreControlScoreDetails.DisplayName", "Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSecurityCenterSecureScoreControls(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_secure_score_control.listSecurityCenterSecureScoreControls", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := security.NewSecureScoreControlsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	// The definition of the controls is expanded for their description and assessments
+	var result security.SecureScoreControlListPage
+	if secureScoreName := d.EqualsQualString("secure_score_name"); secureScoreName != "" {
+		result, err = client.ListBySecureScore(ctx, secureScoreName, security.Definition)
+	} else {
+		result, err = client.List(ctx, security.Definition)
+	}
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_security_center_secure_score_control.listSecurityCenterSecureScoreControls", "api_error", err)
+		return nil, err
+	}
+
+	for _, control := range result.Values() {
+		d.StreamListItem(ctx, control)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_security_center_secure_score_control.listSecurityCenterSecureScoreControls", "api_paging_error", err)
+			return nil, err
+		}
+		for _, control := range result.Values() {
+			d.StreamListItem(ctx, control)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// The ID of a control is of the form
+// /subscriptions/{subscriptionId}/providers/Microsoft.Security/secureScores/{secureScoreName}/secureScoreControls/{controlName}
+func extractSecureScoreControlSecureScoreName(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	segments := strings.Split(types.SafeString(d.Value), "/")
+	for i, segment := range segments {
+		if strings.EqualFold(segment, "secureScores") && i+1 < len(segments) {
+			return segments[i+1], nil
+		}
+	}
+	return nil, nil
+}
+
+func extractSecureScoreControlAssessmentDefinitionIDs(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	links, ok := d.Value.(*[]security.AzureResourceLink)
+	if !ok || links == nil {
+		return nil, nil
+	}
+
+	ids := []string{}
+	for _, link := range *links {
+		if link.ID != nil {
+			ids = append(ids, *link.ID)
+		}
+	}
+	return ids, nil
+}
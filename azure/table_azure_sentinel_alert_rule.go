@@ -0,0 +1,357 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/operationalinsights/mgmt/operationalinsights"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureSentinelAlertRule(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_sentinel_alert_rule",
+		Description: "Azure Sentinel Alert Rule, the analytics rules that Microsoft Sentinel runs to raise alerts and incidents in a Log Analytics workspace.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"workspace_name", "resource_group", "name"}),
+			Hydrate:    getSentinelAlertRule,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listLogAnalyticsWorkspaces,
+			Hydrate:       listSentinelAlertRules,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				// Workspaces that Sentinel is not onboarded to report a bad request
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "404", "not onboarded"}),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "workspace_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "resource_group",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name (rule ID) of the alert rule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the alert rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "workspace_name",
+				Description: "The name of the Log Analytics workspace the alert rule belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kind",
+				Description: "The kind of the alert rule. Possible values include: 'Scheduled', 'NRT', 'Fusion', 'MicrosoftSecurityIncidentCreation', 'MLBehaviorAnalytics', 'ThreatIntelligence'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the alert rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DisplayName"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the alert rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Description"),
+			},
+			{
+				Name:        "enabled",
+				Description: "Indicates whether the alert rule is enabled.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.Enabled"),
+			},
+			{
+				Name:        "severity",
+				Description: "The severity of the alerts raised by the alert rule. Possible values include: 'High', 'Medium', 'Low', 'Informational'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Severity"),
+			},
+			{
+				Name:        "query",
+				Description: "The query that creates alerts, for scheduled and NRT rules.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Query"),
+			},
+			{
+				Name:        "query_frequency",
+				Description: "How often the query of a scheduled rule runs, as an ISO 8601 duration.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.QueryFrequency"),
+			},
+			{
+				Name:        "query_period",
+				Description: "The time window the query of a scheduled rule looks back over, as an ISO 8601 duration.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.QueryPeriod"),
+			},
+			{
+				Name:        "trigger_operator",
+				Description: "The operation against the threshold that triggers the alert rule. Possible values include: 'GreaterThan', 'LessThan', 'Equal', 'NotEqual'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.TriggerOperator"),
+			},
+			{
+				Name:        "trigger_threshold",
+				Description: "The threshold that triggers the alert rule.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Properties.TriggerThreshold"),
+			},
+			{
+				Name:        "suppression_enabled",
+				Description: "Indicates whether the query of the alert rule stops running after an alert is raised.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.SuppressionEnabled"),
+			},
+			{
+				Name:        "suppression_duration",
+				Description: "How long the query stops running after an alert is raised, as an ISO 8601 duration.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.SuppressionDuration"),
+			},
+			{
+				Name:        "alert_rule_template_name",
+				Description: "The name of the alert rule template the alert rule was created from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.AlertRuleTemplateName"),
+			},
+			{
+				Name:        "template_version",
+				Description: "The version of the alert rule template the alert rule was created from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.TemplateVersion"),
+			},
+			{
+				Name:        "product_filter",
+				Description: "The product whose alerts create incidents, for Microsoft security incident creation rules.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ProductFilter"),
+			},
+			{
+				Name:        "last_modified_time",
+				Description: "The last time the alert rule was modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.LastModifiedUtc"),
+			},
+			{
+				Name:        "etag",
+				Description: "An unique read-only string that changes whenever the resource is updated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tactics",
+				Description: "The tactics of the alert rule.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Tactics"),
+			},
+			{
+				Name:        "techniques",
+				Description: "The techniques of the alert rule.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Techniques"),
+			},
+			{
+				Name:        "severities_filter",
+				Description: "The alert severities that create incidents, for Microsoft security incident creation rules.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.SeveritiesFilter"),
+			},
+			{
+				Name:        "incident_configuration",
+				Description: "The settings of the incidents created from the alerts raised by the alert rule.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.IncidentConfiguration"),
+			},
+			{
+				Name:        "event_grouping_settings",
+				Description: "How the events of the query are grouped into alerts.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.EventGroupingSettings"),
+			},
+			{
+				Name:        "entity_mappings",
+				Description: "The entities mapped from the results of the query.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.EntityMappings"),
+			},
+			{
+				Name:        "system_data",
+				Description: "Metadata pertaining to creation and last modification of the alert rule.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DisplayName", "Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+// The SDK package models alert rules as polymorphic types, which lose their read-only
+// properties when marshalled, so alert rules are requested over REST
+const sentinelAPIVersion = "2024-03-01"
+
+type SentinelAlertRule struct {
+	WorkspaceName *string                      `json:"-"`
+	Location      *string                      `json:"-"`
+	ID            *string                      `json:"id,omitempty"`
+	Name          *string                      `json:"name,omitempty"`
+	Type          *string                      `json:"type,omitempty"`
+	Kind          *string                      `json:"kind,omitempty"`
+	Etag          *string                      `json:"etag,omitempty"`
+	SystemData    map[string]interface{}       `json:"systemData,omitempty"`
+	Properties    *SentinelAlertRuleProperties `json:"properties,omitempty"`
+}
+
+type SentinelAlertRuleProperties struct {
+	DisplayName           *string                `json:"displayName,omitempty"`
+	Description           *string                `json:"description,omitempty"`
+	Enabled               *bool                  `json:"enabled,omitempty"`
+	Severity              *string                `json:"severity,omitempty"`
+	Query                 *string                `json:"query,omitempty"`
+	QueryFrequency        *string                `json:"queryFrequency,omitempty"`
+	QueryPeriod           *string                `json:"queryPeriod,omitempty"`
+	TriggerOperator       *string                `json:"triggerOperator,omitempty"`
+	TriggerThreshold      *int64                 `json:"triggerThreshold,omitempty"`
+	SuppressionEnabled    *bool                  `json:"suppressionEnabled,omitempty"`
+	SuppressionDuration   *string                `json:"suppressionDuration,omitempty"`
+	AlertRuleTemplateName *string                `json:"alertRuleTemplateName,omitempty"`
+	TemplateVersion       *string                `json:"templateVersion,omitempty"`
+	ProductFilter         *string                `json:"productFilter,omitempty"`
+	LastModifiedUtc       *string                `json:"lastModifiedUtc,omitempty"`
+	Tactics               []string               `json:"tactics,omitempty"`
+	Techniques            []string               `json:"techniques,omitempty"`
+	SeveritiesFilter      []string               `json:"severitiesFilter,omitempty"`
+	IncidentConfiguration map[string]interface{} `json:"incidentConfiguration,omitempty"`
+	EventGroupingSettings map[string]interface{} `json:"eventGroupingSettings,omitempty"`
+	EntityMappings        []interface{}          `json:"entityMappings,omitempty"`
+}
+
+//// LIST FUNCTION
+
+func listSentinelAlertRules(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	workspace := h.Item.(operationalinsights.Workspace)
+	resourceGroup := strings.Split(*workspace.ID, "/")[4]
+
+	workspaceName := d.EqualsQualString("workspace_name")
+	rgName := d.EqualsQualString("resource_group")
+
+	if workspaceName != "" && workspaceName != *workspace.Name {
+		return nil, nil
+	}
+	if rgName != "" && !strings.EqualFold(rgName, resourceGroup) {
+		return nil, nil
+	}
+
+	path := *workspace.ID + "/providers/Microsoft.SecurityInsights/alertRules"
+	err := listResourceManagerResources(ctx, d, path, sentinelAPIVersion, func(items []json.RawMessage) (bool, error) {
+		for _, item := range items {
+			rule := SentinelAlertRule{WorkspaceName: workspace.Name, Location: workspace.Location}
+			if err := json.Unmarshal(item, &rule); err != nil {
+				return false, err
+			}
+			d.StreamListItem(ctx, rule)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sentinel_alert_rule.listSentinelAlertRules", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSentinelAlertRule(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	workspaceName := d.EqualsQualString("workspace_name")
+	resourceGroup := d.EqualsQualString("resource_group")
+	name := d.EqualsQualString("name")
+
+	// Return nil, if no input provided
+	if workspaceName == "" || resourceGroup == "" || name == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sentinel_alert_rule.getSentinelAlertRule", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	// The workspace is fetched for its location, which the alert rule does not carry
+	workspaceClient := operationalinsights.NewWorkspacesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	workspaceClient.Authorizer = session.Authorizer
+
+	workspace, err := workspaceClient.Get(ctx, resourceGroup, workspaceName)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sentinel_alert_rule.getSentinelAlertRule", "api_error", err)
+		return nil, err
+	}
+
+	rule := SentinelAlertRule{WorkspaceName: workspace.Name, Location: workspace.Location}
+	path := *workspace.ID + "/providers/Microsoft.SecurityInsights/alertRules/" + name
+	found, err := getResourceManagerResource(ctx, d, path, sentinelAPIVersion, &rule)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sentinel_alert_rule.getSentinelAlertRule", "api_error", err)
+		return nil, err
+	}
+
+	if found && rule.ID != nil {
+		return rule, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,320 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/operationalinsights/mgmt/operationalinsights"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureSentinelAutomationRule(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_sentinel_automation_rule",
+		Description: "Azure Sentinel Automation Rule, the rules that Microsoft Sentinel runs when incidents or alerts are created or updated, to modify them or run playbooks.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"workspace_name", "resource_group", "name"}),
+			Hydrate:    getSentinelAutomationRule,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listLogAnalyticsWorkspaces,
+			Hydrate:       listSentinelAutomationRules,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				// Workspaces that Sentinel is not onboarded to report a bad request
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "404", "not onboarded"}),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "workspace_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "resource_group",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name (rule ID) of the automation rule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the automation rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "workspace_name",
+				Description: "The name of the Log Analytics workspace the automation rule belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the automation rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DisplayName"),
+			},
+			{
+				Name:        "order",
+				Description: "The order the automation rule runs in, relative to the other automation rules.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Properties.Order"),
+			},
+			{
+				Name:        "enabled",
+				Description: "Indicates whether the automation rule is enabled.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.TriggeringLogic.IsEnabled"),
+			},
+			{
+				Name:        "triggers_on",
+				Description: "The kind of object the automation rule is triggered by. Possible values include: 'Incidents', 'Alerts'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.TriggeringLogic.TriggersOn"),
+			},
+			{
+				Name:        "triggers_when",
+				Description: "The event the automation rule is triggered by. Possible values include: 'Created', 'Updated'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.TriggeringLogic.TriggersWhen"),
+			},
+			{
+				Name:        "expiration_time",
+				Description: "The time the automation rule stops running, if any.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.TriggeringLogic.ExpirationTimeUtc"),
+			},
+			{
+				Name:        "created_time",
+				Description: "The time the automation rule was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.CreatedTimeUtc"),
+			},
+			{
+				Name:        "last_modified_time",
+				Description: "The last time the automation rule was modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.LastModifiedTimeUtc"),
+			},
+			{
+				Name:        "etag",
+				Description: "An unique read-only string that changes whenever the resource is updated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "conditions",
+				Description: "The conditions the triggering object must meet for the automation rule to run.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.TriggeringLogic.Conditions"),
+			},
+			{
+				Name:        "actions",
+				Description: "The actions the automation rule runs, in order.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Actions"),
+			},
+			{
+				Name:        "playbook_ids",
+				Description: "The resource IDs of the Logic Apps playbooks the automation rule runs.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Actions").Transform(extractSentinelAutomationRulePlaybookIDs),
+			},
+			{
+				Name:        "created_by",
+				Description: "The client that created the automation rule.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.CreatedBy"),
+			},
+			{
+				Name:        "last_modified_by",
+				Description: "The client that last modified the automation rule.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.LastModifiedBy"),
+			},
+			{
+				Name:        "system_data",
+				Description: "Metadata pertaining to creation and last modification of the automation rule.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DisplayName", "Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type SentinelAutomationRule struct {
+	WorkspaceName *string                           `json:"-"`
+	Location      *string                           `json:"-"`
+	ID            *string                           `json:"id,omitempty"`
+	Name          *string                           `json:"name,omitempty"`
+	Type          *string                           `json:"type,omitempty"`
+	Etag          *string                           `json:"etag,omitempty"`
+	SystemData    map[string]interface{}            `json:"systemData,omitempty"`
+	Properties    *SentinelAutomationRuleProperties `json:"properties,omitempty"`
+}
+
+type SentinelAutomationRuleProperties struct {
+	DisplayName         *string                                `json:"displayName,omitempty"`
+	Order               *int64                                 `json:"order,omitempty"`
+	TriggeringLogic     *SentinelAutomationRuleTriggeringLogic `json:"triggeringLogic,omitempty"`
+	Actions             []SentinelAutomationRuleAction         `json:"actions,omitempty"`
+	CreatedTimeUtc      *string                                `json:"createdTimeUtc,omitempty"`
+	LastModifiedTimeUtc *string                                `json:"lastModifiedTimeUtc,omitempty"`
+	CreatedBy           map[string]interface{}                 `json:"createdBy,omitempty"`
+	LastModifiedBy      map[string]interface{}                 `json:"lastModifiedBy,omitempty"`
+}
+
+type SentinelAutomationRuleTriggeringLogic struct {
+	IsEnabled         *bool         `json:"isEnabled,omitempty"`
+	ExpirationTimeUtc *string       `json:"expirationTimeUtc,omitempty"`
+	TriggersOn        *string       `json:"triggersOn,omitempty"`
+	TriggersWhen      *string       `json:"triggersWhen,omitempty"`
+	Conditions        []interface{} `json:"conditions,omitempty"`
+}
+
+type SentinelAutomationRuleAction struct {
+	Order               *int64                 `json:"order,omitempty"`
+	ActionType          *string                `json:"actionType,omitempty"`
+	ActionConfiguration map[string]interface{} `json:"actionConfiguration,omitempty"`
+}
+
+//// LIST FUNCTION
+
+func listSentinelAutomationRules(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	workspace := h.Item.(operationalinsights.Workspace)
+	resourceGroup := strings.Split(*workspace.ID, "/")[4]
+
+	workspaceName := d.EqualsQualString("workspace_name")
+	rgName := d.EqualsQualString("resource_group")
+
+	if workspaceName != "" && workspaceName != *workspace.Name {
+		return nil, nil
+	}
+	if rgName != "" && !strings.EqualFold(rgName, resourceGroup) {
+		return nil, nil
+	}
+
+	path := *workspace.ID + "/providers/Microsoft.SecurityInsights/automationRules"
+	err := listResourceManagerResources(ctx, d, path, sentinelAPIVersion, func(items []json.RawMessage) (bool, error) {
+		for _, item := range items {
+			rule := SentinelAutomationRule{WorkspaceName: workspace.Name, Location: workspace.Location}
+			if err := json.Unmarshal(item, &rule); err != nil {
+				return false, err
+			}
+			d.StreamListItem(ctx, rule)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sentinel_automation_rule.listSentinelAutomationRules", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSentinelAutomationRule(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	workspaceName := d.EqualsQualString("workspace_name")
+	resourceGroup := d.EqualsQualString("resource_group")
+	name := d.EqualsQualString("name")
+
+	// Return nil, if no input provided
+	if workspaceName == "" || resourceGroup == "" || name == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sentinel_automation_rule.getSentinelAutomationRule", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	// The workspace is fetched for its location, which the automation rule does not carry
+	workspaceClient := operationalinsights.NewWorkspacesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	workspaceClient.Authorizer = session.Authorizer
+
+	workspace, err := workspaceClient.Get(ctx, resourceGroup, workspaceName)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sentinel_automation_rule.getSentinelAutomationRule", "api_error", err)
+		return nil, err
+	}
+
+	rule := SentinelAutomationRule{WorkspaceName: workspace.Name, Location: workspace.Location}
+	path := *workspace.ID + "/providers/Microsoft.SecurityInsights/automationRules/" + name
+	found, err := getResourceManagerResource(ctx, d, path, sentinelAPIVersion, &rule)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sentinel_automation_rule.getSentinelAutomationRule", "api_error", err)
+		return nil, err
+	}
+
+	if found && rule.ID != nil {
+		return rule, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func extractSentinelAutomationRulePlaybookIDs(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	actions, ok := d.Value.([]SentinelAutomationRuleAction)
+	if !ok {
+		return nil, nil
+	}
+
+	playbookIDs := []string{}
+	for _, action := range actions {
+		if action.ActionType == nil || *action.ActionType != "RunPlaybook" {
+			continue
+		}
+		if id, ok := action.ActionConfiguration["logicAppResourceId"].(string); ok {
+			playbookIDs = append(playbookIDs, id)
+		}
+	}
+	return playbookIDs, nil
+}
@@ -0,0 +1,310 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/operationalinsights/mgmt/operationalinsights"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureSentinelDataConnector(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_sentinel_data_connector",
+		Description: "Azure Sentinel Data Connector, the connectors that stream the data of Microsoft and third-party services into a Microsoft Sentinel workspace.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"workspace_name", "resource_group", "name"}),
+			Hydrate:    getSentinelDataConnector,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listLogAnalyticsWorkspaces,
+			Hydrate:       listSentinelDataConnectors,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				// Workspaces that Sentinel is not onboarded to report a bad request
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "404", "not onboarded"}),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "workspace_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "resource_group",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name (connector ID) of the data connector.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the data connector.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "workspace_name",
+				Description: "The name of the Log Analytics workspace the data connector belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kind",
+				Description: "The kind of the data connector, for example 'AzureActiveDirectory', 'AzureSecurityCenter', 'Office365', 'MicrosoftThreatProtection' or 'AmazonWebServicesCloudTrail'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the data connector, 'Enabled' if any of its data types is enabled, otherwise 'Disabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DataTypes").Transform(extractSentinelDataConnectorState),
+			},
+			{
+				Name:        "connector_tenant_id",
+				Description: "The ID of the tenant the data of the connector is read from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.TenantID"),
+			},
+			{
+				Name:        "connector_subscription_id",
+				Description: "The ID of the subscription the data of the connector is read from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.SubscriptionID"),
+			},
+			{
+				Name:        "etag",
+				Description: "An unique read-only string that changes whenever the resource is updated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "enabled_data_types",
+				Description: "The names of the data types of the connector that are enabled.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.DataTypes").Transform(extractSentinelDataConnectorEnabledDataTypes),
+			},
+			{
+				Name:        "data_types",
+				Description: "The data types of the connector, with their state.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.DataTypes"),
+			},
+			{
+				Name:        "properties",
+				Description: "The kind specific properties of the data connector.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("RawProperties"),
+			},
+			{
+				Name:        "system_data",
+				Description: "Metadata pertaining to creation and last modification of the data connector.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type SentinelDataConnector struct {
+	WorkspaceName *string                          `json:"-"`
+	Location      *string                          `json:"-"`
+	ID            *string                          `json:"id,omitempty"`
+	Name          *string                          `json:"name,omitempty"`
+	Type          *string                          `json:"type,omitempty"`
+	Kind          *string                          `json:"kind,omitempty"`
+	Etag          *string                          `json:"etag,omitempty"`
+	SystemData    map[string]interface{}           `json:"systemData,omitempty"`
+	Properties    *SentinelDataConnectorProperties `json:"properties,omitempty"`
+	RawProperties map[string]interface{}           `json:"-"`
+}
+
+type SentinelDataConnectorProperties struct {
+	TenantID       *string                                  `json:"tenantId,omitempty"`
+	SubscriptionID *string                                  `json:"subscriptionId,omitempty"`
+	DataTypes      map[string]SentinelDataConnectorDataType `json:"dataTypes,omitempty"`
+}
+
+type SentinelDataConnectorDataType struct {
+	State *string `json:"state,omitempty"`
+}
+
+//// LIST FUNCTION
+
+func listSentinelDataConnectors(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	workspace := h.Item.(operationalinsights.Workspace)
+	resourceGroup := strings.Split(*workspace.ID, "/")[4]
+
+	workspaceName := d.EqualsQualString("workspace_name")
+	rgName := d.EqualsQualString("resource_group")
+
+	if workspaceName != "" && workspaceName != *workspace.Name {
+		return nil, nil
+	}
+	if rgName != "" && !strings.EqualFold(rgName, resourceGroup) {
+		return nil, nil
+	}
+
+	path := *workspace.ID + "/providers/Microsoft.SecurityInsights/dataConnectors"
+	err := listResourceManagerResources(ctx, d, path, sentinelAPIVersion, func(items []json.RawMessage) (bool, error) {
+		for _, item := range items {
+			connector := SentinelDataConnector{WorkspaceName: workspace.Name, Location: workspace.Location}
+			if err := unmarshalSentinelDataConnector(item, &connector); err != nil {
+				return false, err
+			}
+			d.StreamListItem(ctx, connector)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sentinel_data_connector.listSentinelDataConnectors", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSentinelDataConnector(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	workspaceName := d.EqualsQualString("workspace_name")
+	resourceGroup := d.EqualsQualString("resource_group")
+	name := d.EqualsQualString("name")
+
+	// Return nil, if no input provided
+	if workspaceName == "" || resourceGroup == "" || name == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sentinel_data_connector.getSentinelDataConnector", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	// The workspace is fetched for its location, which the data connector does not carry
+	workspaceClient := operationalinsights.NewWorkspacesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	workspaceClient.Authorizer = session.Authorizer
+
+	workspace, err := workspaceClient.Get(ctx, resourceGroup, workspaceName)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sentinel_data_connector.getSentinelDataConnector", "api_error", err)
+		return nil, err
+	}
+
+	var item json.RawMessage
+	path := *workspace.ID + "/providers/Microsoft.SecurityInsights/dataConnectors/" + name
+	found, err := getResourceManagerResource(ctx, d, path, sentinelAPIVersion, &item)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sentinel_data_connector.getSentinelDataConnector", "api_error", err)
+		return nil, err
+	}
+	if !found {
+		return nil, nil
+	}
+
+	connector := SentinelDataConnector{WorkspaceName: workspace.Name, Location: workspace.Location}
+	if err := unmarshalSentinelDataConnector(item, &connector); err != nil {
+		plugin.Logger(ctx).Error("azure_sentinel_data_connector.getSentinelDataConnector", "unmarshal_error", err)
+		return nil, err
+	}
+
+	if connector.ID != nil {
+		return connector, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func extractSentinelDataConnectorState(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	dataTypes, ok := d.Value.(map[string]SentinelDataConnectorDataType)
+	if !ok || len(dataTypes) == 0 {
+		return nil, nil
+	}
+
+	for _, dataType := range dataTypes {
+		if dataType.State != nil && strings.EqualFold(*dataType.State, "Enabled") {
+			return "Enabled", nil
+		}
+	}
+	return "Disabled", nil
+}
+
+func extractSentinelDataConnectorEnabledDataTypes(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	dataTypes, ok := d.Value.(map[string]SentinelDataConnectorDataType)
+	if !ok {
+		return nil, nil
+	}
+
+	enabled := []string{}
+	for name, dataType := range dataTypes {
+		if dataType.State != nil && strings.EqualFold(*dataType.State, "Enabled") {
+			enabled = append(enabled, name)
+		}
+	}
+	sort.Strings(enabled)
+	return enabled, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// The properties of a data connector depend on its kind, so they are also kept as they were returned
+func unmarshalSentinelDataConnector(item json.RawMessage, connector *SentinelDataConnector) error {
+	if err := json.Unmarshal(item, connector); err != nil {
+		return err
+	}
+
+	var raw struct {
+		Properties map[string]interface{} `json:"properties,omitempty"`
+	}
+	if err := json.Unmarshal(item, &raw); err != nil {
+		return err
+	}
+	connector.RawProperties = raw.Properties
+	return nil
+}
@@ -0,0 +1,437 @@
+package azure
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/operationalinsights/mgmt/operationalinsights"
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2022-01-01-preview/securityinsight"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureSentinelIncident(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_sentinel_incident",
+		Description: "Azure Sentinel Incident, the incidents raised by Microsoft Sentinel in the Log Analytics workspaces it is onboarded to.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"workspace_name", "resource_group", "name"}),
+			Hydrate:    getSentinelIncident,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listLogAnalyticsWorkspaces,
+			Hydrate:       listSentinelIncidents,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				// Workspaces that Sentinel is not onboarded to report a bad request
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "404", "not onboarded"}),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "workspace_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "resource_group",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "status",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "severity",
+					Require: plugin.Optional,
+				},
+				{
+					Name:      "created_time",
+					Require:   plugin.Optional,
+					Operators: []string{">", ">=", "<", "<="},
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name (incident ID) of the incident.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "Contains ID to identify an incident uniquely.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "workspace_name",
+				Description: "The name of the Log Analytics workspace the incident belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "incident_number",
+				Description: "The sequential number of the incident in the workspace.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("IncidentProperties.IncidentNumber"),
+			},
+			{
+				Name:        "incident_title",
+				Description: "The title of the incident.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IncidentProperties.Title"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the incident.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IncidentProperties.Description"),
+			},
+			{
+				Name:        "severity",
+				Description: "The severity of the incident. Possible values include: 'High', 'Medium', 'Low', 'Informational'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IncidentProperties.Severity"),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the incident. Possible values include: 'New', 'Active', 'Closed'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IncidentProperties.Status"),
+			},
+			{
+				Name:        "classification",
+				Description: "The reason the incident was closed. Possible values include: 'Undetermined', 'TruePositive', 'BenignPositive', 'FalsePositive'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IncidentProperties.Classification"),
+			},
+			{
+				Name:        "classification_reason",
+				Description: "The classification reason the incident was closed with.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IncidentProperties.ClassificationReason"),
+			},
+			{
+				Name:        "classification_comment",
+				Description: "Describes the reason the incident was closed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IncidentProperties.ClassificationComment"),
+			},
+			{
+				Name:        "owner_assigned_to",
+				Description: "The name of the user the incident is assigned to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IncidentProperties.Owner.AssignedTo"),
+			},
+			{
+				Name:        "owner_email",
+				Description: "The email of the user the incident is assigned to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IncidentProperties.Owner.Email"),
+			},
+			{
+				Name:        "owner_user_principal_name",
+				Description: "The user principal name of the user the incident is assigned to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IncidentProperties.Owner.UserPrincipalName"),
+			},
+			{
+				Name:        "owner_object_id",
+				Description: "The object ID of the user the incident is assigned to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IncidentProperties.Owner.ObjectID"),
+			},
+			{
+				Name:        "owner_type",
+				Description: "The type of the owner the incident is assigned to. Possible values include: 'Unknown', 'User', 'Group'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IncidentProperties.Owner.OwnerType"),
+			},
+			{
+				Name:        "alerts_count",
+				Description: "The number of alerts in the incident.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("IncidentProperties.AdditionalData.AlertsCount"),
+			},
+			{
+				Name:        "bookmarks_count",
+				Description: "The number of bookmarks in the incident.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("IncidentProperties.AdditionalData.BookmarksCount"),
+			},
+			{
+				Name:        "comments_count",
+				Description: "The number of comments in the incident.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("IncidentProperties.AdditionalData.CommentsCount"),
+			},
+			{
+				Name:        "created_time",
+				Description: "The time the incident was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("IncidentProperties.CreatedTimeUtc").Transform(convertDateToTime),
+			},
+			{
+				Name:        "first_activity_time",
+				Description: "The time of the first activity in the incident.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("IncidentProperties.FirstActivityTimeUtc").Transform(convertDateToTime),
+			},
+			{
+				Name:        "last_activity_time",
+				Description: "The time of the last activity in the incident.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("IncidentProperties.LastActivityTimeUtc").Transform(convertDateToTime),
+			},
+			{
+				Name:        "last_modified_time",
+				Description: "The last time the incident was updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("IncidentProperties.LastModifiedTimeUtc").Transform(convertDateToTime),
+			},
+			{
+				Name:        "incident_url",
+				Description: "The deep-link URL to the incident in the Azure portal.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IncidentProperties.IncidentURL"),
+			},
+			{
+				Name:        "provider_name",
+				Description: "The name of the source provider that generated the incident.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IncidentProperties.ProviderName"),
+			},
+			{
+				Name:        "provider_incident_id",
+				Description: "The incident ID assigned by the incident provider.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IncidentProperties.ProviderIncidentID"),
+			},
+			{
+				Name:        "etag",
+				Description: "An unique read-only string that changes whenever the resource is updated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tactics",
+				Description: "The tactics associated with the incident.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("IncidentProperties.AdditionalData.Tactics"),
+			},
+			{
+				Name:        "techniques",
+				Description: "The techniques associated with the tactics of the incident.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("IncidentProperties.AdditionalData.Techniques"),
+			},
+			{
+				Name:        "alert_product_names",
+				Description: "The product names of the alerts in the incident.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("IncidentProperties.AdditionalData.AlertProductNames"),
+			},
+			{
+				Name:        "labels",
+				Description: "The labels relevant to the incident.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("IncidentProperties.Labels"),
+			},
+			{
+				Name:        "related_analytic_rule_ids",
+				Description: "The resource IDs of the analytics rules related to the incident.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("IncidentProperties.RelatedAnalyticRuleIds"),
+			},
+			{
+				Name:        "team_information",
+				Description: "The team created for the incident.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("IncidentProperties.TeamInformation"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IncidentProperties.Title"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type SentinelIncidentInfo struct {
+	WorkspaceName *string
+	Location      *string
+	securityinsight.Incident
+}
+
+//// LIST FUNCTION
+
+func listSentinelIncidents(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	workspace := h.Item.(operationalinsights.Workspace)
+	resourceGroup := strings.Split(*workspace.ID, "/")[4]
+
+	workspaceName := d.EqualsQualString("workspace_name")
+	rgName := d.EqualsQualString("resource_group")
+
+	if workspaceName != "" && workspaceName != *workspace.Name {
+		return nil, nil
+	}
+	if rgName != "" && !strings.EqualFold(rgName, resourceGroup) {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sentinel_incident.listSentinelIncidents", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := securityinsight.NewIncidentsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx, resourceGroup, *workspace.Name, buildSentinelIncidentFilter(d.Quals), "", nil, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sentinel_incident.listSentinelIncidents", "api_error", err)
+		return nil, err
+	}
+
+	for _, incident := range result.Values() {
+		d.StreamListItem(ctx, SentinelIncidentInfo{workspace.Name, workspace.Location, incident})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_sentinel_incident.listSentinelIncidents", "api_paging_error", err)
+			return nil, err
+		}
+		for _, incident := range result.Values() {
+			d.StreamListItem(ctx, SentinelIncidentInfo{workspace.Name, workspace.Location, incident})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSentinelIncident(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	workspaceName := d.EqualsQualString("workspace_name")
+	resourceGroup := d.EqualsQualString("resource_group")
+	name := d.EqualsQualString("name")
+
+	// Return nil, if no input provided
+	if workspaceName == "" || resourceGroup == "" || name == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sentinel_incident.getSentinelIncident", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	// The workspace is fetched for its location, which the incident does not carry
+	workspaceClient := operationalinsights.NewWorkspacesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	workspaceClient.Authorizer = session.Authorizer
+
+	workspace, err := workspaceClient.Get(ctx, resourceGroup, workspaceName)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sentinel_incident.getSentinelIncident", "api_error", err)
+		return nil, err
+	}
+
+	client := securityinsight.NewIncidentsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, workspaceName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sentinel_incident.getSentinelIncident", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return SentinelIncidentInfo{workspace.Name, workspace.Location, op}, nil
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// buildSentinelIncidentFilter returns the OData filter of the status, severity and created_time quals
+func buildSentinelIncidentFilter(quals plugin.KeyColumnQualMap) string {
+	var filters []string
+
+	filterQuals := map[string]string{
+		"status":   "properties/status",
+		"severity": "properties/severity",
+	}
+	for columnName, filterName := range filterQuals {
+		if quals[columnName] != nil {
+			for _, q := range quals[columnName].Quals {
+				if q.Operator == "=" {
+					filters = append(filters, filterName+" eq '"+strings.ReplaceAll(q.Value.GetStringValue(), "'", "''")+"'")
+				}
+			}
+		}
+	}
+
+	if quals["created_time"] != nil {
+		for _, q := range quals["created_time"].Quals {
+			createdTime := q.Value.GetTimestampValue().AsTime().UTC().Format(time.RFC3339)
+			switch q.Operator {
+			case ">":
+				filters = append(filters, "properties/createdTimeUtc gt "+createdTime)
+			case ">=":
+				filters = append(filters, "properties/createdTimeUtc ge "+createdTime)
+			case "<":
+				filters = append(filters, "properties/createdTimeUtc lt "+createdTime)
+			case "<=":
+				filters = append(filters, "properties/createdTimeUtc le "+createdTime)
+			}
+		}
+	}
+
+	return strings.Join(filters, " and ")
+}
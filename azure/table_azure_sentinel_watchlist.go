@@ -0,0 +1,335 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/operationalinsights/mgmt/operationalinsights"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureSentinelWatchlist(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_sentinel_watchlist",
+		Description: "Azure Sentinel Watchlist, the lists of reference data, like VIP users or known IP ranges, that Microsoft Sentinel queries and rules correlate events with.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"workspace_name", "resource_group", "name"}),
+			Hydrate:    getSentinelWatchlist,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listLogAnalyticsWorkspaces,
+			Hydrate:       listSentinelWatchlists,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				// Workspaces that Sentinel is not onboarded to report a bad request
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "404", "not onboarded"}),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "workspace_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "resource_group",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name (alias) of the watchlist.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the watchlist.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "workspace_name",
+				Description: "The name of the Log Analytics workspace the watchlist belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "watchlist_id",
+				Description: "The unique ID of the watchlist.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.WatchlistID"),
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the watchlist.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DisplayName"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the watchlist.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Description"),
+			},
+			{
+				Name:        "provider",
+				Description: "The provider of the watchlist, for example 'Microsoft' for the watchlists created from templates.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Provider"),
+			},
+			{
+				Name:        "source",
+				Description: "The file name or the URL of the source of the watchlist.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Source"),
+			},
+			{
+				Name:        "source_type",
+				Description: "The type of the source of the watchlist. Possible values include: 'Local file', 'Remote storage'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.SourceType"),
+			},
+			{
+				Name:        "items_search_key",
+				Description: "The column of the watchlist used to join it with other data.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ItemsSearchKey"),
+			},
+			{
+				Name:        "item_count",
+				Description: "The number of items in the watchlist.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     countSentinelWatchlistItems,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "default_duration",
+				Description: "How long the items of the watchlist are kept, as an ISO 8601 duration.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DefaultDuration"),
+			},
+			{
+				Name:        "upload_status",
+				Description: "The status of the upload of the content of the watchlist.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.UploadStatus"),
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the watchlist.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ProvisioningState"),
+			},
+			{
+				Name:        "is_deleted",
+				Description: "Indicates whether the watchlist is deleted.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.IsDeleted"),
+			},
+			{
+				Name:        "created_time",
+				Description: "The time the watchlist was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.Created"),
+			},
+			{
+				Name:        "updated_time",
+				Description: "The last time the watchlist was updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.Updated"),
+			},
+			{
+				Name:        "etag",
+				Description: "An unique read-only string that changes whenever the resource is updated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_by",
+				Description: "The user who created the watchlist.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.CreatedBy"),
+			},
+			{
+				Name:        "updated_by",
+				Description: "The user who last updated the watchlist.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.UpdatedBy"),
+			},
+			{
+				Name:        "labels",
+				Description: "The labels of the watchlist.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Labels"),
+			},
+			{
+				Name:        "system_data",
+				Description: "Metadata pertaining to creation and last modification of the watchlist.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DisplayName", "Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type SentinelWatchlist struct {
+	WorkspaceName *string                      `json:"-"`
+	Location      *string                      `json:"-"`
+	ID            *string                      `json:"id,omitempty"`
+	Name          *string                      `json:"name,omitempty"`
+	Type          *string                      `json:"type,omitempty"`
+	Etag          *string                      `json:"etag,omitempty"`
+	SystemData    map[string]interface{}       `json:"systemData,omitempty"`
+	Properties    *SentinelWatchlistProperties `json:"properties,omitempty"`
+}
+
+type SentinelWatchlistProperties struct {
+	WatchlistID       *string                `json:"watchlistId,omitempty"`
+	DisplayName       *string                `json:"displayName,omitempty"`
+	Description       *string                `json:"description,omitempty"`
+	Provider          *string                `json:"provider,omitempty"`
+	Source            *string                `json:"source,omitempty"`
+	SourceType        *string                `json:"sourceType,omitempty"`
+	ItemsSearchKey    *string                `json:"itemsSearchKey,omitempty"`
+	DefaultDuration   *string                `json:"defaultDuration,omitempty"`
+	UploadStatus      *string                `json:"uploadStatus,omitempty"`
+	ProvisioningState *string                `json:"provisioningState,omitempty"`
+	IsDeleted         *bool                  `json:"isDeleted,omitempty"`
+	Created           *string                `json:"created,omitempty"`
+	Updated           *string                `json:"updated,omitempty"`
+	CreatedBy         map[string]interface{} `json:"createdBy,omitempty"`
+	UpdatedBy         map[string]interface{} `json:"updatedBy,omitempty"`
+	Labels            []string               `json:"labels,omitempty"`
+}
+
+//// LIST FUNCTION
+
+func listSentinelWatchlists(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	workspace := h.Item.(operationalinsights.Workspace)
+	resourceGroup := strings.Split(*workspace.ID, "/")[4]
+
+	workspaceName := d.EqualsQualString("workspace_name")
+	rgName := d.EqualsQualString("resource_group")
+
+	if workspaceName != "" && workspaceName != *workspace.Name {
+		return nil, nil
+	}
+	if rgName != "" && !strings.EqualFold(rgName, resourceGroup) {
+		return nil, nil
+	}
+
+	path := *workspace.ID + "/providers/Microsoft.SecurityInsights/watchlists"
+	err := listResourceManagerResources(ctx, d, path, sentinelAPIVersion, func(items []json.RawMessage) (bool, error) {
+		for _, item := range items {
+			watchlist := SentinelWatchlist{WorkspaceName: workspace.Name, Location: workspace.Location}
+			if err := json.Unmarshal(item, &watchlist); err != nil {
+				return false, err
+			}
+			d.StreamListItem(ctx, watchlist)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sentinel_watchlist.listSentinelWatchlists", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSentinelWatchlist(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	workspaceName := d.EqualsQualString("workspace_name")
+	resourceGroup := d.EqualsQualString("resource_group")
+	name := d.EqualsQualString("name")
+
+	// Return nil, if no input provided
+	if workspaceName == "" || resourceGroup == "" || name == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sentinel_watchlist.getSentinelWatchlist", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	// The workspace is fetched for its location, which the watchlist does not carry
+	workspaceClient := operationalinsights.NewWorkspacesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	workspaceClient.Authorizer = session.Authorizer
+
+	workspace, err := workspaceClient.Get(ctx, resourceGroup, workspaceName)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sentinel_watchlist.getSentinelWatchlist", "api_error", err)
+		return nil, err
+	}
+
+	watchlist := SentinelWatchlist{WorkspaceName: workspace.Name, Location: workspace.Location}
+	path := *workspace.ID + "/providers/Microsoft.SecurityInsights/watchlists/" + name
+	found, err := getResourceManagerResource(ctx, d, path, sentinelAPIVersion, &watchlist)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sentinel_watchlist.getSentinelWatchlist", "api_error", err)
+		return nil, err
+	}
+
+	if found && watchlist.ID != nil {
+		return watchlist, nil
+	}
+
+	return nil, nil
+}
+
+func countSentinelWatchlistItems(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	watchlist := h.Item.(SentinelWatchlist)
+
+	count := 0
+	err := listResourceManagerResources(ctx, d, *watchlist.ID+"/watchlistItems", sentinelAPIVersion, func(items []json.RawMessage) (bool, error) {
+		count += len(items)
+		return true, nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sentinel_watchlist.countSentinelWatchlistItems", "api_error", err)
+		return nil, err
+	}
+
+	return count, nil
+}
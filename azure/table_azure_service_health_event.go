@@ -0,0 +1,361 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureServiceHealthEvent(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_service_health_event",
+		Description: "Azure Service Health Event, the service issues, planned maintenances and health advisories impacting the subscription.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getServiceHealthEvent,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listServiceHealthEvents,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:      "last_update_time",
+					Require:   plugin.Optional,
+					Operators: []string{">", ">=", "="},
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The tracking ID of the event, for example 'XXXX-XXX'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the event.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource (Microsoft.ResourceHealth/events).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "event_type",
+				Description: "The type of the event, 'ServiceIssue', 'PlannedMaintenance', 'HealthAdvisory', 'SecurityAdvisory', 'RCA' or 'EmergingIssues'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.EventType"),
+			},
+			{
+				Name:        "event_source",
+				Description: "The source of the event, 'ResourceHealth' or 'ServiceHealth'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.EventSource"),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the event, 'Active' or 'Resolved'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Status"),
+			},
+			{
+				Name:        "level",
+				Description: "The level of the event, 'Critical', 'Error', 'Warning' or 'Informational'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Level"),
+			},
+			{
+				Name:        "event_level",
+				Description: "The level of the event as displayed in the portal, 'Critical', 'Error', 'Warning' or 'Informational'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.EventLevel"),
+			},
+			{
+				Name:        "summary",
+				Description: "The summary of the event.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Summary"),
+			},
+			{
+				Name:        "header",
+				Description: "The header of the event.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Header"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the event.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Description"),
+			},
+			{
+				Name:        "reason",
+				Description: "The reason of the event.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Reason"),
+			},
+			{
+				Name:        "impact_start_time",
+				Description: "The time when the impact of the event started.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.ImpactStartTime"),
+			},
+			{
+				Name:        "impact_mitigation_time",
+				Description: "The time when the impact of the event was mitigated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.ImpactMitigationTime"),
+			},
+			{
+				Name:        "last_update_time",
+				Description: "The time when the event was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.LastUpdateTime"),
+			},
+			{
+				Name:        "impacted_services",
+				Description: "The names of the services impacted by the event.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Impact").Transform(serviceHealthEventImpactedServices),
+			},
+			{
+				Name:        "impacted_regions",
+				Description: "The names of the regions impacted by the event.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Impact").Transform(serviceHealthEventImpactedRegions),
+			},
+			{
+				Name:        "impact",
+				Description: "The services and the regions impacted by the event, with the status and the updates of each region.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Impact"),
+			},
+			{
+				Name:        "is_hir",
+				Description: "Indicates whether the event is a high impact incident, or not.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.IsHIR"),
+			},
+			{
+				Name:        "platform_initiated",
+				Description: "Indicates whether the event was initiated by the platform, or not.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.PlatformInitiated"),
+			},
+			{
+				Name:        "priority",
+				Description: "The priority of the event, the lower the more important.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Properties.Priority"),
+			},
+			{
+				Name:        "external_incident_id",
+				Description: "The ID of the incident of the event, in the ticketing system of the service.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ExternalIncidentID"),
+			},
+			{
+				Name:        "recommended_actions",
+				Description: "The actions recommended to mitigate the impact of the event.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.RecommendedActions"),
+			},
+			{
+				Name:        "links",
+				Description: "The links of the event, such as the ones to the root cause analysis or to a support request.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Links"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Title", "Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+		}),
+	}
+}
+
+// The events of the Microsoft.ResourceHealth resource provider are more recent than its SDK package
+const serviceHealthEventAPIVersion = "2022-10-01"
+
+type ServiceHealthEvent struct {
+	ID         *string                       `json:"id,omitempty"`
+	Name       *string                       `json:"name,omitempty"`
+	Type       *string                       `json:"type,omitempty"`
+	Properties *ServiceHealthEventProperties `json:"properties,omitempty"`
+}
+
+type ServiceHealthEventProperties struct {
+	EventType            *string                               `json:"eventType,omitempty"`
+	EventSource          *string                               `json:"eventSource,omitempty"`
+	Status               *string                               `json:"status,omitempty"`
+	Title                *string                               `json:"title,omitempty"`
+	Summary              *string                               `json:"summary,omitempty"`
+	Header               *string                               `json:"header,omitempty"`
+	Description          *string                               `json:"description,omitempty"`
+	Reason               *string                               `json:"reason,omitempty"`
+	Level                *string                               `json:"level,omitempty"`
+	EventLevel           *string                               `json:"eventLevel,omitempty"`
+	ExternalIncidentID   *string                               `json:"externalIncidentId,omitempty"`
+	ImpactStartTime      *time.Time                            `json:"impactStartTime,omitempty"`
+	ImpactMitigationTime *time.Time                            `json:"impactMitigationTime,omitempty"`
+	LastUpdateTime       *time.Time                            `json:"lastUpdateTime,omitempty"`
+	Impact               []ServiceHealthEventImpact            `json:"impact,omitempty"`
+	IsHIR                *bool                                 `json:"isHIR,omitempty"`
+	PlatformInitiated    *bool                                 `json:"platformInitiated,omitempty"`
+	Priority             *int64                                `json:"priority,omitempty"`
+	RecommendedActions   *ServiceHealthEventRecommendedActions `json:"recommendedActions,omitempty"`
+	Links                []map[string]interface{}              `json:"links,omitempty"`
+}
+
+type ServiceHealthEventImpact struct {
+	ImpactedService *string                            `json:"impactedService,omitempty"`
+	ImpactedRegions []ServiceHealthEventImpactedRegion `json:"impactedRegions,omitempty"`
+}
+
+type ServiceHealthEventImpactedRegion struct {
+	ImpactedRegion        *string                  `json:"impactedRegion,omitempty"`
+	Status                *string                  `json:"status,omitempty"`
+	ImpactedSubscriptions []string                 `json:"impactedSubscriptions,omitempty"`
+	LastUpdateTime        *time.Time               `json:"lastUpdateTime,omitempty"`
+	Updates               []map[string]interface{} `json:"updates,omitempty"`
+}
+
+type ServiceHealthEventRecommendedActions struct {
+	Message    *string                  `json:"message,omitempty"`
+	Actions    []map[string]interface{} `json:"actions,omitempty"`
+	LocaleCode *string                  `json:"localeCode,omitempty"`
+}
+
+//// LIST FUNCTION
+
+func listServiceHealthEvents(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_service_health_event.listServiceHealthEvents", "session_error", err)
+		return nil, err
+	}
+
+	path := "/subscriptions/" + session.SubscriptionID + "/providers/Microsoft.ResourceHealth/events"
+
+	// The events updated since the start of the query are returned, by default the ones of the last 3 months
+	if d.Quals["last_update_time"] != nil && len(d.Quals["last_update_time"].Quals) > 0 {
+		queryStartTime := d.Quals["last_update_time"].Quals[0].Value.GetTimestampValue().AsTime().UTC().Format("01/02/2006")
+		path += "?" + url.Values{"queryStartTime": []string{queryStartTime}}.Encode()
+	}
+
+	err = listResourceManagerResources(ctx, d, path, serviceHealthEventAPIVersion, func(items []json.RawMessage) (bool, error) {
+		for _, item := range items {
+			var event ServiceHealthEvent
+			if err := json.Unmarshal(item, &event); err != nil {
+				return false, err
+			}
+			d.StreamListItem(ctx, event)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_service_health_event.listServiceHealthEvents", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getServiceHealthEvent(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+
+	// Return nil, if no input provided
+	if name == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_service_health_event.getServiceHealthEvent", "session_error", err)
+		return nil, err
+	}
+
+	var event ServiceHealthEvent
+	path := "/subscriptions/" + session.SubscriptionID + "/providers/Microsoft.ResourceHealth/events/" + name
+	found, err := getResourceManagerResource(ctx, d, path, serviceHealthEventAPIVersion, &event)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_service_health_event.getServiceHealthEvent", "api_error", err)
+		return nil, err
+	}
+
+	if found && event.ID != nil {
+		return event, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func serviceHealthEventImpactedServices(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	impacts, ok := d.Value.([]ServiceHealthEventImpact)
+	if !ok {
+		return nil, nil
+	}
+
+	services := []string{}
+	for _, impact := range impacts {
+		if impact.ImpactedService != nil {
+			services = append(services, *impact.ImpactedService)
+		}
+	}
+	return services, nil
+}
+
+func serviceHealthEventImpactedRegions(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	impacts, ok := d.Value.([]ServiceHealthEventImpact)
+	if !ok {
+		return nil, nil
+	}
+
+	// The same region is usually impacted by several services of the event
+	occurred := map[string]bool{}
+	for _, impact := range impacts {
+		for _, region := range impact.ImpactedRegions {
+			if region.ImpactedRegion != nil {
+				occurred[*region.ImpactedRegion] = true
+			}
+		}
+	}
+
+	regions := []string{}
+	for region := range occurred {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+	return regions, nil
+}
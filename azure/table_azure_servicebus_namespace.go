@@ -7,6 +7,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/servicebus/mgmt/servicebus"
 	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/monitor/mgmt/insights"
 
+	"github.com/turbot/go-kit/types"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
@@ -124,6 +125,18 @@ func tableAzureServiceBusNamespace(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("SBNamespaceProperties.Encryption"),
 			},
+			{
+				Name:        "customer_managed_key_enabled",
+				Description: "Indicates whether the namespace is encrypted with a customer-managed key stored in Azure Key Vault.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromP(extractServiceBusNamespaceCustomerManagedKey, "enabled"),
+			},
+			{
+				Name:        "customer_managed_key_uri",
+				Description: "The URI of the Key Vault key used to encrypt the namespace. If several keys are configured, the first key is returned.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(extractServiceBusNamespaceCustomerManagedKey, "uri"),
+			},
 			{
 				Name:        "network_rule_set",
 				Description: "Describes the network rule set for specified namespace. The ServiceBus Namespace must be Premium in order to attach a ServiceBus Namespace Network Rule Set.",
@@ -145,6 +158,24 @@ func tableAzureServiceBusNamespace(_ context.Context) *plugin.Table {
 				Hydrate:     listServiceBusNamespaceAuthorizationRules,
 				Transform:   transform.FromValue(),
 			},
+			{
+				Name:        "identity_type",
+				Description: "The type of managed identity used by the resource. Possible values include: 'SystemAssigned', 'UserAssigned', 'SystemAssigned, UserAssigned', 'None'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityType),
+			},
+			{
+				Name:        "system_assigned_principal_id",
+				Description: "The principal ID of the system-assigned managed identity of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityPrincipalID),
+			},
+			{
+				Name:        "user_assigned_identity_ids",
+				Description: "The resource IDs of the user-assigned managed identities associated with the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityUserAssignedIDs),
+			},
 
 			// Steampipe standard columns
 			{
@@ -458,3 +489,27 @@ func extractServiceBusNamespacePrivateEndpointConnection(i servicebus.PrivateEnd
 	}
 	return serviceBusNamespacePrivateEndpointConnection
 }
+
+// A namespace can be encrypted with several keys; the URI of the first key is returned
+func extractServiceBusNamespaceCustomerManagedKey(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	namespace := d.HydrateItem.(servicebus.SBNamespace)
+
+	var enabled bool
+	var keyURI string
+	if namespace.SBNamespaceProperties != nil && namespace.Encryption != nil {
+		encryption := namespace.Encryption
+		enabled = encryption.KeySource == servicebus.MicrosoftKeyVault
+		if enabled && encryption.KeyVaultProperties != nil && len(*encryption.KeyVaultProperties) > 0 {
+			properties := (*encryption.KeyVaultProperties)[0]
+			keyURI = buildKeyVaultKeyURI(types.SafeString(properties.KeyVaultURI), types.SafeString(properties.KeyName), types.SafeString(properties.KeyVersion))
+		}
+	}
+
+	if d.Param.(string) == "enabled" {
+		return enabled, nil
+	}
+	if keyURI == "" {
+		return nil, nil
+	}
+	return keyURI, nil
+}
@@ -134,6 +134,34 @@ func tableAzureSignalRService(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("Properties.Upstream"),
 			},
+			{
+				Name:        "identity",
+				Description: "The managed identity of the SignalR service.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSignalRServiceIdentity,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "identity_type",
+				Description: "The type of managed identity used by the resource. Possible values include: 'SystemAssigned', 'UserAssigned', 'SystemAssigned, UserAssigned', 'None'.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSignalRServiceIdentity,
+				Transform:   transform.FromValue().Transform(extractManagedIdentityType),
+			},
+			{
+				Name:        "system_assigned_principal_id",
+				Description: "The principal ID of the system-assigned managed identity of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSignalRServiceIdentity,
+				Transform:   transform.FromValue().Transform(extractManagedIdentityPrincipalID),
+			},
+			{
+				Name:        "user_assigned_identity_ids",
+				Description: "The resource IDs of the user-assigned managed identities associated with the resource.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSignalRServiceIdentity,
+				Transform:   transform.FromValue().Transform(extractManagedIdentityUserAssignedIDs),
+			},
 
 			// Steampipe standard columns
 			{
@@ -295,6 +323,22 @@ func listSignalRServiceDiagnosticSettings(ctx context.Context, d *plugin.QueryDa
 	return diagnosticSettings, nil
 }
 
+// The SDK package of the Microsoft.SignalRService resource provider does not return the managed
+// identity of the service
+const signalRServiceIdentityAPIVersion = "2023-02-01"
+
+func getSignalRServiceIdentity(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	service := h.Item.(signalr.ResourceType)
+
+	identity, err := getResourceManagerIdentity(ctx, d, *service.ID, signalRServiceIdentityAPIVersion)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_signalr_service.getSignalRServiceIdentity", "api_error", err)
+		return nil, err
+	}
+
+	return identity, nil
+}
+
 //// TRANSFORM FUNCTION
 
 // If we return the API response directly, the output will not provide all the properties of PrivateEndpointConnections
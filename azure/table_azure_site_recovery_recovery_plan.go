@@ -0,0 +1,275 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/recoveryservices/mgmt/recoveryservices"
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/recoveryservices/mgmt/siterecovery"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureSiteRecoveryRecoveryPlan(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_site_recovery_recovery_plan",
+		Description: "Azure Site Recovery Recovery Plan, the ordered groups of replicated items that are failed over together.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listRecoveryServicesVaults,
+			Hydrate:       listSiteRecoveryRecoveryPlans,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "404"}),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "vault_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "resource_group",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the recovery plan.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the recovery plan.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the recovery plan.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vault_name",
+				Description: "The name of the Recovery Services vault of the recovery plan.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "friendly_name",
+				Description: "The friendly name of the recovery plan.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.FriendlyName"),
+			},
+			{
+				Name:        "protected_item_count",
+				Description: "The number of replicated items in the groups of the recovery plan.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Properties.Groups").Transform(countSiteRecoveryRecoveryPlanItems),
+			},
+			{
+				Name:        "primary_fabric_id",
+				Description: "The ID of the primary fabric of the recovery plan.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.PrimaryFabricID"),
+			},
+			{
+				Name:        "primary_fabric_friendly_name",
+				Description: "The friendly name of the primary fabric, for example the source region.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.PrimaryFabricFriendlyName"),
+			},
+			{
+				Name:        "recovery_fabric_id",
+				Description: "The ID of the recovery fabric of the recovery plan.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.RecoveryFabricID"),
+			},
+			{
+				Name:        "recovery_fabric_friendly_name",
+				Description: "The friendly name of the recovery fabric, for example the target region.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.RecoveryFabricFriendlyName"),
+			},
+			{
+				Name:        "failover_deployment_model",
+				Description: "The failover deployment model of the recovery plan.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.FailoverDeploymentModel"),
+			},
+			{
+				Name:        "last_planned_failover_time",
+				Description: "The time of the last planned failover of the recovery plan.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.LastPlannedFailoverTime").Transform(convertDateToTime),
+			},
+			{
+				Name:        "last_unplanned_failover_time",
+				Description: "The time of the last unplanned failover of the recovery plan.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.LastUnplannedFailoverTime").Transform(convertDateToTime),
+			},
+			{
+				Name:        "last_test_failover_time",
+				Description: "The time of the last test failover of the recovery plan.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.LastTestFailoverTime").Transform(convertDateToTime),
+			},
+			{
+				Name:        "current_scenario_status",
+				Description: "The status of the operation currently running on the recovery plan.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.CurrentScenarioStatus"),
+			},
+			{
+				Name:        "current_scenario_status_description",
+				Description: "The description of the status of the operation currently running on the recovery plan.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.CurrentScenarioStatusDescription"),
+			},
+			{
+				Name:        "current_scenario",
+				Description: "The operation currently running on the recovery plan, if any.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.CurrentScenario"),
+			},
+			{
+				Name:        "replication_providers",
+				Description: "The replication scenarios of the items of the recovery plan.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.ReplicationProviders"),
+			},
+			{
+				Name:        "allowed_operations",
+				Description: "The operations allowed on the recovery plan.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.AllowedOperations"),
+			},
+			{
+				Name:        "groups",
+				Description: "The groups of the recovery plan, with their replicated items and the actions run before and after each group is failed over.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Groups"),
+			},
+			{
+				Name:        "provider_specific_details",
+				Description: "The details of the recovery plan specific to its replication scenarios.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.ProviderSpecificDetails"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.FriendlyName", "Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+// Recovery plans don't have a location of their own, the location of the vault is used instead
+type SiteRecoveryRecoveryPlanInfo struct {
+	VaultName *string
+	Location  *string
+	siterecovery.RecoveryPlan
+}
+
+//// LIST FUNCTION
+
+func listSiteRecoveryRecoveryPlans(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	vault := h.Item.(recoveryservices.Vault)
+	resourceGroup := strings.Split(*vault.ID, "/")[4]
+
+	vaultName := d.EqualsQualString("vault_name")
+	rgName := d.EqualsQualString("resource_group")
+
+	if vaultName != "" && vaultName != *vault.Name {
+		return nil, nil
+	}
+	if rgName != "" && !strings.EqualFold(rgName, resourceGroup) {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_site_recovery_recovery_plan.listSiteRecoveryRecoveryPlans", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := siterecovery.NewReplicationRecoveryPlansClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID, resourceGroup, *vault.Name)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_site_recovery_recovery_plan.listSiteRecoveryRecoveryPlans", "api_error", err)
+		return nil, err
+	}
+
+	for _, plan := range result.Values() {
+		d.StreamListItem(ctx, SiteRecoveryRecoveryPlanInfo{vault.Name, vault.Location, plan})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_site_recovery_recovery_plan.listSiteRecoveryRecoveryPlans", "api_paging_error", err)
+			return nil, err
+		}
+		for _, plan := range result.Values() {
+			d.StreamListItem(ctx, SiteRecoveryRecoveryPlanInfo{vault.Name, vault.Location, plan})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func countSiteRecoveryRecoveryPlanItems(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	groups, ok := d.Value.(*[]siterecovery.RecoveryPlanGroup)
+	if !ok || groups == nil {
+		return 0, nil
+	}
+
+	count := 0
+	for _, group := range *groups {
+		if group.ReplicationProtectedItems != nil {
+			count += len(*group.ReplicationProtectedItems)
+		}
+	}
+	return count, nil
+}
@@ -0,0 +1,315 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/recoveryservices/mgmt/recoveryservices"
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/recoveryservices/mgmt/siterecovery"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureSiteRecoveryReplicatedItem(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_site_recovery_replicated_item",
+		Description: "Azure Site Recovery Replicated Item, the virtual machines and servers replicated by Site Recovery to a secondary region or site.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listRecoveryServicesVaults,
+			Hydrate:       listSiteRecoveryReplicatedItems,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "404"}),
+			},
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "vault_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "resource_group",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the replicated item.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the replicated item.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the replicated item.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vault_name",
+				Description: "The name of the Recovery Services vault the item is replicated with.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "friendly_name",
+				Description: "The friendly name of the replicated item.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.FriendlyName"),
+			},
+			{
+				Name:        "protected_item_type",
+				Description: "The type of the replicated item.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ProtectedItemType"),
+			},
+			{
+				Name:        "replication_provider",
+				Description: "The replication scenario of the item, for example 'A2A', 'HyperVReplicaAzure', 'InMageRcm' or 'InMageAzureV2'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ProviderSpecificDetails").TransformP(extractSiteRecoveryProviderDetail, "instanceType"),
+			},
+			{
+				Name:        "source_vm_id",
+				Description: "The resource ID of the replicated virtual machine, for Azure to Azure replication.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ProviderSpecificDetails").TransformP(extractSiteRecoveryProviderDetail, "fabricObjectId"),
+			},
+			{
+				Name:        "protection_state",
+				Description: "The protection status of the item.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ProtectionState"),
+			},
+			{
+				Name:        "protection_state_description",
+				Description: "The description of the protection status of the item.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ProtectionStateDescription"),
+			},
+			{
+				Name:        "replication_health",
+				Description: "The consolidated health of the replication of the item. Possible values include: 'Normal', 'Warning', 'Critical'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ReplicationHealth"),
+			},
+			{
+				Name:        "failover_health",
+				Description: "The consolidated health of the item for failover, indicating whether it is ready to be failed over. Possible values include: 'Normal', 'Warning', 'Critical'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.FailoverHealth"),
+			},
+			{
+				Name:        "rpo_in_seconds",
+				Description: "The last recovery point objective (RPO) of the item, in seconds.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Properties.ProviderSpecificDetails").TransformP(extractSiteRecoveryProviderDetail, "rpoInSeconds"),
+			},
+			{
+				Name:        "last_rpo_calculated_time",
+				Description: "The time the last RPO of the item was calculated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.ProviderSpecificDetails").TransformP(extractSiteRecoveryProviderDetail, "lastRpoCalculatedTime"),
+			},
+			{
+				Name:        "active_location",
+				Description: "The location the item is currently running in, either 'Primary' or 'Recovery'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ActiveLocation"),
+			},
+			{
+				Name:        "test_failover_state",
+				Description: "The state of the test failover of the item.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.TestFailoverState"),
+			},
+			{
+				Name:        "last_successful_failover_time",
+				Description: "The time of the last successful failover of the item.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.LastSuccessfulFailoverTime").Transform(convertDateToTime),
+			},
+			{
+				Name:        "last_successful_test_failover_time",
+				Description: "The time of the last successful test failover of the item.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.LastSuccessfulTestFailoverTime").Transform(convertDateToTime),
+			},
+			{
+				Name:        "primary_fabric_friendly_name",
+				Description: "The friendly name of the primary fabric, for example the source region.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.PrimaryFabricFriendlyName"),
+			},
+			{
+				Name:        "recovery_fabric_friendly_name",
+				Description: "The friendly name of the recovery fabric, for example the target region.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.RecoveryFabricFriendlyName"),
+			},
+			{
+				Name:        "recovery_fabric_id",
+				Description: "The ID of the recovery fabric.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.RecoveryFabricID"),
+			},
+			{
+				Name:        "policy_id",
+				Description: "The ID of the replication policy of the item.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.PolicyID"),
+			},
+			{
+				Name:        "policy_friendly_name",
+				Description: "The name of the replication policy of the item.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.PolicyFriendlyName"),
+			},
+			{
+				Name:        "allowed_operations",
+				Description: "The operations allowed on the item, like 'PlannedFailover', 'UnplannedFailover' or 'TestFailover'.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.AllowedOperations"),
+			},
+			{
+				Name:        "health_errors",
+				Description: "The health errors of the item.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.HealthErrors"),
+			},
+			{
+				Name:        "current_scenario",
+				Description: "The operation currently running on the item, if any.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.CurrentScenario"),
+			},
+			{
+				Name:        "provider_specific_details",
+				Description: "The replication details specific to the replication scenario of the item.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.ProviderSpecificDetails"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.FriendlyName", "Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+// Replicated items don't have a location of their own, the location of the vault is used instead
+type SiteRecoveryReplicatedItemInfo struct {
+	VaultName *string
+	Location  *string
+	siterecovery.ReplicationProtectedItem
+}
+
+//// LIST FUNCTION
+
+func listSiteRecoveryReplicatedItems(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	vault := h.Item.(recoveryservices.Vault)
+	resourceGroup := strings.Split(*vault.ID, "/")[4]
+
+	vaultName := d.EqualsQualString("vault_name")
+	rgName := d.EqualsQualString("resource_group")
+
+	if vaultName != "" && vaultName != *vault.Name {
+		return nil, nil
+	}
+	if rgName != "" && !strings.EqualFold(rgName, resourceGroup) {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_site_recovery_replicated_item.listSiteRecoveryReplicatedItems", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := siterecovery.NewReplicationProtectedItemsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID, resourceGroup, *vault.Name)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx, "", "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_site_recovery_replicated_item.listSiteRecoveryReplicatedItems", "api_error", err)
+		return nil, err
+	}
+
+	for _, item := range result.Values() {
+		d.StreamListItem(ctx, SiteRecoveryReplicatedItemInfo{vault.Name, vault.Location, item})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_site_recovery_replicated_item.listSiteRecoveryReplicatedItems", "api_paging_error", err)
+			return nil, err
+		}
+		for _, item := range result.Values() {
+			d.StreamListItem(ctx, SiteRecoveryReplicatedItemInfo{vault.Name, vault.Location, item})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// The replication details depend on the replication scenario of the item, so they are read from their JSON representation
+func extractSiteRecoveryProviderDetail(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	details, ok := d.Value.(siterecovery.BasicReplicationProviderSpecificSettings)
+	if !ok || details == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(details)
+	if err != nil {
+		return nil, err
+	}
+	var properties map[string]interface{}
+	if err := json.Unmarshal(data, &properties); err != nil {
+		return nil, err
+	}
+	return properties[d.Param.(string)], nil
+}
@@ -95,6 +95,34 @@ func tableAzureSpringCloudService(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.From(extractSpringCloudServiceNetworkProfile),
 			},
+			{
+				Name:        "identity",
+				Description: "The managed identity of the service.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSpringCloudServiceIdentity,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "identity_type",
+				Description: "The type of managed identity used by the resource. Possible values include: 'SystemAssigned', 'UserAssigned', 'SystemAssigned, UserAssigned', 'None'.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSpringCloudServiceIdentity,
+				Transform:   transform.FromValue().Transform(extractManagedIdentityType),
+			},
+			{
+				Name:        "system_assigned_principal_id",
+				Description: "The principal ID of the system-assigned managed identity of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSpringCloudServiceIdentity,
+				Transform:   transform.FromValue().Transform(extractManagedIdentityPrincipalID),
+			},
+			{
+				Name:        "user_assigned_identity_ids",
+				Description: "The resource IDs of the user-assigned managed identities associated with the resource.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSpringCloudServiceIdentity,
+				Transform:   transform.FromValue().Transform(extractManagedIdentityUserAssignedIDs),
+			},
 
 			// Steampipe standard columns
 			{
@@ -263,6 +291,22 @@ func listSpringCloudServiceDiagnosticSettings(ctx context.Context, d *plugin.Que
 	return diagnosticSettings, nil
 }
 
+// The SDK package of the Microsoft.AppPlatform resource provider does not return the managed
+// identity of the service
+const springCloudServiceIdentityAPIVersion = "2023-12-01"
+
+func getSpringCloudServiceIdentity(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	service := h.Item.(appplatform.ServiceResource)
+
+	identity, err := getResourceManagerIdentity(ctx, d, *service.ID, springCloudServiceIdentityAPIVersion)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_spring_cloud_service.getSpringCloudServiceIdentity", "api_error", err)
+		return nil, err
+	}
+
+	return identity, nil
+}
+
 //// TRANSFORM FUNCTION
 
 // If we return the API response directly, the output does not provide
@@ -11,6 +11,14 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/sql/armsql"
 )
 
+// sqlServerEncryptionProtectorInfo holds the encryption protectors of a server along with the
+// customer-managed key they use, if any.
+type sqlServerEncryptionProtectorInfo struct {
+	EncryptionProtectors      []*armsql.EncryptionProtector
+	CustomerManagedKeyEnabled bool
+	CustomerManagedKeyURI     *string
+}
+
 //// TABLE DEFINITION
 
 func tableAzureSQLServer(_ context.Context) *plugin.Table {
@@ -84,6 +92,19 @@ func tableAzureSQLServer(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Properties.PublicNetworkAccess"),
 			},
+			{
+				Name:        "public_network_exposed",
+				Description: "Indicates whether the server is reachable from any address on the internet, derived from the public network access setting and the firewall default action.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getSQLServerPublicNetworkExposed,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "restrict_outbound_network_access",
+				Description: "Whether outbound network access from the server is restricted to the destinations of its outbound firewall rules. Possible values include: 'Enabled', 'Disabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.RestrictOutboundNetworkAccess"),
+			},
 			{
 				Name:        "version",
 				Description: "The version of the server.",
@@ -131,12 +152,33 @@ func tableAzureSQLServer(_ context.Context) *plugin.Table {
 				Hydrate:     listSQLServerFirewallRules,
 				Transform:   transform.FromValue(),
 			},
+			{
+				Name:        "outbound_firewall_rules",
+				Description: "A list of outbound firewall rules, the fully qualified domain names the server is allowed to connect to when outbound network access is restricted.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listSQLServerOutboundFirewallRules,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "encryption_protector",
 				Description: "The server encryption protector.",
 				Type:        proto.ColumnType_JSON,
 				Hydrate:     getSQLServerEncryptionProtector,
-				Transform:   transform.FromValue(),
+				Transform:   transform.FromField("EncryptionProtectors"),
+			},
+			{
+				Name:        "customer_managed_key_enabled",
+				Description: "Indicates whether the server is encrypted with a customer-managed key stored in Azure Key Vault.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getSQLServerEncryptionProtector,
+				Transform:   transform.FromField("CustomerManagedKeyEnabled"),
+			},
+			{
+				Name:        "customer_managed_key_uri",
+				Description: "The URI of the Key Vault key used to encrypt the server.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getSQLServerEncryptionProtector,
+				Transform:   transform.FromField("CustomerManagedKeyURI"),
 			},
 			{
 				Name:        "private_endpoint_connections",
@@ -158,6 +200,24 @@ func tableAzureSQLServer(_ context.Context) *plugin.Table {
 				Hydrate:     listSQLServerVirtualNetworkRules,
 				Transform:   transform.FromValue(),
 			},
+			{
+				Name:        "identity_type",
+				Description: "The type of managed identity used by the resource. Possible values include: 'SystemAssigned', 'UserAssigned', 'SystemAssigned, UserAssigned', 'None'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityType),
+			},
+			{
+				Name:        "system_assigned_principal_id",
+				Description: "The principal ID of the system-assigned managed identity of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityPrincipalID),
+			},
+			{
+				Name:        "user_assigned_identity_ids",
+				Description: "The resource IDs of the user-assigned managed identities associated with the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityUserAssignedIDs),
+			},
 
 			// Steampipe standard columns
 			{
@@ -422,7 +482,20 @@ func getSQLServerEncryptionProtector(ctx context.Context, d *plugin.QueryData, h
 		encryptionProtectors = append(encryptionProtectors, result.Value...)
 	}
 
-	return encryptionProtectors, nil
+	info := &sqlServerEncryptionProtectorInfo{EncryptionProtectors: encryptionProtectors}
+	// The server uses a customer-managed key if its encryption protector is an Azure Key Vault key
+	for _, protector := range encryptionProtectors {
+		if protector.Properties == nil || protector.Properties.ServerKeyType == nil {
+			continue
+		}
+		if *protector.Properties.ServerKeyType == armsql.ServerKeyTypeAzureKeyVault {
+			info.CustomerManagedKeyEnabled = true
+			info.CustomerManagedKeyURI = protector.Properties.URI
+			break
+		}
+	}
+
+	return info, nil
 }
 
 func getSQLServerVulnerabilityAssessment(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
@@ -487,6 +560,37 @@ func listSQLServerFirewallRules(ctx context.Context, d *plugin.QueryData, h *plu
 	return firewallRules, nil
 }
 
+func listSQLServerOutboundFirewallRules(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listSQLServerOutboundFirewallRules")
+	server := h.Item.(armsql.Server)
+	serverName := *server.Name
+	resourceGroupName := strings.Split(string(*server.ID), "/")[4]
+
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sql_server.listSQLServerOutboundFirewallRules", "session_error", err)
+		return nil, err
+	}
+	client, err := armsql.NewOutboundFirewallRulesClient(session.SubscriptionID, session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sql_server.listSQLServerOutboundFirewallRules", "client_error", err)
+		return nil, err
+	}
+
+	var outboundFirewallRules []*armsql.OutboundFirewallRule
+	pager := client.NewListByServerPager(resourceGroupName, serverName, nil)
+	for pager.More() {
+		result, err := pager.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_sql_server.listSQLServerOutboundFirewallRules", "api_error", err)
+			return nil, err
+		}
+		outboundFirewallRules = append(outboundFirewallRules, result.Value...)
+	}
+
+	return outboundFirewallRules, nil
+}
+
 func listSQLServerVirtualNetworkRules(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	plugin.Logger(ctx).Trace("listSQLServerVirtualNetworkRules")
 	server := h.Item.(armsql.Server)
@@ -517,3 +621,35 @@ func listSQLServerVirtualNetworkRules(ctx context.Context, d *plugin.QueryData,
 
 	return networkRules, nil
 }
+
+// The SQL firewall denies all traffic by default, so the server only allows
+// all networks if a firewall rule covers the whole IPv4 address space
+func getSQLServerPublicNetworkExposed(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	server := h.Item.(armsql.Server)
+	if server.Properties == nil {
+		return false, nil
+	}
+
+	firewallRules, err := listSQLServerFirewallRules(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+
+	allowsAllNetworks := false
+	for _, rule := range firewallRules.([]*armsql.FirewallRule) {
+		if rule.Properties == nil || rule.Properties.StartIPAddress == nil || rule.Properties.EndIPAddress == nil {
+			continue
+		}
+		if *rule.Properties.StartIPAddress == "0.0.0.0" && *rule.Properties.EndIPAddress == "255.255.255.255" {
+			allowsAllNetworks = true
+			break
+		}
+	}
+
+	publicNetworkAccess := ""
+	if server.Properties.PublicNetworkAccess != nil {
+		publicNetworkAccess = string(*server.Properties.PublicNetworkAccess)
+	}
+
+	return isPublicNetworkExposed(publicNetworkAccess, allowsAllNetworks), nil
+}
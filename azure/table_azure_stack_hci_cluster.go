@@ -0,0 +1,363 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/azurestackhci/mgmt/azurestackhci"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureStackHCICluster(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_stack_hci_cluster",
+		Description: "Azure Stack HCI Cluster",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getStackHCICluster,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listStackHCIClusters,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ClusterProperties.ProvisioningState"),
+			},
+			{
+				Name:        "status",
+				Description: "The connectivity status of the cluster. Possible values include: 'NotYetRegistered', 'ConnectedRecently', 'NotConnectedRecently', 'Disconnected', 'Error'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ClusterProperties.Status"),
+			},
+			{
+				Name:        "cloud_id",
+				Description: "The unique ID of the cluster in Azure.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ClusterProperties.CloudID"),
+			},
+			{
+				Name:        "cluster_id",
+				Description: "The unique ID of the on-premises cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ClusterProperties.ReportedProperties.ClusterID"),
+			},
+			{
+				Name:        "cluster_version",
+				Description: "The version of the Azure Stack HCI operating system running on the cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ClusterProperties.ReportedProperties.ClusterVersion"),
+			},
+			{
+				Name:        "billing_model",
+				Description: "The billing model of the cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ClusterProperties.BillingModel"),
+			},
+			{
+				Name:        "trial_days_remaining",
+				Description: "The number of days remaining in the trial period.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("ClusterProperties.TrialDaysRemaining"),
+			},
+			{
+				Name:        "registration_timestamp",
+				Description: "The first cluster sync timestamp.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ClusterProperties.RegistrationTimestamp").Transform(convertDateToTime),
+			},
+			{
+				Name:        "last_sync_timestamp",
+				Description: "The most recent cluster sync timestamp.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ClusterProperties.LastSyncTimestamp").Transform(convertDateToTime),
+			},
+			{
+				Name:        "last_billing_timestamp",
+				Description: "The most recent billing meter timestamp.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ClusterProperties.LastBillingTimestamp").Transform(convertDateToTime),
+			},
+			{
+				Name:        "service_endpoint",
+				Description: "The region specific data path endpoint of the cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ClusterProperties.ServiceEndpoint"),
+			},
+			{
+				Name:        "cloud_management_endpoint",
+				Description: "The endpoint configured for management from the Azure portal.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ClusterProperties.CloudManagementEndpoint"),
+			},
+			{
+				Name:        "aad_client_id",
+				Description: "The app ID of the cluster AAD identity.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ClusterProperties.AadClientID"),
+			},
+			{
+				Name:        "aad_tenant_id",
+				Description: "The tenant ID of the cluster AAD identity.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ClusterProperties.AadTenantID"),
+			},
+			{
+				Name:        "aad_application_object_id",
+				Description: "The object ID of the cluster AAD identity.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ClusterProperties.AadApplicationObjectID"),
+			},
+			{
+				Name:        "aad_service_principal_object_id",
+				Description: "The ID of the cluster AAD service principal.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ClusterProperties.AadServicePrincipalObjectID"),
+			},
+			{
+				Name:        "windows_server_subscription",
+				Description: "The desired state of the Windows Server subscription. Possible values include: 'Disabled', 'Enabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ClusterProperties.DesiredProperties.WindowsServerSubscription"),
+			},
+			{
+				Name:        "diagnostic_level",
+				Description: "The level of diagnostic data emitted by the cluster. Possible values include: 'Off', 'Basic', 'Enhanced'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ClusterProperties.ReportedProperties.DiagnosticLevel"),
+			},
+			{
+				Name:        "imds_attestation",
+				Description: "Indicates whether IMDS attestation is enabled on the cluster. Possible values include: 'Disabled', 'Enabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ClusterProperties.ReportedProperties.ImdsAttestation"),
+			},
+			{
+				Name:        "reported_last_updated",
+				Description: "The last time the cluster reported its data.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ClusterProperties.ReportedProperties.LastUpdated").Transform(convertDateToTime),
+			},
+			{
+				Name:        "update_state",
+				Description: "The current state of the updates of the cluster, for example 'AppliedSuccessfully', 'UpdateAvailable', 'UpdateInProgress' or 'UpdateFailed'.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStackHCIClusterUpdateSummary,
+				Transform:   transform.FromField("Properties.State"),
+			},
+			{
+				Name:        "update_current_version",
+				Description: "The current solution version of the cluster.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStackHCIClusterUpdateSummary,
+				Transform:   transform.FromField("Properties.CurrentVersion"),
+			},
+			{
+				Name:        "update_last_updated",
+				Description: "The last time an update was completed on the cluster.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getStackHCIClusterUpdateSummary,
+				Transform:   transform.FromField("Properties.LastUpdated"),
+			},
+			{
+				Name:        "update_last_checked",
+				Description: "The last time the cluster checked for updates.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getStackHCIClusterUpdateSummary,
+				Transform:   transform.FromField("Properties.LastChecked"),
+			},
+			{
+				Name:        "update_health_state",
+				Description: "The overall health state of the update readiness checks of the cluster.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getStackHCIClusterUpdateSummary,
+				Transform:   transform.FromField("Properties.HealthState"),
+			},
+			{
+				Name:        "nodes",
+				Description: "The list of nodes of the cluster, with their hardware, operating system and core count.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ClusterProperties.ReportedProperties.Nodes"),
+			},
+			{
+				Name:        "system_data",
+				Description: "Metadata pertaining to creation and last modification of the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SystemData"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listStackHCIClusters(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_stack_hci_cluster.listStackHCIClusters", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := azurestackhci.NewClustersClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListBySubscription(ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_stack_hci_cluster.listStackHCIClusters", "api_error", err)
+		return nil, err
+	}
+
+	for _, cluster := range result.Values() {
+		d.StreamListItem(ctx, cluster)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_stack_hci_cluster.listStackHCIClusters", "api_paging_error", err)
+			return nil, err
+		}
+		for _, cluster := range result.Values() {
+			d.StreamListItem(ctx, cluster)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getStackHCICluster(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_stack_hci_cluster.getStackHCICluster", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := azurestackhci.NewClustersClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_stack_hci_cluster.getStackHCICluster", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+// The update summary of a cluster is not available in the Stack HCI API version of the SDK
+const stackHCIUpdateSummaryAPIVersion = "2023-03-01"
+
+type StackHCIClusterUpdateSummary struct {
+	Properties *StackHCIClusterUpdateSummaryProperties `json:"properties,omitempty"`
+}
+
+type StackHCIClusterUpdateSummaryProperties struct {
+	State          *string `json:"state,omitempty"`
+	CurrentVersion *string `json:"currentVersion,omitempty"`
+	LastUpdated    *string `json:"lastUpdated,omitempty"`
+	LastChecked    *string `json:"lastChecked,omitempty"`
+	HealthState    *string `json:"healthState,omitempty"`
+}
+
+func getStackHCIClusterUpdateSummary(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	cluster := h.Item.(azurestackhci.Cluster)
+
+	var summary StackHCIClusterUpdateSummary
+	found, err := getResourceManagerResource(ctx, d, *cluster.ID+"/updateSummaries/default", stackHCIUpdateSummaryAPIVersion, &summary)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_stack_hci_cluster.getStackHCIClusterUpdateSummary", "api_error", err)
+		return nil, err
+	}
+
+	// Clusters that have not reported any update information have no update summary
+	if !found {
+		return nil, nil
+	}
+
+	return summary, nil
+}
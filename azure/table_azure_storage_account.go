@@ -11,6 +11,7 @@ import (
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/queue/queues"
 	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/accounts"
+	"github.com/turbot/go-kit/types"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 
@@ -93,6 +94,18 @@ func tableAzureStorageAccount(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_BOOL,
 				Transform:   transform.FromField("Account.AccountProperties.AllowBlobPublicAccess"),
 			},
+			{
+				Name:        "allow_cross_tenant_replication",
+				Description: "Specifies whether object replication to and from storage accounts in other Azure AD tenants is allowed. The default interpretation is true when not set.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Account.AccountProperties.AllowCrossTenantReplication"),
+			},
+			{
+				Name:        "allowed_copy_scope",
+				Description: "Restricts copy operations to and from the storage account to accounts within the same Azure AD tenant or with private links to the same virtual network. Possible values include: 'PrivateLink', 'AAD'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Account.AccountProperties.AllowedCopyScope").Transform(transform.ToString),
+			},
 			{
 				Name:        "blob_change_feed_enabled",
 				Description: "Specifies whether change feed event logging is enabled for the Blob service.",
@@ -161,6 +174,18 @@ func tableAzureStorageAccount(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Account.AccountProperties.Encryption.KeySource").Transform(transform.ToString),
 			},
+			{
+				Name:        "customer_managed_key_enabled",
+				Description: "Indicates whether the storage account is encrypted with a customer-managed key stored in Azure Key Vault.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromP(extractStorageAccountCustomerManagedKey, "enabled"),
+			},
+			{
+				Name:        "customer_managed_key_uri",
+				Description: "The URI of the Key Vault key used to encrypt the storage account.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(extractStorageAccountCustomerManagedKey, "uri"),
+			},
 			{
 				Name:        "encryption_key_vault_properties_key_current_version_id",
 				Description: "The object identifier of the current versioned Key Vault Key in use.",
@@ -353,6 +378,12 @@ func tableAzureStorageAccount(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Account.AccountProperties.PublicNetworkAccess"),
 			},
+			{
+				Name:        "public_network_exposed",
+				Description: "Indicates whether the storage account is reachable from any address on the internet, derived from the public network access setting and the firewall default action.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.From(storageAccountPublicNetworkExposed),
+			},
 			{
 				Name:        "status_of_primary",
 				Description: "The status indicating whether the primary location of the storage account is available or unavailable. Possible values include: 'available', 'unavailable'.",
@@ -456,6 +487,24 @@ func tableAzureStorageAccount(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("Account.AccountProperties.NetworkRuleSet.VirtualNetworkRules"),
 			},
+			{
+				Name:        "identity_type",
+				Description: "The type of managed identity used by the resource. Possible values include: 'SystemAssigned', 'UserAssigned', 'SystemAssigned, UserAssigned', 'None'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Account.Identity").Transform(extractManagedIdentityType),
+			},
+			{
+				Name:        "system_assigned_principal_id",
+				Description: "The principal ID of the system-assigned managed identity of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Account.Identity").Transform(extractManagedIdentityPrincipalID),
+			},
+			{
+				Name:        "user_assigned_identity_ids",
+				Description: "The resource IDs of the user-assigned managed identities associated with the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Account.Identity").Transform(extractManagedIdentityUserAssignedIDs),
+			},
 
 			// Steampipe standard columns
 			{
@@ -971,3 +1020,44 @@ func storageAccountEncryptionScopeMap(scope storage.EncryptionScope) map[string]
 	}
 	return objMap
 }
+
+//// TRANSFORM FUNCTIONS
+
+func storageAccountPublicNetworkExposed(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	account := d.HydrateItem.(*storageAccountInfo).Account
+	if account.AccountProperties == nil {
+		return false, nil
+	}
+
+	ruleSet := account.NetworkRuleSet
+	allowsAllNetworks := ruleSet == nil || ruleSet.DefaultAction != storage.DefaultActionDeny
+
+	return isPublicNetworkExposed(string(account.PublicNetworkAccess), allowsAllNetworks), nil
+}
+
+func extractStorageAccountCustomerManagedKey(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	account := d.HydrateItem.(*storageAccountInfo).Account
+
+	var enabled bool
+	var keyURI string
+	if account.AccountProperties != nil && account.Encryption != nil {
+		encryption := account.Encryption
+		enabled = encryption.KeySource == storage.KeySourceMicrosoftKeyvault
+		if enabled && encryption.KeyVaultProperties != nil {
+			properties := encryption.KeyVaultProperties
+			if properties.CurrentVersionedKeyIdentifier != nil {
+				keyURI = *properties.CurrentVersionedKeyIdentifier
+			} else {
+				keyURI = buildKeyVaultKeyURI(types.SafeString(properties.KeyVaultURI), types.SafeString(properties.KeyName), types.SafeString(properties.KeyVersion))
+			}
+		}
+	}
+
+	if d.Param.(string) == "enabled" {
+		return enabled, nil
+	}
+	if keyURI == "" {
+		return nil, nil
+	}
+	return keyURI, nil
+}
@@ -17,6 +17,15 @@ type subnetInfo = struct {
 	Name           *string
 	VirtualNetwork *string
 	ResourceGroup  *string
+	Usages         *subnetVirtualNetworkUsages
+}
+
+// subnetVirtualNetworkUsages holds the usages of a virtual network, listed at most once per query
+// and shared by all the subnets of the virtual network.
+type subnetVirtualNetworkUsages struct {
+	once   sync.Once
+	usages []network.VirtualNetworkUsage
+	err    error
 }
 
 //// TABLE DEFINITION
@@ -114,6 +123,39 @@ func tableAzureSubnet(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("Subnet.SubnetPropertiesFormat.Delegations"),
 			},
+			{
+				Name:        "delegated_services",
+				Description: "The names of the services the subnet is delegated to.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Subnet.SubnetPropertiesFormat.Delegations").Transform(extractSubnetDelegatedServices),
+			},
+			{
+				Name:        "address_prefixes",
+				Description: "A list of address prefixes for the subnet.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Subnet.SubnetPropertiesFormat.AddressPrefixes"),
+			},
+			{
+				Name:        "total_ip_address_count",
+				Description: "The number of usable IP addresses in the subnet, excluding the addresses reserved by Azure.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getSubnetUsage,
+				Transform:   transform.FromField("Limit"),
+			},
+			{
+				Name:        "used_ip_address_count",
+				Description: "The number of IP addresses in use in the subnet.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getSubnetUsage,
+				Transform:   transform.FromField("CurrentValue"),
+			},
+			{
+				Name:        "available_ip_address_count",
+				Description: "The number of IP addresses still available in the subnet.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getSubnetUsage,
+				Transform:   transform.From(subnetAvailableIPAddressCount),
+			},
 			{
 				Name:        "ip_configurations",
 				Description: "IP Configuration details in a subnet.",
@@ -176,8 +218,9 @@ func listSubnets(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 	if err != nil {
 		return nil, err
 	}
+	usages := &subnetVirtualNetworkUsages{}
 	for _, subnet := range result.Values() {
-		d.StreamListItem(ctx, subnetInfo{subnet, subnet.Name, virtualNetwork.Name, resourceGroupName})
+		d.StreamListItem(ctx, subnetInfo{subnet, subnet.Name, virtualNetwork.Name, resourceGroupName, usages})
 		// Check if context has been cancelled or if the limit has been hit (if specified)
 		// if there is a limit, it will return the number of rows required to reach this limit
 		if d.RowsRemaining(ctx) == 0 {
@@ -191,7 +234,7 @@ func listSubnets(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 			return nil, err
 		}
 		for _, subnet := range result.Values() {
-			d.StreamListItem(ctx, subnetInfo{subnet, subnet.Name, virtualNetwork.Name, resourceGroupName})
+			d.StreamListItem(ctx, subnetInfo{subnet, subnet.Name, virtualNetwork.Name, resourceGroupName, usages})
 			// Check if context has been cancelled or if the limit has been hit (if specified)
 			// if there is a limit, it will return the number of rows required to reach this limit
 			if d.RowsRemaining(ctx) == 0 {
@@ -228,7 +271,7 @@ func getSubnet(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 	// In some cases resource does not give any notFound error
 	// instead of notFound error, it returns empty data
 	if op.ID != nil {
-		return subnetInfo{op, op.Name, &virtualNetwork, &resourceGroup}, nil
+		return subnetInfo{op, op.Name, &virtualNetwork, &resourceGroup, &subnetVirtualNetworkUsages{}}, nil
 	}
 
 	return nil, nil
@@ -319,3 +362,77 @@ func getIpConfiguration(ctx context.Context, ipConfig *network.IPConfiguration,
 
 	return &configuration, nil
 }
+
+// The usage of all subnets is returned by the virtual network usage API, with the subnet ID as usage ID
+func getSubnetUsage(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	subnet := h.Item.(subnetInfo)
+
+	subnet.Usages.once.Do(func() {
+		subnet.Usages.usages, subnet.Usages.err = listSubnetVirtualNetworkUsages(ctx, d, subnet)
+	})
+	if subnet.Usages.err != nil {
+		return nil, subnet.Usages.err
+	}
+
+	for _, usage := range subnet.Usages.usages {
+		if usage.ID != nil && subnet.Subnet.ID != nil && strings.EqualFold(*usage.ID, *subnet.Subnet.ID) {
+			return usage, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func listSubnetVirtualNetworkUsages(ctx context.Context, d *plugin.QueryData, subnet subnetInfo) ([]network.VirtualNetworkUsage, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_subnet.listSubnetVirtualNetworkUsages", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	networkClient := network.NewVirtualNetworksClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	networkClient.Authorizer = session.Authorizer
+
+	result, err := networkClient.ListUsageComplete(ctx, *subnet.ResourceGroup, *subnet.VirtualNetwork)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_subnet.listSubnetVirtualNetworkUsages", "api_error", err)
+		return nil, err
+	}
+
+	var usages []network.VirtualNetworkUsage
+	for result.NotDone() {
+		usages = append(usages, result.Value())
+		if err := result.NextWithContext(ctx); err != nil {
+			plugin.Logger(ctx).Error("azure_subnet.listSubnetVirtualNetworkUsages", "api_paging_error", err)
+			return nil, err
+		}
+	}
+
+	return usages, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func extractSubnetDelegatedServices(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	delegations, ok := d.Value.(*[]network.Delegation)
+	if !ok || delegations == nil {
+		return nil, nil
+	}
+
+	var services []string
+	for _, delegation := range *delegations {
+		if delegation.ServiceDelegationPropertiesFormat != nil && delegation.ServiceName != nil {
+			services = append(services, *delegation.ServiceName)
+		}
+	}
+	return services, nil
+}
+
+func subnetAvailableIPAddressCount(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	usage, ok := d.HydrateItem.(network.VirtualNetworkUsage)
+	if !ok || usage.Limit == nil || usage.CurrentValue == nil {
+		return nil, nil
+	}
+	return *usage.Limit - *usage.CurrentValue, nil
+}
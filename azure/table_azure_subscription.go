@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/resources/mgmt/subscriptions"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 
@@ -65,6 +66,13 @@ func tableAzureSubscription(_ context.Context) *plugin.Table {
 				Description: "The subscription policies.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "activity_log_export_enabled",
+				Description: "Indicates whether a diagnostic setting of the subscription exports the activity log to a Log Analytics workspace or an event hub, with all of the Administrative, Alert, Policy and Security categories enabled, or the allLogs or audit category group.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getSubscriptionActivityLogExportEnabled,
+				Transform:   transform.FromValue(),
+			},
 
 			// Steampipe standard columns
 			{
@@ -117,3 +125,45 @@ func listSubscriptions(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydra
 
 	return nil, nil
 }
+
+//// HYDRATE FUNCTIONS
+
+func getSubscriptionActivityLogExportEnabled(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	subscription := h.Item.(subscriptions.Subscription)
+
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_subscription.getSubscriptionActivityLogExportEnabled", "session_error", err)
+		return nil, err
+	}
+
+	// The category groups of the logs are only returned by the API version of armmonitor
+	client, err := armmonitor.NewDiagnosticSettingsClient(session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_subscription.getSubscriptionActivityLogExportEnabled", "client_error", err)
+		return nil, err
+	}
+
+	// The diagnostic settings of the subscription are the ones that export its activity log
+	pager := client.NewListPager(*subscription.ID, nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_subscription.getSubscriptionActivityLogExportEnabled", "api_error", err)
+			return nil, err
+		}
+		for _, setting := range page.Value {
+			if setting.Properties == nil {
+				continue
+			}
+			if setting.Properties.WorkspaceID == nil && setting.Properties.EventHubAuthorizationRuleID == nil {
+				continue
+			}
+			if len(missingActivityLogCategories(setting.Properties.Logs)) == 0 {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,268 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/synapse/mgmt/synapse"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureSynapseIntegrationRuntime(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_synapse_integration_runtime",
+		Description: "Azure Synapse Integration Runtime",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "workspace_name", "resource_group"}),
+			Hydrate:    getSynapseIntegrationRuntime,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listSynapseWorkspaces,
+			Hydrate:       listSynapseIntegrationRuntimes,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "workspace_name",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the integration runtime.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource identifier.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "workspace_name",
+				Description: "The name of the workspace the integration runtime belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "etag",
+				Description: "An unique read-only string that changes whenever the resource is updated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The resource type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "runtime_type",
+				Description: "The type of the integration runtime. Possible values include: 'Managed', 'SelfHosted'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the integration runtime.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of a managed integration runtime. Possible values include: 'Initial', 'Stopped', 'Started', 'Starting', 'Stopping', 'NeedRegistration', 'Online', 'Limited', 'Offline', 'AccessDenied'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "managed_virtual_network",
+				Description: "The reference to the managed virtual network a managed integration runtime runs in.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "compute_properties",
+				Description: "The compute resources of a managed integration runtime, including its location, node size and virtual network.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "ssis_properties",
+				Description: "The SSIS properties of a managed integration runtime.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "linked_info",
+				Description: "The authorization of a self-hosted integration runtime that is shared from another integration runtime.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+// The properties of the integration runtimes depend on their type, so they are flattened
+type SynapseIntegrationRuntimeInfo struct {
+	ID                    *string
+	Name                  *string
+	Type                  *string
+	Etag                  *string
+	WorkspaceName         string
+	RuntimeType           synapse.Type
+	Description           *string
+	State                 synapse.IntegrationRuntimeState
+	ManagedVirtualNetwork interface{}
+	ComputeProperties     *synapse.IntegrationRuntimeComputeProperties
+	SsisProperties        *synapse.IntegrationRuntimeSsisProperties
+	LinkedInfo            synapse.BasicLinkedIntegrationRuntimeType
+}
+
+//// LIST FUNCTION
+
+func listSynapseIntegrationRuntimes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	workspace := h.Item.(synapse.Workspace)
+	resourceGroup := strings.Split(*workspace.ID, "/")[4]
+
+	workspaceName := d.EqualsQualString("workspace_name")
+	if workspaceName != "" && workspaceName != *workspace.Name {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_synapse_integration_runtime.listSynapseIntegrationRuntimes", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := synapse.NewIntegrationRuntimesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListByWorkspace(ctx, resourceGroup, *workspace.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_synapse_integration_runtime.listSynapseIntegrationRuntimes", "api_error", err)
+		return nil, err
+	}
+
+	for _, runtime := range result.Values() {
+		d.StreamListItem(ctx, newSynapseIntegrationRuntimeInfo(runtime, *workspace.Name))
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_synapse_integration_runtime.listSynapseIntegrationRuntimes", "api_paging_error", err)
+			return nil, err
+		}
+		for _, runtime := range result.Values() {
+			d.StreamListItem(ctx, newSynapseIntegrationRuntimeInfo(runtime, *workspace.Name))
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSynapseIntegrationRuntime(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	workspaceName := d.EqualsQualString("workspace_name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if name == "" || workspaceName == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_synapse_integration_runtime.getSynapseIntegrationRuntime", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := synapse.NewIntegrationRuntimesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, workspaceName, name, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_synapse_integration_runtime.getSynapseIntegrationRuntime", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return newSynapseIntegrationRuntimeInfo(op, workspaceName), nil
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+func newSynapseIntegrationRuntimeInfo(runtime synapse.IntegrationRuntimeResource, workspaceName string) SynapseIntegrationRuntimeInfo {
+	info := SynapseIntegrationRuntimeInfo{
+		ID:            runtime.ID,
+		Name:          runtime.Name,
+		Type:          runtime.Type,
+		Etag:          runtime.Etag,
+		WorkspaceName: workspaceName,
+	}
+	if runtime.Properties == nil {
+		return info
+	}
+
+	if managed, ok := runtime.Properties.AsManagedIntegrationRuntime(); ok {
+		info.RuntimeType = managed.Type
+		info.Description = managed.Description
+		info.State = managed.State
+		// The managed virtual network is not part of the model of the SDK package
+		info.ManagedVirtualNetwork = managed.AdditionalProperties["managedVirtualNetwork"]
+		if managed.ManagedIntegrationRuntimeTypeProperties != nil {
+			info.ComputeProperties = managed.ComputeProperties
+			info.SsisProperties = managed.SsisProperties
+		}
+	} else if selfHosted, ok := runtime.Properties.AsSelfHostedIntegrationRuntime(); ok {
+		info.RuntimeType = selfHosted.Type
+		info.Description = selfHosted.Description
+		if selfHosted.SelfHostedIntegrationRuntimeTypeProperties != nil {
+			info.LinkedInfo = selfHosted.LinkedInfo
+		}
+	} else if base, ok := runtime.Properties.AsIntegrationRuntime(); ok {
+		info.RuntimeType = base.Type
+		info.Description = base.Description
+	}
+
+	return info
+}
@@ -0,0 +1,187 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/synapse/mgmt/synapse"
+	"github.com/Azure/azure-sdk-for-go/services/preview/synapse/2019-06-01-preview/managedvirtualnetwork"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureSynapseManagedPrivateEndpoint(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_synapse_managed_private_endpoint",
+		Description: "Azure Synapse Managed Private Endpoint",
+		List: &plugin.ListConfig{
+			ParentHydrate: listSynapseWorkspaces,
+			Hydrate:       listSynapseManagedPrivateEndpoints,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "workspace_name",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the managed private endpoint.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource identifier.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "workspace_name",
+				Description: "The name of the workspace the managed private endpoint belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The resource type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the managed private endpoint.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ProvisioningState"),
+			},
+			{
+				Name:        "private_link_resource_id",
+				Description: "The ID of the resource the managed private endpoint connects to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.PrivateLinkResourceID"),
+			},
+			{
+				Name:        "group_id",
+				Description: "The sub-resource of the private link resource the managed private endpoint connects to, for example 'blob' or 'sqlServer'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.GroupID"),
+			},
+			{
+				Name:        "is_reserved",
+				Description: "Indicates whether the managed private endpoint is reserved by the workspace.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.IsReserved"),
+			},
+			{
+				Name:        "connection_state_status",
+				Description: "The status of the connection of the managed private endpoint, for example 'Pending', 'Approved' or 'Rejected'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ConnectionState.Status"),
+			},
+			{
+				Name:        "connection_state_description",
+				Description: "The description of the status of the connection, set when it was approved or rejected.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ConnectionState.Description"),
+			},
+			{
+				Name:        "connection_state_actions_required",
+				Description: "The actions required on the connection of the managed private endpoint.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ConnectionState.ActionsRequired"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type synapseManagedPrivateEndpointInfo = struct {
+	managedvirtualnetwork.ManagedPrivateEndpoint
+	WorkspaceName string
+}
+
+//// LIST FUNCTION
+
+func listSynapseManagedPrivateEndpoints(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	workspace := h.Item.(synapse.Workspace)
+
+	workspaceName := d.EqualsQualString("workspace_name")
+	if workspaceName != "" && workspaceName != *workspace.Name {
+		return nil, nil
+	}
+
+	// Only the workspaces with a managed virtual network have managed private endpoints, which are
+	// read from the development endpoint of the workspace
+	props := workspace.WorkspaceProperties
+	if props == nil || props.ManagedVirtualNetwork == nil || !strings.EqualFold(*props.ManagedVirtualNetwork, "default") {
+		return nil, nil
+	}
+	endpoint := props.ConnectivityEndpoints["dev"]
+	if endpoint == nil {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "SYNAPSE")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_synapse_managed_private_endpoint.listSynapseManagedPrivateEndpoints", "session_error", err)
+		return nil, err
+	}
+
+	client := managedvirtualnetwork.NewManagedPrivateEndpointsClient(*endpoint)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx, "default")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_synapse_managed_private_endpoint.listSynapseManagedPrivateEndpoints", "api_error", err)
+		return nil, err
+	}
+
+	for _, privateEndpoint := range result.Values() {
+		d.StreamListItem(ctx, synapseManagedPrivateEndpointInfo{privateEndpoint, *workspace.Name})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_synapse_managed_private_endpoint.listSynapseManagedPrivateEndpoints", "api_paging_error", err)
+			return nil, err
+		}
+		for _, privateEndpoint := range result.Values() {
+			d.StreamListItem(ctx, synapseManagedPrivateEndpointInfo{privateEndpoint, *workspace.Name})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
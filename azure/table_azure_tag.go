@@ -0,0 +1,172 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/resources/mgmt/resources"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureTag(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_tag",
+		Description: "Azure Tag",
+		List: &plugin.ListConfig{
+			Hydrate: listTags,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the tag.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "value",
+				Description: "The value of the tag. Tag names without any value in use have a single row with a null value.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "name_count",
+				Description: "The number of resources and resource groups using the tag name, with any value.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "value_count",
+				Description: "The number of resources and resource groups using the tag name with this value.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "name_id",
+				Description: "The ID of the tag name.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "value_id",
+				Description: "The ID of the tag value.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(tagTitle),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ValueID", "NameID").Transform(idToAkas),
+			},
+		}),
+	}
+}
+
+// custom tag struct, one per tag name and value pair
+
+type tagInfo struct {
+	Name       *string
+	Value      *string
+	NameCount  *int32
+	ValueCount *int32
+	NameID     *string
+	ValueID    *string
+}
+
+//// LIST FUNCTION
+
+func listTags(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_tag.listTags", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := resources.NewTagsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_tag.listTags", "api_error", err)
+		return nil, err
+	}
+
+	for _, tag := range result.Values() {
+		for _, item := range flattenTagDetails(tag) {
+			d.StreamListItem(ctx, item)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_tag.listTags", "api_paging_error", err)
+			return nil, err
+		}
+
+		for _, tag := range result.Values() {
+			for _, item := range flattenTagDetails(tag) {
+				d.StreamListItem(ctx, item)
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+func flattenTagDetails(tag resources.TagDetails) []*tagInfo {
+	info := tagInfo{
+		Name:   tag.TagName,
+		NameID: tag.ID,
+	}
+	if tag.Count != nil {
+		info.NameCount = tag.Count.Value
+	}
+
+	if tag.Values == nil || len(*tag.Values) == 0 {
+		return []*tagInfo{&info}
+	}
+
+	var items []*tagInfo
+	for _, value := range *tag.Values {
+		item := info
+		item.Value = value.TagValue
+		item.ValueID = value.ID
+		if value.Count != nil {
+			item.ValueCount = value.Count.Value
+		}
+		items = append(items, &item)
+	}
+	return items
+}
+
+//// TRANSFORM FUNCTIONS
+
+func tagTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	tag := d.HydrateItem.(*tagInfo)
+	if tag.Name == nil {
+		return nil, nil
+	}
+	if tag.Value == nil {
+		return *tag.Name, nil
+	}
+	return *tag.Name + "=" + *tag.Value, nil
+}
@@ -0,0 +1,191 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureTrustedSigningAccount(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_trusted_signing_account",
+		Description: "Azure Trusted Signing Account, the code signing accounts used to sign applications, packages and documents.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getTrustedSigningAccount,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listTrustedSigningAccounts,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the Trusted Signing account.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the Trusted Signing account.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the Trusted Signing account.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the Trusted Signing account.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ProvisioningState"),
+			},
+			{
+				Name:        "account_uri",
+				Description: "The URI of the Trusted Signing account, used by the signing clients.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.AccountURI"),
+			},
+			{
+				Name:        "sku_name",
+				Description: "The SKU of the Trusted Signing account. Possible values include: 'Basic', 'Premium'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Sku.Name"),
+			},
+			{
+				Name:        "system_data",
+				Description: "The metadata pertaining to creation and last modification of the Trusted Signing account.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+// There is no SDK package for the Microsoft.CodeSigning resource provider
+const trustedSigningAPIVersion = "2024-02-05-preview"
+
+type TrustedSigningAccount struct {
+	ID         *string                          `json:"id,omitempty"`
+	Name       *string                          `json:"name,omitempty"`
+	Type       *string                          `json:"type,omitempty"`
+	Location   *string                          `json:"location,omitempty"`
+	Tags       map[string]*string               `json:"tags,omitempty"`
+	SystemData map[string]interface{}           `json:"systemData,omitempty"`
+	Properties *TrustedSigningAccountProperties `json:"properties,omitempty"`
+}
+
+type TrustedSigningAccountProperties struct {
+	ProvisioningState *string                   `json:"provisioningState,omitempty"`
+	AccountURI        *string                   `json:"accountUri,omitempty"`
+	Sku               *TrustedSigningAccountSku `json:"sku,omitempty"`
+}
+
+type TrustedSigningAccountSku struct {
+	Name *string `json:"name,omitempty"`
+}
+
+//// LIST FUNCTION
+
+func listTrustedSigningAccounts(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_trusted_signing_account.listTrustedSigningAccounts", "session_error", err)
+		return nil, err
+	}
+
+	path := "/subscriptions/" + session.SubscriptionID + "/providers/Microsoft.CodeSigning/codeSigningAccounts"
+	err = listResourceManagerResources(ctx, d, path, trustedSigningAPIVersion, func(items []json.RawMessage) (bool, error) {
+		for _, item := range items {
+			var account TrustedSigningAccount
+			if err := json.Unmarshal(item, &account); err != nil {
+				return false, err
+			}
+			d.StreamListItem(ctx, account)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_trusted_signing_account.listTrustedSigningAccounts", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getTrustedSigningAccount(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_trusted_signing_account.getTrustedSigningAccount", "session_error", err)
+		return nil, err
+	}
+
+	var account TrustedSigningAccount
+	path := "/subscriptions/" + session.SubscriptionID + "/resourceGroups/" + resourceGroup + "/providers/Microsoft.CodeSigning/codeSigningAccounts/" + name
+	found, err := getResourceManagerResource(ctx, d, path, trustedSigningAPIVersion, &account)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_trusted_signing_account.getTrustedSigningAccount", "api_error", err)
+		return nil, err
+	}
+
+	if found && account.ID != nil {
+		return account, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,249 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureTrustedSigningCertificateProfile(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_trusted_signing_certificate_profile",
+		Description: "Azure Trusted Signing Certificate Profile, the certificate templates of a Trusted Signing account and the certificates issued from them.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"account_name", "name", "resource_group"}),
+			Hydrate:    getTrustedSigningCertificateProfile,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listTrustedSigningAccounts,
+			Hydrate:       listTrustedSigningCertificateProfiles,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the certificate profile.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the certificate profile.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the certificate profile.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "account_name",
+				Description: "The name of the Trusted Signing account the certificate profile belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractTrustedSigningAccountNameFromID),
+			},
+			{
+				Name:        "profile_type",
+				Description: "The type of the certificate profile. Possible values include: 'PublicTrust', 'PrivateTrust', 'PrivateTrustCIPolicy', 'VBSEnclave', 'PublicTrustTest'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ProfileType"),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the certificate profile. Possible values include: 'Active', 'Disabled', 'Suspended'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Status"),
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the certificate profile.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ProvisioningState"),
+			},
+			{
+				Name:        "identity_validation_id",
+				Description: "The ID of the identity validation the subject of the certificates is verified with.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.IdentityValidationID"),
+			},
+			{
+				Name:        "common_name",
+				Description: "The common name of the subject of the certificates.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.CommonName"),
+			},
+			{
+				Name:        "organization",
+				Description: "The organization of the subject of the certificates.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Organization"),
+			},
+			{
+				Name:        "organization_unit",
+				Description: "The organization unit of the subject of the certificates.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.OrganizationUnit"),
+			},
+			{
+				Name:        "city",
+				Description: "The city of the subject of the certificates.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.City"),
+			},
+			{
+				Name:        "state",
+				Description: "The state of the subject of the certificates.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.State"),
+			},
+			{
+				Name:        "country",
+				Description: "The country of the subject of the certificates.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Country"),
+			},
+			{
+				Name:        "enhanced_key_usage",
+				Description: "The enhanced key usage of the certificates.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.EnhancedKeyUsage"),
+			},
+			{
+				Name:        "certificates",
+				Description: "The certificates issued from the certificate profile, with their thumbprint, validity and revocation status.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Certificates"),
+			},
+			{
+				Name:        "system_data",
+				Description: "The metadata pertaining to creation and last modification of the certificate profile.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type TrustedSigningCertificateProfile struct {
+	ID         *string                                     `json:"id,omitempty"`
+	Name       *string                                     `json:"name,omitempty"`
+	Type       *string                                     `json:"type,omitempty"`
+	SystemData map[string]interface{}                      `json:"systemData,omitempty"`
+	Properties *TrustedSigningCertificateProfileProperties `json:"properties,omitempty"`
+}
+
+type TrustedSigningCertificateProfileProperties struct {
+	ProfileType          *string       `json:"profileType,omitempty"`
+	Status               *string       `json:"status,omitempty"`
+	ProvisioningState    *string       `json:"provisioningState,omitempty"`
+	IdentityValidationID *string       `json:"identityValidationId,omitempty"`
+	CommonName           *string       `json:"commonName,omitempty"`
+	Organization         *string       `json:"organization,omitempty"`
+	OrganizationUnit     *string       `json:"organizationUnit,omitempty"`
+	City                 *string       `json:"city,omitempty"`
+	State                *string       `json:"state,omitempty"`
+	Country              *string       `json:"country,omitempty"`
+	EnhancedKeyUsage     *string       `json:"enhancedKeyUsage,omitempty"`
+	Certificates         []interface{} `json:"certificates,omitempty"`
+}
+
+//// LIST FUNCTION
+
+func listTrustedSigningCertificateProfiles(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	account := h.Item.(TrustedSigningAccount)
+
+	err := listResourceManagerResources(ctx, d, *account.ID+"/certificateProfiles", trustedSigningAPIVersion, func(items []json.RawMessage) (bool, error) {
+		for _, item := range items {
+			var profile TrustedSigningCertificateProfile
+			if err := json.Unmarshal(item, &profile); err != nil {
+				return false, err
+			}
+			d.StreamListItem(ctx, profile)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_trusted_signing_certificate_profile.listTrustedSigningCertificateProfiles", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getTrustedSigningCertificateProfile(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	accountName := d.EqualsQualString("account_name")
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if accountName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_trusted_signing_certificate_profile.getTrustedSigningCertificateProfile", "session_error", err)
+		return nil, err
+	}
+
+	var profile TrustedSigningCertificateProfile
+	path := "/subscriptions/" + session.SubscriptionID + "/resourceGroups/" + resourceGroup + "/providers/Microsoft.CodeSigning/codeSigningAccounts/" + accountName + "/certificateProfiles/" + name
+	found, err := getResourceManagerResource(ctx, d, path, trustedSigningAPIVersion, &profile)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_trusted_signing_certificate_profile.getTrustedSigningCertificateProfile", "api_error", err)
+		return nil, err
+	}
+
+	if found && profile.ID != nil {
+		return profile, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func extractTrustedSigningAccountNameFromID(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	segments := strings.Split(types.SafeString(d.Value), "/")
+	if len(segments) < 9 {
+		return nil, nil
+	}
+	return segments[8], nil
+}
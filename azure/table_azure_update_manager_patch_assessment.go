@@ -0,0 +1,295 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/resourcegraph/mgmt/resourcegraph"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureUpdateManagerPatchAssessment(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_update_manager_patch_assessment",
+		Description: "Azure Update Manager Patch Assessment, the latest patch assessment result of each virtual machine and Arc-enabled server.",
+		List: &plugin.ListConfig{
+			Hydrate: listUpdateManagerPatchAssessments,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "machine_name",
+				Description: "The name of the assessed machine.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractPatchAssessmentMachineName),
+			},
+			{
+				Name:        "machine_id",
+				Description: "The resource ID of the assessed machine.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractPatchAssessmentMachineID),
+			},
+			{
+				Name:        "machine_type",
+				Description: "The resource type of the assessed machine, either 'Microsoft.Compute/virtualMachines' or 'Microsoft.HybridCompute/machines'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractPatchAssessmentMachineType),
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the patch assessment result.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "status",
+				Description: "The overall success or failure status of the assessment. Possible values include: 'Unknown', 'InProgress', 'Failed', 'Succeeded', 'CompletedWithWarnings'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Status"),
+			},
+			{
+				Name:        "os_type",
+				Description: "The operating system type of the machine.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.OsType"),
+			},
+			{
+				Name:        "reboot_pending",
+				Description: "Indicates whether the machine has a reboot pending.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.RebootPending"),
+			},
+			{
+				Name:        "critical_update_count",
+				Description: "The number of missing critical updates.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Properties.AvailablePatchCountByClassification.Critical"),
+			},
+			{
+				Name:        "security_update_count",
+				Description: "The number of missing security updates.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Properties.AvailablePatchCountByClassification.Security"),
+			},
+			{
+				Name:        "other_update_count",
+				Description: "The number of missing updates of the other classifications.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Properties.AvailablePatchCountByClassification").Transform(countOtherPatchAssessmentUpdates),
+			},
+			{
+				Name:        "last_assessment_time",
+				Description: "The time the assessment last completed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.LastModifiedDateTime"),
+			},
+			{
+				Name:        "start_time",
+				Description: "The time the assessment started.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.StartDateTime"),
+			},
+			{
+				Name:        "started_by",
+				Description: "Indicates whether the assessment was started by the user or by the platform.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.StartedBy"),
+			},
+			{
+				Name:        "patch_service_used",
+				Description: "The patch service used to assess the machine, for example 'WU', 'APT' or 'YUM'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.PatchServiceUsed"),
+			},
+			{
+				Name:        "assessment_activity_id",
+				Description: "The activity ID of the assessment operation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.AssessmentActivityID"),
+			},
+			{
+				Name:        "available_patch_count_by_classification",
+				Description: "The number of missing updates of each classification.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.AvailablePatchCountByClassification"),
+			},
+			{
+				Name:        "error_details",
+				Description: "The errors encountered during the assessment, if any.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.ErrorDetails"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractPatchAssessmentMachineName),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+// Patch assessment results are only exposed through Azure Resource Graph
+const patchAssessmentQuery = `patchassessmentresources
+| where type in~ ('microsoft.compute/virtualmachines/patchassessmentresults', 'microsoft.hybridcompute/machines/patchassessmentresults')
+| project id, name, type, location, properties`
+
+type PatchAssessmentResult struct {
+	ID         *string                          `json:"id,omitempty"`
+	Name       *string                          `json:"name,omitempty"`
+	Type       *string                          `json:"type,omitempty"`
+	Location   *string                          `json:"location,omitempty"`
+	Properties *PatchAssessmentResultProperties `json:"properties,omitempty"`
+}
+
+type PatchAssessmentResultProperties struct {
+	Status                              *string                     `json:"status,omitempty"`
+	AssessmentActivityID                *string                     `json:"assessmentActivityId,omitempty"`
+	RebootPending                       *bool                       `json:"rebootPending,omitempty"`
+	AvailablePatchCountByClassification *PatchAssessmentPatchCounts `json:"availablePatchCountByClassification,omitempty"`
+	StartDateTime                       *string                     `json:"startDateTime,omitempty"`
+	LastModifiedDateTime                *string                     `json:"lastModifiedDateTime,omitempty"`
+	StartedBy                           *string                     `json:"startedBy,omitempty"`
+	PatchServiceUsed                    *string                     `json:"patchServiceUsed,omitempty"`
+	OsType                              *string                     `json:"osType,omitempty"`
+	ErrorDetails                        map[string]interface{}      `json:"errorDetails,omitempty"`
+}
+
+type PatchAssessmentPatchCounts struct {
+	Critical     *int64 `json:"critical,omitempty"`
+	Security     *int64 `json:"security,omitempty"`
+	Definition   *int64 `json:"definition,omitempty"`
+	UpdateRollup *int64 `json:"updateRollup,omitempty"`
+	FeaturePack  *int64 `json:"featurePack,omitempty"`
+	ServicePack  *int64 `json:"servicePack,omitempty"`
+	Tools        *int64 `json:"tools,omitempty"`
+	Updates      *int64 `json:"updates,omitempty"`
+	Other        *int64 `json:"other,omitempty"`
+}
+
+//// LIST FUNCTION
+
+func listUpdateManagerPatchAssessments(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_update_manager_patch_assessment.listUpdateManagerPatchAssessments", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := resourcegraph.NewWithBaseURI(session.ResourceManagerEndpoint)
+	client.Authorizer = session.Authorizer
+
+	request := resourcegraph.QueryRequest{
+		Subscriptions: &[]string{subscriptionID},
+		Query:         types.String(patchAssessmentQuery),
+		Options: &resourcegraph.QueryRequestOptions{
+			ResultFormat: resourcegraph.ResultFormatObjectArray,
+		},
+	}
+
+	for {
+		result, err := client.Resources(ctx, request)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_update_manager_patch_assessment.listUpdateManagerPatchAssessments", "api_error", err)
+			return nil, err
+		}
+
+		// The rows are returned as generic objects, which are decoded into the result type
+		data, err := json.Marshal(result.Data)
+		if err != nil {
+			return nil, err
+		}
+		var assessments []PatchAssessmentResult
+		if err := json.Unmarshal(data, &assessments); err != nil {
+			plugin.Logger(ctx).Error("azure_update_manager_patch_assessment.listUpdateManagerPatchAssessments", "unmarshal_error", err)
+			return nil, err
+		}
+
+		for _, assessment := range assessments {
+			d.StreamListItem(ctx, assessment)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if result.SkipToken == nil || *result.SkipToken == "" {
+			break
+		}
+		request.Options.SkipToken = result.SkipToken
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// Patch assessment result IDs have the form
+// /subscriptions/{id}/resourceGroups/{rg}/providers/Microsoft.Compute/virtualMachines/{machine}/patchAssessmentResults/latest
+func extractPatchAssessmentMachineID(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	id := types.SafeString(d.Value)
+	if index := strings.Index(strings.ToLower(id), "/patchassessmentresults/"); index > 0 {
+		return id[:index], nil
+	}
+	return nil, nil
+}
+
+func extractPatchAssessmentMachineName(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	segments := strings.Split(types.SafeString(d.Value), "/")
+	if len(segments) < 9 {
+		return nil, nil
+	}
+	return segments[8], nil
+}
+
+func extractPatchAssessmentMachineType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	segments := strings.Split(types.SafeString(d.Value), "/")
+	if len(segments) < 9 {
+		return nil, nil
+	}
+	return segments[6] + "/" + segments[7], nil
+}
+
+func countOtherPatchAssessmentUpdates(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	counts, ok := d.Value.(*PatchAssessmentPatchCounts)
+	if !ok || counts == nil {
+		return nil, nil
+	}
+
+	var total int64
+	for _, count := range []*int64{counts.Definition, counts.UpdateRollup, counts.FeaturePack, counts.ServicePack, counts.Tools, counts.Updates, counts.Other} {
+		if count != nil {
+			total += *count
+		}
+	}
+	return total, nil
+}
@@ -0,0 +1,195 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/msi/mgmt/msi"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureUserAssignedIdentityFederatedCredential(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_user_assigned_identity_federated_credential",
+		Description: "Azure User Assigned Identity Federated Credential, the trust relationships of the user assigned identities with external OpenID Connect identity providers.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"identity_name", "name", "resource_group"}),
+			Hydrate:    getUserAssignedIdentityFederatedCredential,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate:       listUserAssignedIdentityFederatedCredentials,
+			ParentHydrate: listUserAssignedIdentities,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the federated identity credential.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the federated identity credential.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource (Microsoft.ManagedIdentity/userAssignedIdentities/federatedIdentityCredentials).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "identity_name",
+				Description: "The name of the user assigned identity of the federated identity credential.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractUserAssignedIdentityNameFromID),
+			},
+			{
+				Name:        "issuer",
+				Description: "The URL of the issuer of the tokens exchanged for tokens of the identity, for example 'https://token.actions.githubusercontent.com' for GitHub Actions, or the OIDC issuer URL of a Kubernetes cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FederatedIdentityCredentialProperties.Issuer"),
+			},
+			{
+				Name:        "subject",
+				Description: "The subject of the tokens exchanged for tokens of the identity, for example 'repo:{owner}/{repository}:ref:refs/heads/main' for GitHub Actions, or 'system:serviceaccount:{namespace}:{name}' for a Kubernetes service account.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FederatedIdentityCredentialProperties.Subject"),
+			},
+			{
+				Name:        "audiences",
+				Description: "The audiences of the tokens exchanged for tokens of the identity, usually 'api://AzureADTokenExchange'.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("FederatedIdentityCredentialProperties.Audiences"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listUserAssignedIdentityFederatedCredentials(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	identity := h.Item.(msi.Identity)
+	resourceGroup := strings.Split(*identity.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_user_assigned_identity_federated_credential.listUserAssignedIdentityFederatedCredentials", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := msi.NewFederatedIdentityCredentialsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx, resourceGroup, *identity.Name, nil, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_user_assigned_identity_federated_credential.listUserAssignedIdentityFederatedCredentials", "api_error", err)
+		return nil, err
+	}
+
+	for _, credential := range result.Values() {
+		d.StreamLeafListItem(ctx, credential)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_user_assigned_identity_federated_credential.listUserAssignedIdentityFederatedCredentials", "api_paging_error", err)
+			return nil, err
+		}
+		for _, credential := range result.Values() {
+			d.StreamLeafListItem(ctx, credential)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getUserAssignedIdentityFederatedCredential(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	identityName := d.EqualsQualString("identity_name")
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if identityName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_user_assigned_identity_federated_credential.getUserAssignedIdentityFederatedCredential", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := msi.NewFederatedIdentityCredentialsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, identityName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_user_assigned_identity_federated_credential.getUserAssignedIdentityFederatedCredential", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// The ID of a federated identity credential is
+// /subscriptions/{id}/resourceGroups/{name}/providers/Microsoft.ManagedIdentity/userAssignedIdentities/{name}/federatedIdentityCredentials/{name}
+func extractUserAssignedIdentityNameFromID(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	segments := strings.Split(types.SafeString(d.Value), "/")
+	if len(segments) < 9 {
+		return nil, nil
+	}
+	return segments[8], nil
+}
@@ -0,0 +1,217 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/desktopvirtualization/mgmt/2021-09-03-preview/desktopvirtualization"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureVirtualDesktopApplicationGroup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_virtual_desktop_application_group",
+		Description: "Azure Virtual Desktop Application Group",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getVirtualDesktopApplicationGroup,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listVirtualDesktopApplicationGroups,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the application group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the application group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the application group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "friendly_name",
+				Description: "The friendly name of the application group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ApplicationGroupProperties.FriendlyName"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the application group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ApplicationGroupProperties.Description"),
+			},
+			{
+				Name:        "application_group_type",
+				Description: "The type of the application group. Possible values include: 'RemoteApp', 'Desktop'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ApplicationGroupProperties.ApplicationGroupType"),
+			},
+			{
+				Name:        "host_pool_id",
+				Description: "The resource ID of the host pool of the application group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ApplicationGroupProperties.ApplicationGroupArmPath"),
+			},
+			{
+				Name:        "host_pool_name",
+				Description: "The name of the host pool of the application group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ApplicationGroupProperties.ApplicationGroupArmPath").Transform(lastPathElement),
+			},
+			{
+				Name:        "workspace_id",
+				Description: "The resource ID of the workspace the application group is published in.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ApplicationGroupProperties.WorkspaceArmPath"),
+			},
+			{
+				Name:        "cloud_pc_resource",
+				Description: "Indicates whether the application group is a Cloud PC resource.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("ApplicationGroupProperties.CloudPcResource"),
+			},
+			{
+				Name:        "created_at",
+				Description: "The timestamp of resource creation (UTC).",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("SystemData.CreatedAt").Transform(convertDateToTime),
+			},
+			{
+				Name:        "last_modified_at",
+				Description: "The timestamp of resource last modification (UTC).",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("SystemData.LastModifiedAt").Transform(convertDateToTime),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listVirtualDesktopApplicationGroups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_virtual_desktop_application_group.listVirtualDesktopApplicationGroups", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := desktopvirtualization.NewApplicationGroupsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListBySubscription(ctx, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_virtual_desktop_application_group.listVirtualDesktopApplicationGroups", "api_error", err)
+		return nil, err
+	}
+
+	for _, applicationGroup := range result.Values() {
+		d.StreamListItem(ctx, applicationGroup)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_virtual_desktop_application_group.listVirtualDesktopApplicationGroups", "api_paging_error", err)
+			return nil, err
+		}
+		for _, applicationGroup := range result.Values() {
+			d.StreamListItem(ctx, applicationGroup)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getVirtualDesktopApplicationGroup(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_virtual_desktop_application_group.getVirtualDesktopApplicationGroup", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := desktopvirtualization.NewApplicationGroupsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_virtual_desktop_application_group.getVirtualDesktopApplicationGroup", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
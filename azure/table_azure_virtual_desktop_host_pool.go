@@ -0,0 +1,312 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/desktopvirtualization/mgmt/2021-09-03-preview/desktopvirtualization"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureVirtualDesktopHostPool(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_virtual_desktop_host_pool",
+		Description: "Azure Virtual Desktop Host Pool",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getVirtualDesktopHostPool,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listVirtualDesktopHostPools,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the host pool.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the host pool.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the host pool.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "friendly_name",
+				Description: "The friendly name of the host pool.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HostPoolProperties.FriendlyName"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the host pool.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HostPoolProperties.Description"),
+			},
+			{
+				Name:        "host_pool_type",
+				Description: "The type of the host pool. Possible values include: 'Personal', 'Pooled', 'BYODesktop'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HostPoolProperties.HostPoolType"),
+			},
+			{
+				Name:        "personal_desktop_assignment_type",
+				Description: "The way users are assigned to the session hosts of a personal host pool. Possible values include: 'Automatic', 'Direct'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HostPoolProperties.PersonalDesktopAssignmentType"),
+			},
+			{
+				Name:        "load_balancer_type",
+				Description: "The load balancing algorithm of the host pool. Possible values include: 'BreadthFirst', 'DepthFirst', 'Persistent'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HostPoolProperties.LoadBalancerType"),
+			},
+			{
+				Name:        "max_session_limit",
+				Description: "The maximum number of sessions per session host.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("HostPoolProperties.MaxSessionLimit"),
+			},
+			{
+				Name:        "preferred_app_group_type",
+				Description: "The type of application group preferred when users connect. Possible values include: 'None', 'Desktop', 'RailApplications'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HostPoolProperties.PreferredAppGroupType"),
+			},
+			{
+				Name:        "custom_rdp_property",
+				Description: "The custom RDP properties of the host pool, such as drive, clipboard and device redirection settings.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HostPoolProperties.CustomRdpProperty"),
+			},
+			{
+				Name:        "rdp_properties",
+				Description: "The custom RDP properties of the host pool, parsed into a map of property name to value.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("HostPoolProperties.CustomRdpProperty").Transform(parseVirtualDesktopRdpProperties),
+			},
+			{
+				Name:        "start_vm_on_connect",
+				Description: "Indicates whether deallocated session hosts are started when a user connects.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("HostPoolProperties.StartVMOnConnect"),
+			},
+			{
+				Name:        "validation_environment",
+				Description: "Indicates whether the host pool is a validation environment, which receives service updates first.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("HostPoolProperties.ValidationEnvironment"),
+			},
+			{
+				Name:        "public_network_access",
+				Description: "Indicates whether the host pool can be accessed from public networks. Possible values include: 'Enabled', 'Disabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HostPoolProperties.PublicNetworkAccess"),
+			},
+			{
+				Name:        "ring",
+				Description: "The ring number of the host pool.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("HostPoolProperties.Ring"),
+			},
+			{
+				Name:        "cloud_pc_resource",
+				Description: "Indicates whether the host pool is a Cloud PC resource.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("HostPoolProperties.CloudPcResource"),
+			},
+			{
+				Name:        "registration_expiration_time",
+				Description: "The expiration time of the registration token of the host pool.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("HostPoolProperties.RegistrationInfo.ExpirationTime").Transform(convertDateToTime),
+			},
+			{
+				Name:        "sso_adfs_authority",
+				Description: "The URL of the ADFS server used to sign the single sign-on certificates.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HostPoolProperties.SsoadfsAuthority"),
+			},
+			{
+				Name:        "sso_secret_type",
+				Description: "The type of the single sign-on secret. Possible values include: 'SharedKey', 'Certificate', 'SharedKeyInKeyVault', 'CertificateInKeyVault'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HostPoolProperties.SsoSecretType"),
+			},
+			{
+				Name:        "vm_template",
+				Description: "The virtual machine template used to create the session hosts of the host pool.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HostPoolProperties.VMTemplate"),
+			},
+			{
+				Name:        "application_group_references",
+				Description: "The resource IDs of the application groups of the host pool.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("HostPoolProperties.ApplicationGroupReferences"),
+			},
+			{
+				Name:        "created_at",
+				Description: "The timestamp of resource creation (UTC).",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("SystemData.CreatedAt").Transform(convertDateToTime),
+			},
+			{
+				Name:        "last_modified_at",
+				Description: "The timestamp of resource last modification (UTC).",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("SystemData.LastModifiedAt").Transform(convertDateToTime),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listVirtualDesktopHostPools(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_virtual_desktop_host_pool.listVirtualDesktopHostPools", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := desktopvirtualization.NewHostPoolsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_virtual_desktop_host_pool.listVirtualDesktopHostPools", "api_error", err)
+		return nil, err
+	}
+
+	for _, hostPool := range result.Values() {
+		d.StreamListItem(ctx, hostPool)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_virtual_desktop_host_pool.listVirtualDesktopHostPools", "api_paging_error", err)
+			return nil, err
+		}
+		for _, hostPool := range result.Values() {
+			d.StreamListItem(ctx, hostPool)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getVirtualDesktopHostPool(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_virtual_desktop_host_pool.getVirtualDesktopHostPool", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := desktopvirtualization.NewHostPoolsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_virtual_desktop_host_pool.getVirtualDesktopHostPool", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// The custom RDP properties are a list of "name:type:value" entries separated by semicolons,
+// for example "audiomode:i:0;redirectclipboard:i:1;drivestoredirect:s:*"
+func parseVirtualDesktopRdpProperties(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	rdpProperty := types.SafeString(d.Value)
+	if rdpProperty == "" {
+		return nil, nil
+	}
+
+	properties := map[string]string{}
+	for _, entry := range strings.Split(rdpProperty, ";") {
+		parts := strings.SplitN(strings.TrimSpace(entry), ":", 3)
+		if len(parts) != 3 || parts[0] == "" {
+			continue
+		}
+		properties[parts[0]] = parts[2]
+	}
+	return properties, nil
+}
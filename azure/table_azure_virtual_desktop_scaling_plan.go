@@ -0,0 +1,223 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/desktopvirtualization/mgmt/2021-09-03-preview/desktopvirtualization"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureVirtualDesktopScalingPlan(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_virtual_desktop_scaling_plan",
+		Description: "Azure Virtual Desktop Scaling Plan",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getVirtualDesktopScalingPlan,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listVirtualDesktopScalingPlans,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the scaling plan.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the scaling plan.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the scaling plan.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "friendly_name",
+				Description: "The user friendly name of the scaling plan.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ScalingPlanProperties.FriendlyName"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the scaling plan.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ScalingPlanProperties.Description"),
+			},
+			{
+				Name:        "object_id",
+				Description: "The object ID of the scaling plan.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ScalingPlanProperties.ObjectID"),
+			},
+			{
+				Name:        "host_pool_type",
+				Description: "The type of the host pools the scaling plan applies to. Possible values include: 'Pooled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ScalingPlanProperties.HostPoolType"),
+			},
+			{
+				Name:        "time_zone",
+				Description: "The time zone of the schedules of the scaling plan.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ScalingPlanProperties.TimeZone"),
+			},
+			{
+				Name:        "exclusion_tag",
+				Description: "The tag of the virtual machines that are excluded from the scaling plan.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ScalingPlanProperties.ExclusionTag"),
+			},
+			{
+				Name:        "schedules",
+				Description: "The schedules of the scaling plan, with the start time, load balancing algorithm and capacity thresholds of their ramp up, peak, ramp down and off-peak phases.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ScalingPlanProperties.Schedules"),
+			},
+			{
+				Name:        "host_pool_references",
+				Description: "The host pools the scaling plan is assigned to, and whether the scaling plan is enabled for each of them.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ScalingPlanProperties.HostPoolReferences"),
+			},
+			{
+				Name:        "created_at",
+				Description: "The timestamp of resource creation (UTC).",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("SystemData.CreatedAt").Transform(convertDateToTime),
+			},
+			{
+				Name:        "last_modified_at",
+				Description: "The timestamp of resource last modification (UTC).",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("SystemData.LastModifiedAt").Transform(convertDateToTime),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listVirtualDesktopScalingPlans(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_virtual_desktop_scaling_plan.listVirtualDesktopScalingPlans", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := desktopvirtualization.NewScalingPlansClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListBySubscription(ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_virtual_desktop_scaling_plan.listVirtualDesktopScalingPlans", "api_error", err)
+		return nil, err
+	}
+
+	for _, scalingPlan := range result.Values() {
+		d.StreamListItem(ctx, scalingPlan)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_virtual_desktop_scaling_plan.listVirtualDesktopScalingPlans", "api_paging_error", err)
+			return nil, err
+		}
+		for _, scalingPlan := range result.Values() {
+			d.StreamListItem(ctx, scalingPlan)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getVirtualDesktopScalingPlan(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_virtual_desktop_scaling_plan.getVirtualDesktopScalingPlan", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := desktopvirtualization.NewScalingPlansClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_virtual_desktop_scaling_plan.getVirtualDesktopScalingPlan", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,281 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/desktopvirtualization/mgmt/2021-09-03-preview/desktopvirtualization"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureVirtualDesktopSessionHost(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_virtual_desktop_session_host",
+		Description: "Azure Virtual Desktop Session Host",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"host_pool_name", "name", "resource_group"}),
+			Hydrate:    getVirtualDesktopSessionHost,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listVirtualDesktopHostPools,
+			Hydrate:       listVirtualDesktopSessionHosts,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the session host, which is the FQDN of its virtual machine.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(lastPathElement),
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the session host.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the session host.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "host_pool_name",
+				Description: "The name of the host pool the session host belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractVirtualDesktopHostPoolNameFromID),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the session host. Possible values include: 'Available', 'Unavailable', 'Shutdown', 'Disconnected', 'Upgrading', 'UpgradeFailed', 'NoHeartbeat', 'NotJoinedToDomain', 'DomainTrustRelationshipLost', 'SxSStackListenerNotReady', 'FSLogixNotHealthy', 'NeedsAssistance'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SessionHostProperties.Status"),
+			},
+			{
+				Name:        "status_timestamp",
+				Description: "The time of the last status change of the session host.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("SessionHostProperties.StatusTimestamp").Transform(convertDateToTime),
+			},
+			{
+				Name:        "allow_new_session",
+				Description: "Indicates whether the session host accepts new sessions.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("SessionHostProperties.AllowNewSession"),
+			},
+			{
+				Name:        "drain_mode",
+				Description: "Indicates whether the session host is in drain mode, that is it does not accept new sessions.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("SessionHostProperties.AllowNewSession").Transform(isVirtualDesktopSessionHostDrained),
+			},
+			{
+				Name:        "sessions",
+				Description: "The number of sessions on the session host.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("SessionHostProperties.Sessions"),
+			},
+			{
+				Name:        "assigned_user",
+				Description: "The user assigned to the session host of a personal host pool.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SessionHostProperties.AssignedUser"),
+			},
+			{
+				Name:        "agent_version",
+				Description: "The version of the Azure Virtual Desktop agent on the session host.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SessionHostProperties.AgentVersion"),
+			},
+			{
+				Name:        "last_heart_beat",
+				Description: "The time of the last heartbeat of the agent.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("SessionHostProperties.LastHeartBeat").Transform(convertDateToTime),
+			},
+			{
+				Name:        "os_version",
+				Description: "The version of the operating system of the session host.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SessionHostProperties.OsVersion"),
+			},
+			{
+				Name:        "sxs_stack_version",
+				Description: "The version of the side-by-side stack on the session host.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SessionHostProperties.SxSStackVersion"),
+			},
+			{
+				Name:        "update_state",
+				Description: "The state of the agent update of the session host. Possible values include: 'Initial', 'Pending', 'Started', 'Succeeded', 'Failed'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SessionHostProperties.UpdateState"),
+			},
+			{
+				Name:        "last_update_time",
+				Description: "The time of the last agent update of the session host.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("SessionHostProperties.LastUpdateTime").Transform(convertDateToTime),
+			},
+			{
+				Name:        "update_error_message",
+				Description: "The error message of the last agent update.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SessionHostProperties.UpdateErrorMessage"),
+			},
+			{
+				Name:        "virtual_machine_id",
+				Description: "The ID of the virtual machine of the session host.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SessionHostProperties.VirtualMachineID"),
+			},
+			{
+				Name:        "resource_id",
+				Description: "The resource ID of the virtual machine of the session host.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SessionHostProperties.ResourceID"),
+			},
+			{
+				Name:        "health_check_results",
+				Description: "The results of the agent health checks of the session host.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SessionHostProperties.SessionHostHealthCheckResults"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(lastPathElement),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listVirtualDesktopSessionHosts(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	hostPool := h.Item.(desktopvirtualization.HostPool)
+	resourceGroup := strings.Split(*hostPool.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_virtual_desktop_session_host.listVirtualDesktopSessionHosts", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := desktopvirtualization.NewSessionHostsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx, resourceGroup, *hostPool.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_virtual_desktop_session_host.listVirtualDesktopSessionHosts", "api_error", err)
+		return nil, err
+	}
+
+	for _, sessionHost := range result.Values() {
+		d.StreamListItem(ctx, sessionHost)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_virtual_desktop_session_host.listVirtualDesktopSessionHosts", "api_paging_error", err)
+			return nil, err
+		}
+		for _, sessionHost := range result.Values() {
+			d.StreamListItem(ctx, sessionHost)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getVirtualDesktopSessionHost(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	hostPoolName := d.EqualsQualString("host_pool_name")
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if hostPoolName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_virtual_desktop_session_host.getVirtualDesktopSessionHost", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := desktopvirtualization.NewSessionHostsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, hostPoolName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_virtual_desktop_session_host.getVirtualDesktopSessionHost", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// Session host names are returned as {hostPoolName}/{sessionHostName}, so the host pool name is read from the ID
+func extractVirtualDesktopHostPoolNameFromID(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	segments := strings.Split(types.SafeString(d.Value), "/")
+	if len(segments) < 9 {
+		return nil, nil
+	}
+	return segments[8], nil
+}
+
+func isVirtualDesktopSessionHostDrained(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	allowNewSession, ok := d.Value.(*bool)
+	if !ok || allowNewSession == nil {
+		return nil, nil
+	}
+	return !*allowNewSession, nil
+}
@@ -0,0 +1,241 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/desktopvirtualization/mgmt/2021-09-03-preview/desktopvirtualization"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureVirtualDesktopUserSession(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_virtual_desktop_user_session",
+		Description: "Azure Virtual Desktop User Session",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"host_pool_name", "session_host_name", "name", "resource_group"}),
+			Hydrate:    getVirtualDesktopUserSession,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listVirtualDesktopHostPools,
+			Hydrate:       listVirtualDesktopUserSessions,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "host_pool_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "session_host_name",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The ID of the user session on its session host.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(lastPathElement),
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the user session.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the user session.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "host_pool_name",
+				Description: "The name of the host pool the user session belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractVirtualDesktopHostPoolNameFromID),
+			},
+			{
+				Name:        "session_host_name",
+				Description: "The name of the session host the user session runs on.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractVirtualDesktopSessionHostNameFromID),
+			},
+			{
+				Name:        "user_principal_name",
+				Description: "The user principal name of the user of the session.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UserSessionProperties.UserPrincipalName"),
+			},
+			{
+				Name:        "active_directory_user_name",
+				Description: "The Active Directory user name of the user of the session.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UserSessionProperties.ActiveDirectoryUserName"),
+			},
+			{
+				Name:        "object_id",
+				Description: "The object ID of the user session.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UserSessionProperties.ObjectID"),
+			},
+			{
+				Name:        "application_type",
+				Description: "The type of the application of the session. Possible values include: 'RemoteApp', 'Desktop'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UserSessionProperties.ApplicationType"),
+			},
+			{
+				Name:        "session_state",
+				Description: "The state of the user session. Possible values include: 'Unknown', 'Active', 'Disconnected', 'Pending', 'LogOff', 'UserProfileDiskMounted'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UserSessionProperties.SessionState"),
+			},
+			{
+				Name:        "create_time",
+				Description: "The time the user session was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UserSessionProperties.CreateTime").Transform(convertDateToTime),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UserSessionProperties.UserPrincipalName", "Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listVirtualDesktopUserSessions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	hostPool := h.Item.(desktopvirtualization.HostPool)
+	resourceGroup := strings.Split(*hostPool.ID, "/")[4]
+
+	hostPoolName := d.EqualsQualString("host_pool_name")
+	if hostPoolName != "" && hostPoolName != *hostPool.Name {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_virtual_desktop_user_session.listVirtualDesktopUserSessions", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := desktopvirtualization.NewUserSessionsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	// The sessions of a single session host are listed if the session host is qualified
+	var result desktopvirtualization.UserSessionListPage
+	if sessionHostName := d.EqualsQualString("session_host_name"); sessionHostName != "" {
+		result, err = client.List(ctx, resourceGroup, *hostPool.Name, sessionHostName)
+	} else {
+		result, err = client.ListByHostPool(ctx, resourceGroup, *hostPool.Name, "")
+	}
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_virtual_desktop_user_session.listVirtualDesktopUserSessions", "api_error", err)
+		return nil, err
+	}
+
+	for _, userSession := range result.Values() {
+		d.StreamListItem(ctx, userSession)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_virtual_desktop_user_session.listVirtualDesktopUserSessions", "api_paging_error", err)
+			return nil, err
+		}
+		for _, userSession := range result.Values() {
+			d.StreamListItem(ctx, userSession)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getVirtualDesktopUserSession(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	hostPoolName := d.EqualsQualString("host_pool_name")
+	sessionHostName := d.EqualsQualString("session_host_name")
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if hostPoolName == "" || sessionHostName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_virtual_desktop_user_session.getVirtualDesktopUserSession", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := desktopvirtualization.NewUserSessionsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, hostPoolName, sessionHostName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_virtual_desktop_user_session.getVirtualDesktopUserSession", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// User session names are returned as {hostPoolName}/{sessionHostName}/{sessionId}, so the session host name is read from the ID
+func extractVirtualDesktopSessionHostNameFromID(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	segments := strings.Split(types.SafeString(d.Value), "/")
+	if len(segments) < 11 {
+		return nil, nil
+	}
+	return segments[10], nil
+}
@@ -54,6 +54,12 @@ func tableAzureVirtualNetwork(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_BOOL,
 				Transform:   transform.FromField("VirtualNetworkPropertiesFormat.EnableDdosProtection"),
 			},
+			{
+				Name:        "ddos_protection_plan_id",
+				Description: "The ID of the DDoS protection plan associated with the virtual network",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualNetworkPropertiesFormat.DdosProtectionPlan.ID"),
+			},
 			{
 				Name:        "enable_vm_protection",
 				Description: "Indicates if VM protection is enabled for all the subnets in the virtual network",
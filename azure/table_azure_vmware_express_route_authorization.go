@@ -0,0 +1,182 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/avs/mgmt/avs"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureVMwareExpressRouteAuthorization(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_vmware_express_route_authorization",
+		Description: "Azure VMware Solution ExpressRoute Authorization",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"private_cloud_name", "name", "resource_group"}),
+			Hydrate:    getVMwareExpressRouteAuthorization,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listVMwarePrivateClouds,
+			Hydrate:       listVMwareExpressRouteAuthorizations,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the ExpressRoute circuit authorization.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the ExpressRoute circuit authorization.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "private_cloud_name",
+				Description: "The name of the private cloud the authorization belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractVMwarePrivateCloudNameFromID),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the ExpressRoute circuit authorization.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the ExpressRoute circuit authorization.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExpressRouteAuthorizationProperties.ProvisioningState"),
+			},
+			{
+				Name:        "express_route_authorization_id",
+				Description: "The ID of the ExpressRoute circuit authorization.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExpressRouteAuthorizationProperties.ExpressRouteAuthorizationID"),
+			},
+			{
+				Name:        "express_route_id",
+				Description: "The ID of the ExpressRoute circuit the authorization was created for, in a virtual network gateway or another private cloud.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExpressRouteAuthorizationProperties.ExpressRouteID"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listVMwareExpressRouteAuthorizations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	privateCloud := h.Item.(avs.PrivateCloud)
+	resourceGroup := strings.Split(*privateCloud.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_vmware_express_route_authorization.listVMwareExpressRouteAuthorizations", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := avs.NewAuthorizationsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx, resourceGroup, *privateCloud.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_vmware_express_route_authorization.listVMwareExpressRouteAuthorizations", "api_error", err)
+		return nil, err
+	}
+
+	for _, authorization := range result.Values() {
+		d.StreamListItem(ctx, authorization)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_vmware_express_route_authorization.listVMwareExpressRouteAuthorizations", "api_paging_error", err)
+			return nil, err
+		}
+		for _, authorization := range result.Values() {
+			d.StreamListItem(ctx, authorization)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getVMwareExpressRouteAuthorization(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	privateCloudName := d.EqualsQualString("private_cloud_name")
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if privateCloudName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_vmware_express_route_authorization.getVMwareExpressRouteAuthorization", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := avs.NewAuthorizationsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, privateCloudName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_vmware_express_route_authorization.getVMwareExpressRouteAuthorization", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
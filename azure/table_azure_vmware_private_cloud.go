@@ -0,0 +1,341 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/avs/mgmt/avs"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureVMwarePrivateCloud(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_vmware_private_cloud",
+		Description: "Azure VMware Solution Private Cloud",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getVMwarePrivateCloud,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listVMwarePrivateClouds,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the private cloud.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the private cloud.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the private cloud.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the private cloud.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PrivateCloudProperties.ProvisioningState"),
+			},
+			{
+				Name:        "sku_name",
+				Description: "The name of the SKU of the private cloud, for example 'AV36'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Sku.Name"),
+			},
+			{
+				Name:        "network_block",
+				Description: "The block of addresses used by the private cloud, which should be unique across the virtual network and on-premises networks.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PrivateCloudProperties.NetworkBlock"),
+			},
+			{
+				Name:        "management_network",
+				Description: "The network used to access vCenter Server and NSX-T Manager.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PrivateCloudProperties.ManagementNetwork"),
+			},
+			{
+				Name:        "provisioning_network",
+				Description: "The network used for virtual machine cold migration, cloning and snapshot migration.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PrivateCloudProperties.ProvisioningNetwork"),
+			},
+			{
+				Name:        "vmotion_network",
+				Description: "The network used for live migration of virtual machines.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PrivateCloudProperties.VmotionNetwork"),
+			},
+			{
+				Name:        "internet",
+				Description: "Indicates whether internet access is enabled for the private cloud. Possible values include: 'Enabled', 'Disabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PrivateCloudProperties.Internet"),
+			},
+			{
+				Name:        "management_cluster_size",
+				Description: "The number of hosts in the management cluster.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("PrivateCloudProperties.ManagementCluster.ClusterSize"),
+			},
+			{
+				Name:        "management_cluster_hosts",
+				Description: "The hosts of the management cluster.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PrivateCloudProperties.ManagementCluster.Hosts"),
+			},
+			{
+				Name:        "vcenter_endpoint",
+				Description: "The endpoint of the vCenter Server Appliance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PrivateCloudProperties.Endpoints.Vcsa"),
+			},
+			{
+				Name:        "nsxt_manager_endpoint",
+				Description: "The endpoint of the NSX-T Data Center manager.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PrivateCloudProperties.Endpoints.NsxtManager"),
+			},
+			{
+				Name:        "hcx_cloud_manager_endpoint",
+				Description: "The endpoint of the HCX cloud manager.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PrivateCloudProperties.Endpoints.HcxCloudManager"),
+			},
+			{
+				Name:        "vcenter_certificate_thumbprint",
+				Description: "The thumbprint of the vCenter Server SSL certificate.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PrivateCloudProperties.VcenterCertificateThumbprint"),
+			},
+			{
+				Name:        "nsxt_certificate_thumbprint",
+				Description: "The thumbprint of the NSX-T Manager SSL certificate.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PrivateCloudProperties.NsxtCertificateThumbprint"),
+			},
+			{
+				Name:        "express_route_id",
+				Description: "The ID of the ExpressRoute circuit of the private cloud.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PrivateCloudProperties.Circuit.ExpressRouteID"),
+			},
+			{
+				Name:        "availability_strategy",
+				Description: "The availability strategy of the private cloud. Possible values include: 'SingleZone', 'DualZone'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PrivateCloudProperties.Availability.Strategy"),
+			},
+			{
+				Name:        "encryption_status",
+				Description: "Indicates whether customer managed key encryption is enabled. Possible values include: 'Enabled', 'Disabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PrivateCloudProperties.Encryption.Status"),
+			},
+			{
+				Name:        "circuit",
+				Description: "The primary ExpressRoute circuit of the private cloud.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PrivateCloudProperties.Circuit"),
+			},
+			{
+				Name:        "secondary_circuit",
+				Description: "The secondary ExpressRoute circuit of a stretched private cloud.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PrivateCloudProperties.SecondaryCircuit"),
+			},
+			{
+				Name:        "availability",
+				Description: "The availability zones of the private cloud.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PrivateCloudProperties.Availability"),
+			},
+			{
+				Name:        "encryption",
+				Description: "The customer managed key encryption settings of the private cloud.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PrivateCloudProperties.Encryption"),
+			},
+			{
+				Name:        "external_cloud_links",
+				Description: "The IDs of the private clouds linked to this private cloud.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PrivateCloudProperties.ExternalCloudLinks"),
+			},
+			{
+				Name:        "identity",
+				Description: "The identity of the private cloud.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "identity_type",
+				Description: "The type of managed identity used by the resource. Possible values include: 'SystemAssigned', 'UserAssigned', 'SystemAssigned, UserAssigned', 'None'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityType),
+			},
+			{
+				Name:        "system_assigned_principal_id",
+				Description: "The principal ID of the system-assigned managed identity of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityPrincipalID),
+			},
+			{
+				Name:        "user_assigned_identity_ids",
+				Description: "The resource IDs of the user-assigned managed identities associated with the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Identity").Transform(extractManagedIdentityUserAssignedIDs),
+			},
+			{
+				Name:        "identity_sources",
+				Description: "The vCenter Single Sign On identity sources of the private cloud. Passwords are omitted.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PrivateCloudProperties.IdentitySources").Transform(removeVMwareIdentitySourcePasswords),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listVMwarePrivateClouds(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_vmware_private_cloud.listVMwarePrivateClouds", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := avs.NewPrivateCloudsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListInSubscription(ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_vmware_private_cloud.listVMwarePrivateClouds", "api_error", err)
+		return nil, err
+	}
+
+	for _, privateCloud := range result.Values() {
+		d.StreamListItem(ctx, privateCloud)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_vmware_private_cloud.listVMwarePrivateClouds", "api_paging_error", err)
+			return nil, err
+		}
+		for _, privateCloud := range result.Values() {
+			d.StreamListItem(ctx, privateCloud)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getVMwarePrivateCloud(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_vmware_private_cloud.getVMwarePrivateCloud", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := avs.NewPrivateCloudsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_vmware_private_cloud.getVMwarePrivateCloud", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// Identity sources carry the password of the LDAP user, which must not be returned
+func removeVMwareIdentitySourcePasswords(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	sources, ok := d.Value.(*[]avs.IdentitySource)
+	if !ok || sources == nil {
+		return nil, nil
+	}
+
+	result := []avs.IdentitySource{}
+	for _, source := range *sources {
+		source.Password = nil
+		result = append(result, source)
+	}
+	return result, nil
+}
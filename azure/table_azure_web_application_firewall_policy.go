@@ -0,0 +1,368 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/frontdoor/mgmt/frontdoor"
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/network/mgmt/network"
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/resources/mgmt/resources"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureWebApplicationFirewallPolicy(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_web_application_firewall_policy",
+		Description: "Azure Web Application Firewall Policy",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getWebApplicationFirewallPolicy,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listWebApplicationFirewallPolicies,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the web application firewall policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "Contains ID to identify a web application firewall policy uniquely.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type of the web application firewall policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "policy_type",
+				Description: "The service the policy applies to. Possible values are: 'ApplicationGateway', 'FrontDoor'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(webApplicationFirewallPolicyType),
+			},
+			{
+				Name:        "etag",
+				Description: "An unique read-only string that changes whenever the resource is updated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the web application firewall policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("WebApplicationFirewallPolicyPropertiesFormat.ProvisioningState", "WebApplicationFirewallPolicyProperties.ProvisioningState").Transform(transform.ToString),
+			},
+			{
+				Name:        "resource_state",
+				Description: "The resource status of the policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("WebApplicationFirewallPolicyPropertiesFormat.ResourceState", "WebApplicationFirewallPolicyProperties.ResourceState").Transform(transform.ToString),
+			},
+			{
+				Name:        "mode",
+				Description: "The mode of the policy. Possible values include: 'Prevention', 'Detection'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("WebApplicationFirewallPolicyPropertiesFormat.PolicySettings.Mode", "WebApplicationFirewallPolicyProperties.PolicySettings.Mode"),
+			},
+			{
+				Name:        "enabled_state",
+				Description: "Describes if the policy is in enabled or disabled state.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("WebApplicationFirewallPolicyPropertiesFormat.PolicySettings.State", "WebApplicationFirewallPolicyProperties.PolicySettings.EnabledState"),
+			},
+			{
+				Name:        "sku_name",
+				Description: "The pricing tier of the Front Door web application firewall policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Sku.Name"),
+			},
+			{
+				Name:        "policy_settings",
+				Description: "The settings of the policy, such as request body inspection and custom block response.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("WebApplicationFirewallPolicyPropertiesFormat.PolicySettings", "WebApplicationFirewallPolicyProperties.PolicySettings"),
+			},
+			{
+				Name:        "managed_rule_sets",
+				Description: "The managed rule sets that are associated with the policy.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("WebApplicationFirewallPolicyPropertiesFormat.ManagedRules.ManagedRuleSets", "WebApplicationFirewallPolicyProperties.ManagedRules.ManagedRuleSets"),
+			},
+			{
+				Name:        "custom_rules",
+				Description: "The custom rules inside the policy.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("WebApplicationFirewallPolicyPropertiesFormat.CustomRules", "WebApplicationFirewallPolicyProperties.CustomRules.Rules"),
+			},
+			{
+				Name:        "exclusions",
+				Description: "The exclusions that are applied on the managed rules of the policy.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(extractWebApplicationFirewallPolicyExclusions),
+			},
+			{
+				Name:        "application_gateways",
+				Description: "A collection of references to application gateways associated with the policy.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(extractWebApplicationFirewallPolicyApplicationGatewayIDs),
+			},
+			{
+				Name:        "http_listeners",
+				Description: "A collection of references to application gateway http listeners associated with the policy.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("WebApplicationFirewallPolicyPropertiesFormat.HTTPListeners"),
+			},
+			{
+				Name:        "path_based_rules",
+				Description: "A collection of references to application gateway path rules associated with the policy.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("WebApplicationFirewallPolicyPropertiesFormat.PathBasedRules"),
+			},
+			{
+				Name:        "frontend_endpoint_links",
+				Description: "A collection of references to Front Door frontend endpoints associated with the policy.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("WebApplicationFirewallPolicyProperties.FrontendEndpointLinks"),
+			},
+			{
+				Name:        "routing_rule_links",
+				Description: "A collection of references to Front Door routing rules associated with the policy.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("WebApplicationFirewallPolicyProperties.RoutingRuleLinks"),
+			},
+			{
+				Name:        "security_policy_links",
+				Description: "A collection of references to Front Door security policies associated with the policy.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("WebApplicationFirewallPolicyProperties.SecurityPolicyLinks"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listWebApplicationFirewallPolicies(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_web_application_firewall_policy.listWebApplicationFirewallPolicies", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	// Application Gateway policies can be listed for the whole subscription
+	networkClient := network.NewWebApplicationFirewallPoliciesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	networkClient.Authorizer = session.Authorizer
+
+	result, err := networkClient.ListAll(ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_web_application_firewall_policy.listWebApplicationFirewallPolicies", "api_error", err)
+		return nil, err
+	}
+
+	for _, policy := range result.Values() {
+		d.StreamListItem(ctx, policy)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_web_application_firewall_policy.listWebApplicationFirewallPolicies", "api_paging_error", err)
+			return nil, err
+		}
+		for _, policy := range result.Values() {
+			d.StreamListItem(ctx, policy)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	// Front Door policies can only be listed per resource group
+	resourceGroupClient := resources.NewGroupsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	resourceGroupClient.Authorizer = session.Authorizer
+
+	groups, err := resourceGroupClient.ListComplete(ctx, "", nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_web_application_firewall_policy.listWebApplicationFirewallPolicies", "resource_group_api_error", err)
+		return nil, err
+	}
+
+	frontDoorClient := frontdoor.NewPoliciesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	frontDoorClient.Authorizer = session.Authorizer
+
+	for groups.NotDone() {
+		resourceGroup := groups.Value()
+
+		policies, err := frontDoorClient.ListComplete(ctx, *resourceGroup.Name)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_web_application_firewall_policy.listWebApplicationFirewallPolicies", "front_door_api_error", err)
+			return nil, err
+		}
+		for policies.NotDone() {
+			d.StreamListItem(ctx, policies.Value())
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+			if err := policies.NextWithContext(ctx); err != nil {
+				plugin.Logger(ctx).Error("azure_web_application_firewall_policy.listWebApplicationFirewallPolicies", "front_door_api_paging_error", err)
+				return nil, err
+			}
+		}
+
+		if err := groups.NextWithContext(ctx); err != nil {
+			plugin.Logger(ctx).Error("azure_web_application_firewall_policy.listWebApplicationFirewallPolicies", "resource_group_api_paging_error", err)
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getWebApplicationFirewallPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, if no input provided
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_web_application_firewall_policy.getWebApplicationFirewallPolicy", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	networkClient := network.NewWebApplicationFirewallPoliciesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	networkClient.Authorizer = session.Authorizer
+
+	op, err := networkClient.Get(ctx, resourceGroup, name)
+	if err == nil && op.ID != nil {
+		return op, nil
+	}
+	// A policy that is not found as an Application Gateway policy may still be a Front Door policy
+	if err != nil && !strings.Contains(err.Error(), "404") && !strings.Contains(err.Error(), "ResourceNotFound") {
+		plugin.Logger(ctx).Error("azure_web_application_firewall_policy.getWebApplicationFirewallPolicy", "api_error", err)
+		return nil, err
+	}
+
+	frontDoorClient := frontdoor.NewPoliciesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	frontDoorClient.Authorizer = session.Authorizer
+
+	frontDoorPolicy, err := frontDoorClient.Get(ctx, resourceGroup, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_web_application_firewall_policy.getWebApplicationFirewallPolicy", "front_door_api_error", err)
+		return nil, err
+	}
+
+	if frontDoorPolicy.ID != nil {
+		return frontDoorPolicy, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func webApplicationFirewallPolicyType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	switch d.HydrateItem.(type) {
+	case network.WebApplicationFirewallPolicy:
+		return "ApplicationGateway", nil
+	case frontdoor.WebApplicationFirewallPolicy:
+		return "FrontDoor", nil
+	}
+	return nil, nil
+}
+
+// Application Gateway policies define exclusions for all managed rules, whereas
+// Front Door policies define them per managed rule set
+func extractWebApplicationFirewallPolicyExclusions(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	switch policy := d.HydrateItem.(type) {
+	case network.WebApplicationFirewallPolicy:
+		if policy.WebApplicationFirewallPolicyPropertiesFormat != nil && policy.ManagedRules != nil {
+			return policy.ManagedRules.Exclusions, nil
+		}
+	case frontdoor.WebApplicationFirewallPolicy:
+		if policy.WebApplicationFirewallPolicyProperties == nil || policy.ManagedRules == nil || policy.ManagedRules.ManagedRuleSets == nil {
+			return nil, nil
+		}
+		var exclusions []frontdoor.ManagedRuleExclusion
+		for _, ruleSet := range *policy.ManagedRules.ManagedRuleSets {
+			if ruleSet.Exclusions != nil {
+				exclusions = append(exclusions, *ruleSet.Exclusions...)
+			}
+		}
+		return exclusions, nil
+	}
+	return nil, nil
+}
+
+// The application gateway references returned with the policy only contain the ID of the gateway
+func extractWebApplicationFirewallPolicyApplicationGatewayIDs(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	policy, ok := d.HydrateItem.(network.WebApplicationFirewallPolicy)
+	if !ok || policy.WebApplicationFirewallPolicyPropertiesFormat == nil || policy.ApplicationGateways == nil {
+		return nil, nil
+	}
+
+	var gateways []map[string]interface{}
+	for _, gateway := range *policy.ApplicationGateways {
+		gateways = append(gateways, map[string]interface{}{"id": gateway.ID})
+	}
+	return gateways, nil
+}
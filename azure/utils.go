@@ -2,7 +2,9 @@ package azure
 
 import (
 	"context"
+	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 	"time"
 
@@ -131,3 +133,90 @@ func formatRegion(ctx context.Context, d *transform.TransformData) (interface{},
 	region := strings.ReplaceAll(valStr, " ", "")
 	return region, nil
 }
+
+// isPublicNetworkExposed reports whether a PaaS resource can be reached from
+// any address on the internet. A resource is not exposed if public network
+// access is disabled, or if its firewall does not allow all networks.
+func isPublicNetworkExposed(publicNetworkAccess string, allowsAllNetworks bool) bool {
+	if strings.EqualFold(publicNetworkAccess, "Disabled") {
+		return false
+	}
+	return allowsAllNetworks
+}
+
+// buildKeyVaultKeyURI returns the URI of a Key Vault key from the vault URI,
+// the key name and the optional key version
+func buildKeyVaultKeyURI(vaultURI string, keyName string, keyVersion string) string {
+	if vaultURI == "" || keyName == "" {
+		return ""
+	}
+	keyURI := strings.TrimSuffix(vaultURI, "/") + "/keys/" + keyName
+	if keyVersion != "" {
+		keyURI += "/" + keyVersion
+	}
+	return keyURI
+}
+
+// The managed identity types differ between the SDK packages of each service,
+// but they all describe the identity with the Type, PrincipalID and
+// UserAssignedIdentities fields. The following transforms read these fields
+// from any identity type, so that every table can expose the same columns.
+
+func extractManagedIdentityType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	field := managedIdentityField(d.Value, "Type")
+	if !field.IsValid() {
+		return nil, nil
+	}
+	identityType := fmt.Sprint(field.Interface())
+	if identityType == "" {
+		return nil, nil
+	}
+	return identityType, nil
+}
+
+func extractManagedIdentityPrincipalID(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	field := managedIdentityField(d.Value, "PrincipalID")
+	if !field.IsValid() {
+		return nil, nil
+	}
+	principalID := fmt.Sprint(field.Interface())
+	if principalID == "" {
+		return nil, nil
+	}
+	return principalID, nil
+}
+
+func extractManagedIdentityUserAssignedIDs(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	field := managedIdentityField(d.Value, "UserAssignedIdentities")
+	if !field.IsValid() || field.Kind() != reflect.Map || field.Len() == 0 {
+		return nil, nil
+	}
+	var ids []string
+	for _, key := range field.MapKeys() {
+		ids = append(ids, key.String())
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+func managedIdentityField(identity interface{}, name string) reflect.Value {
+	value := reflect.ValueOf(identity)
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return reflect.Value{}
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+
+	field := value.FieldByName(name)
+	for field.Kind() == reflect.Ptr {
+		if field.IsNil() {
+			return reflect.Value{}
+		}
+		field = field.Elem()
+	}
+	return field
+}